@@ -12,6 +12,7 @@ export interface ApiDocumentation {
   error_details?: any[];
   created_at: string;
   updated_at: string;
+  revision?: number;
 }
 
 export interface ApiInput {
@@ -617,6 +617,9 @@ function FlowCanvas({ board, onBack, readOnly = false, breadcrumbData, onBreadcr
             errorDetails: apiDoc.errorDetails,
             inputs: apiDoc.inputs,
             outputs: apiDoc.outputs,
+            // The catalog entry this node was copied from, to spot outdated nodes
+            catalogApiId: apiDoc.id,
+            catalogRevision: apiDoc.revision,
           },
           style: { width: 300 },
           ...(parentNodeId ? { parentNode: parentNodeId, extent: 'parent' as const, zIndex: 1 } : {}),
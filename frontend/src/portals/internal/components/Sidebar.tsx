@@ -44,6 +44,7 @@ export default function Sidebar() {
       url: api.url,
       docUrl: api.url, // use same URL as documentation link
       category: api.category,
+      revision: api.revision,
       color: api.color,
       method: api.method,
       curlExample: api.curlExample,
@@ -94,6 +95,7 @@ export default function Sidebar() {
     icon: '🌐',
     category: api.category,
     url: api.url,
+    revision: api.revision,
     method: deriveMethodFromCurl(api.curl_example),
     curlExample: api.curl_example,
     successResponse: api.success_response,
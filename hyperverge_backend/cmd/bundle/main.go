@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	supabase "github.com/supabase-community/supabase-go"
+
+	"hypervision_backend/internal/bundle"
+	"hypervision_backend/internal/db"
+)
+
+const usage = `Usage:
+  bundle export -client <id> [-format json|tar] [-out file]
+  bundle import -in <file> [-dry-run] [-namespace name] [-owner user-id] [-force]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	switch os.Args[1] {
+	case "export":
+		runExport(os.Args[2:])
+	case "import":
+		runImport(os.Args[2:])
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+}
+
+// client prefers the service role so RLS does not hide rows
+func client() *supabase.Client {
+	db.Init()
+	if db.ServiceClient != nil {
+		return db.ServiceClient
+	}
+	log.Println("Warning: SUPABASE_SERVICE_ROLE_KEY not set, using the anonymous client")
+	return db.Client
+}
+
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	clientId := fs.String("client", "", "ID of the client to export")
+	format := fs.String("format", bundle.FormatJSON, "archive format: json or tar")
+	out := fs.String("out", "", "output file (default: stdout)")
+	fs.Parse(args)
+
+	if *clientId == "" {
+		log.Fatal("-client is required")
+	}
+
+	b, err := bundle.Export(client(), *clientId)
+	if err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := b.Write(w, *format); err != nil {
+		log.Fatalf("Failed to write bundle: %v", err)
+	}
+
+	counts, _ := json.Marshal(b.Counts())
+	log.Printf("Exported client %s (checksum %s): %s", *clientId, b.Checksum, counts)
+}
+
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	in := fs.String("in", "", "bundle file (json or tar.gz)")
+	dryRun := fs.Bool("dry-run", false, "report the plan and conflicts without writing")
+	namespace := fs.String("namespace", "", "import as an independent copy under this namespace")
+	owner := fs.String("owner", "", "user ID that will own the client and its environments")
+	force := fs.Bool("force", false, "import even when non-blocking conflicts were found")
+	fs.Parse(args)
+
+	if *in == "" {
+		log.Fatal("-in is required")
+	}
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", *in, err)
+	}
+	defer f.Close()
+
+	b, err := bundle.Read(f)
+	if err != nil {
+		log.Fatalf("Invalid bundle: %v", err)
+	}
+
+	report, importErr := bundle.Import(client(), b, bundle.ImportOptions{
+		DryRun:    *dryRun,
+		Namespace: *namespace,
+		OwnerID:   *owner,
+		Force:     *force,
+	})
+
+	if report != nil {
+		out, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(out))
+	}
+	if importErr != nil {
+		log.Fatalf("Import failed: %v", importErr)
+	}
+}
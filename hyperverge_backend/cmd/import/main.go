@@ -2,178 +2,236 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
+	"os"
 
+	"hypervision_backend/internal/catalog"
 	"hypervision_backend/internal/db"
 )
 
-type ApiInput struct {
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	Description string `json:"description"`
-	Required    bool   `json:"required"`
-}
-
-type ApiOutput struct {
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	Description string `json:"description"`
-	Required    bool   `json:"required"`
-}
-
+// ApiDocumentationJSON is an API as the scraper writes it. Entries of the
+// {"apis": [...]} shape also carry their category.
 type ApiDocumentationJSON struct {
-	URL              string                 `json:"url"`
-	Name             string                 `json:"name"`
-	Description      string                 `json:"description"`
-	Inputs           []ApiInput             `json:"inputs"`
-	Outputs          []ApiOutput            `json:"outputs"`
-	CurlExample      string                 `json:"curl_example"`
-	SuccessResponse  map[string]interface{} `json:"success_response"`
-	FailureResponses []interface{}          `json:"failure_responses"`
-	ErrorDetails     []interface{}          `json:"error_details"`
+	URL              string             `json:"url"`
+	Name             string             `json:"name"`
+	Description      string             `json:"description"`
+	Category         string             `json:"category"`
+	Inputs           []catalog.FieldReq `json:"inputs"`
+	Outputs          []catalog.FieldReq `json:"outputs"`
+	CurlExample      string             `json:"curl_example"`
+	SuccessResponse  interface{}        `json:"success_response"`
+	FailureResponses []interface{}      `json:"failure_responses"`
+	ErrorDetails     []interface{}      `json:"error_details"`
+	Error            string             `json:"error"` // set by the scraper when a page failed
 }
 
+// DocumentationData holds either shape: {"india_apis": [...], "global_apis":
+// [...]} from scripts/hyperverge_api_documentation.json, or {"apis": [...]}
+// from api_data_for_import.json
 type DocumentationData struct {
 	IndiaAPIs  []ApiDocumentationJSON `json:"india_apis"`
 	GlobalAPIs []ApiDocumentationJSON `json:"global_apis"`
+	APIs       []ApiDocumentationJSON `json:"apis"`
 }
 
+// Imports API documentation into api_documentation_new. Every API is
+// validated before anything is written, then upserted by URL, each in its
+// own transaction (save_api_documentation), so an API is never left with
+// half of its inputs and outputs. Running it again only records what changed.
+//
+//	go run ./cmd/import [-file scripts/hyperverge_api_documentation.json] [--dry-run] [--prune]
 func main() {
-	// Initialize Supabase connection
-	db.Init()
+	file := flag.String("file", "scripts/hyperverge_api_documentation.json", "JSON file to import, in either shape")
+	dryRun := flag.Bool("dry-run", false, "print what would change without writing")
+	prune := flag.Bool("prune", false, "remove catalog APIs that aren't in the file")
+	flag.Parse()
 
-	// Read the JSON file
-	jsonContent, err := ioutil.ReadFile("scripts/hyperverge_api_documentation.json")
+	jsonContent, err := os.ReadFile(*file)
 	if err != nil {
 		log.Fatalf("Failed to read JSON file: %v", err)
 	}
+	reqs, failedPages, err := parse(jsonContent)
+	if err != nil {
+		log.Fatalf("Failed to parse %s: %v", *file, err)
+	}
+	if len(reqs) == 0 {
+		log.Fatalf("No APIs found in %s", *file)
+	}
+	fmt.Printf("Found %d APIs in %s\n", len(reqs), *file)
+	for _, url := range failedPages {
+		fmt.Printf("Skipping %s: the scraper couldn't read it\n", url)
+	}
 
-	// Parse JSON
-	var data DocumentationData
-	if err := json.Unmarshal(jsonContent, &data); err != nil {
-		log.Fatalf("Failed to parse JSON: %v", err)
+	// Initialize Supabase connection
+	db.Init()
+	if db.ServiceClient == nil && !*dryRun {
+		log.Println("Warning: SUPABASE_SERVICE_ROLE_KEY is not set; writes may be refused by RLS")
 	}
 
-	fmt.Printf("Found %d India APIs and %d Global APIs\n", len(data.IndiaAPIs), len(data.GlobalAPIs))
+	verb := "Imported"
+	if *dryRun {
+		verb = "Would import"
+		fmt.Println("Dry run: the catalog won't be changed")
+	}
 
-	// Import India APIs
-	importedCount := 0
-	skippedCount := 0
-	
-	fmt.Println("Importing India APIs...")
-	for i, api := range data.IndiaAPIs {
-		if err := importAPI(api, "india_api"); err != nil {
-			fmt.Printf("Failed to import India API %d (%s): %v\n", i+1, api.Name, err)
-			skippedCount++
+	counts := make(map[string]int)
+	failed := 0
+	for _, req := range reqs {
+		var result *catalog.ImportResult
+		if *dryRun {
+			result, err = catalog.PreviewImport(req)
 		} else {
-			importedCount++
+			result, err = catalog.ImportAPI(req)
 		}
-		
-		// Progress indicator
-		if (i+1)%10 == 0 {
-			fmt.Printf("Processed %d/%d India APIs...\n", i+1, len(data.IndiaAPIs))
+		if err != nil {
+			fmt.Printf("! %s (%s): %v\n", req.Name, req.URL, err)
+			failed++
+			continue
 		}
+		counts[result.Status]++
+		report(result)
 	}
 
-	fmt.Println("Importing Global APIs...")
-	for i, api := range data.GlobalAPIs {
-		if err := importAPI(api, "global_api"); err != nil {
-			fmt.Printf("Failed to import Global API %d (%s): %v\n", i+1, api.Name, err)
-			skippedCount++
-		} else {
-			importedCount++
-		}
-		
-		// Progress indicator
-		if (i+1)%10 == 0 {
-			fmt.Printf("Processed %d/%d Global APIs...\n", i+1, len(data.GlobalAPIs))
+	if *prune {
+		removed, err := pruneMissing(reqs, failedPages, *dryRun)
+		counts[catalog.ChangeRemoved] = removed
+		if err != nil {
+			fmt.Printf("! Pruning stopped: %v\n", err)
+			failed++
 		}
 	}
 
-	fmt.Printf("\n✅ Import completed!\n")
-	fmt.Printf("Successfully imported: %d APIs\n", importedCount)
-	fmt.Printf("Skipped (errors): %d APIs\n", skippedCount)
-	fmt.Printf("Total processed: %d APIs\n", importedCount+skippedCount)
+	fmt.Printf("\n%s %d APIs\n", verb, len(reqs)-failed)
+	fmt.Printf("  added:     %d\n", counts[catalog.ChangeAdded])
+	fmt.Printf("  changed:   %d\n", counts[catalog.ChangeChanged])
+	fmt.Printf("  unchanged: %d\n", counts[catalog.ChangeUnchanged])
+	if *prune {
+		fmt.Printf("  removed:   %d\n", counts[catalog.ChangeRemoved])
+	}
+	fmt.Printf("  failed:    %d\n", failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
 }
 
-func importAPI(api ApiDocumentationJSON, category string) error {
-	// Insert main API documentation
-	apiData := map[string]interface{}{
-		"name":              api.Name,
-		"description":       api.Description,
-		"url":               api.URL,
-		"category":          category,
-		"curl_example":      api.CurlExample,
-		"success_response":  api.SuccessResponse,
-		"failure_responses": api.FailureResponses,
-		"error_details":     api.ErrorDetails,
+// parse reads and validates every API of the file. It lists every invalid
+// API and fails, so nothing is imported from a file that is partly wrong.
+// Pages the scraper failed on are returned apart, by URL.
+func parse(content []byte) ([]catalog.APIReq, []string, error) {
+	var data DocumentationData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, nil, err
 	}
-
-	data, _, err := db.Client.
-		From("api_documentation").
-		Insert(apiData, false, "", "", "").
-		Execute()
-
-	if err != nil {
-		return fmt.Errorf("failed to insert API documentation: %v", err)
+	if len(data.APIs) > 0 && len(data.IndiaAPIs)+len(data.GlobalAPIs) > 0 {
+		return nil, nil, fmt.Errorf("use either apis or india_apis and global_apis, not both")
 	}
 
-	// Parse the returned data to get the API ID
-	var dbResult []map[string]interface{}
-	if err := json.Unmarshal(data, &dbResult); err != nil {
-		return fmt.Errorf("failed to parse inserted API data: %v", err)
+	var entries []ApiDocumentationJSON
+	for _, api := range data.IndiaAPIs {
+		api.Category = catalog.CategoryIndia
+		entries = append(entries, api)
 	}
-
-	if len(dbResult) == 0 {
-		return fmt.Errorf("no API documentation created")
+	for _, api := range data.GlobalAPIs {
+		api.Category = catalog.CategoryGlobal
+		entries = append(entries, api)
+	}
+	entries = append(entries, data.APIs...)
+
+	var reqs []catalog.APIReq
+	var failedPages []string
+	var invalid int
+	seen := make(map[string]string)
+	for i, api := range entries {
+		if api.Error != "" {
+			failedPages = append(failedPages, api.URL)
+			continue
+		}
+		req := catalog.APIReq{
+			Name:             api.Name,
+			Description:      api.Description,
+			URL:              api.URL,
+			Category:         api.Category,
+			CurlExample:      api.CurlExample,
+			SuccessResponse:  api.SuccessResponse,
+			FailureResponses: api.FailureResponses,
+			ErrorDetails:     api.ErrorDetails,
+			Inputs:           api.Inputs,
+			Outputs:          api.Outputs,
+		}
+		err := req.Validate()
+		if err == nil && seen[req.URL] != "" {
+			err = fmt.Errorf("same url as %s", seen[req.URL])
+		}
+		if err != nil {
+			fmt.Printf("! API %d (%s): %v\n", i+1, api.Name, err)
+			invalid++
+			continue
+		}
+		seen[req.URL] = req.Name
+		reqs = append(reqs, req)
+	}
+	if invalid > 0 {
+		return nil, nil, fmt.Errorf("%d invalid APIs", invalid)
 	}
+	return reqs, failedPages, nil
+}
 
-	apiID, ok := dbResult[0]["id"].(string)
-	if !ok {
-		return fmt.Errorf("failed to get API ID from inserted data")
+// pruneMissing removes the catalog APIs whose URL isn't in the import. APIs
+// on pages the scraper failed on are kept.
+func pruneMissing(reqs []catalog.APIReq, failedPages []string, dryRun bool) (int, error) {
+	keep := make(map[string]bool)
+	for _, req := range reqs {
+		keep[req.URL] = true
+	}
+	for _, url := range failedPages {
+		keep[url] = true
+	}
+	apis, err := catalog.LoadAPIs(catalog.Filter{})
+	if err != nil {
+		return 0, err
 	}
 
-	// Insert inputs
-	for _, input := range api.Inputs {
-		inputData := map[string]interface{}{
-			"api_id":      apiID,
-			"name":        input.Name,
-			"type":        input.Type,
-			"description": input.Description,
-			"required":    input.Required,
+	removed := 0
+	for _, api := range apis {
+		if keep[api.URL] {
+			continue
 		}
-
-		_, _, err := db.Client.
-			From("api_inputs").
-			Insert(inputData, false, "", "", "").
-			Execute()
-
-		if err != nil {
-			fmt.Printf("Warning: Failed to insert input %s for API %s: %v\n", input.Name, api.Name, err)
+		if !dryRun {
+			if err := catalog.RemoveAPI(api.ID); err != nil {
+				return removed, fmt.Errorf("%s: %v", api.Name, err)
+			}
 		}
+		fmt.Printf("- %s (%s)\n", api.Name, api.URL)
+		removed++
 	}
+	return removed, nil
+}
 
-	// Insert outputs
-	for _, output := range api.Outputs {
-		outputData := map[string]interface{}{
-			"api_id":      apiID,
-			"name":        output.Name,
-			"type":        output.Type,
-			"description": output.Description,
-			"required":    output.Required,
+// report prints an API that is or would be added or changed, with the
+// fields that changed
+func report(result *catalog.ImportResult) {
+	switch result.Status {
+	case catalog.ChangeAdded:
+		fmt.Printf("+ %s (%d inputs, %d outputs)\n", result.Name, countKind(result.Changes, "input"), countKind(result.Changes, "output"))
+	case catalog.ChangeChanged:
+		fmt.Printf("~ %s (revision %d)\n", result.Name, result.Revision)
+		for _, a := range result.Changes.Attributes {
+			fmt.Printf("    changed %s\n", a)
 		}
-
-		_, _, err := db.Client.
-			From("api_outputs").
-			Insert(outputData, false, "", "", "").
-			Execute()
-
-		if err != nil {
-			fmt.Printf("Warning: Failed to insert output %s for API %s: %v\n", output.Name, api.Name, err)
+		for _, f := range result.Changes.Fields {
+			fmt.Printf("    %s %s %s\n", f.Change, f.Kind, f.Name)
 		}
 	}
+}
 
-	return nil
-}
\ No newline at end of file
+func countKind(changes catalog.Changes, kind string) int {
+	n := 0
+	for _, f := range changes.Fields {
+		if f.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/mockhv"
+	"hypervision_backend/internal/variables"
+)
+
+// Serves the API catalog as a mock HyperVerge API. Requests must send the
+// appId/appKey of the environment, or of the flags, and the required inputs
+// of the API; the scenario file picks the response of each API.
+//
+//	go run ./cmd/mockhv [-port 8090] [-environment <id>] [-app-id ... -app-key ...] [-scenario scenario.json]
+func main() {
+	port := flag.String("port", "8090", "port to listen on")
+	environmentID := flag.String("environment", "", "environment whose appId/appKey requests must send")
+	appID := flag.String("app-id", "", "appId requests must send (overrides the environment)")
+	appKey := flag.String("app-key", "", "appKey requests must send (overrides the environment)")
+	scenarioFile := flag.String("scenario", "", "JSON file choosing success or error codes per API")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found. Ignore if this is production")
+	}
+	db.Init()
+
+	var creds mockhv.Credentials
+	if *environmentID != "" {
+		var err error
+		if creds, err = environmentCredentials(*environmentID); err != nil {
+			log.Fatalf("Failed to read environment %s: %v", *environmentID, err)
+		}
+	}
+	if *appID != "" {
+		creds.AppID = *appID
+	}
+	if *appKey != "" {
+		creds.AppKey = *appKey
+	}
+	if creds.AppID == "" || creds.AppKey == "" {
+		log.Println("Warning: no appId/appKey configured; any non-empty credentials are accepted")
+	}
+
+	var scenario mockhv.Scenario
+	if *scenarioFile != "" {
+		var err error
+		if scenario, err = mockhv.LoadScenario(*scenarioFile); err != nil {
+			log.Fatalf("Failed to load scenario: %v", err)
+		}
+	}
+
+	apis, err := catalog.LoadAPIs(catalog.Filter{})
+	if err != nil {
+		log.Fatalf("Failed to load the catalog: %v", err)
+	}
+	server, err := mockhv.New(apis, creds, scenario)
+	if err != nil {
+		log.Fatalf("Invalid scenario: %v", err)
+	}
+
+	served := 0
+	for _, e := range server.Endpoints() {
+		if e.Path != "" {
+			fmt.Printf("%-6s %-45s %s\n", e.Method, e.Path, e.Name)
+			served++
+		}
+	}
+	fmt.Printf("Serving %d APIs at their paths and all %d at /apis/<id>\n", served, len(server.Endpoints()))
+
+	gin.SetMode(gin.ReleaseMode)
+	if os.Getenv("GIN_MODE") == "debug" {
+		gin.SetMode(gin.DebugMode)
+	}
+	r := gin.Default()
+	server.Register(r)
+
+	log.Printf("Mock HyperVerge API listening on port %s", *port)
+	if err := r.Run(":" + *port); err != nil {
+		log.Fatalf("Failed to start server: %v", err)
+	}
+}
+
+// environmentCredentials reads appId/appKey from the resolved variables of
+// an environment
+func environmentCredentials(id string) (mockhv.Credentials, error) {
+	var creds mockhv.Credentials
+	client := db.Client
+	if db.ServiceClient != nil {
+		client = db.ServiceClient
+	}
+	data, _, err := client.From("test_environments").Select("*", "", false).Eq("id", id).Execute()
+	if err != nil {
+		return creds, err
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return creds, err
+	}
+	if len(rows) == 0 {
+		return creds, fmt.Errorf("not found")
+	}
+	resolved, err := variables.ResolveForEnvironment(rows[0])
+	if err != nil {
+		return creds, err
+	}
+	for k, v := range variables.Flatten(resolved) {
+		switch flowgraph.FieldKey(k) {
+		case "appid":
+			creds.AppID = fmt.Sprint(v)
+		case "appkey":
+			creds.AppKey = fmt.Sprint(v)
+		}
+	}
+	return creds, nil
+}
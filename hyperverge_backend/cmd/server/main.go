@@ -13,6 +13,7 @@ import (
 	"github.com/joho/godotenv"
 
 	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/drift"
 	"hypervision_backend/routes"
 )
 
@@ -67,6 +68,11 @@ func main() {
 
 	db.Init()
 
+	// Lambda has no long-running process; there, drift reports are made on request
+	if interval := drift.Interval(); interval > 0 {
+		go drift.Run(interval)
+	}
+
 	r := initRouter()
 
 	port := os.Getenv("PORT")
@@ -113,7 +113,7 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	linkId := getString(dbResult[0], "id")
+	linkId := db.GetString(dbResult[0], "id")
 
 	// Build share URL
 	frontendURL := os.Getenv("FRONTEND_URL")
@@ -233,14 +233,14 @@ func Verify(c *gin.Context) {
 	}
 
 	// Verify password
-	passwordHash := getString(link, "password_hash")
+	passwordHash := db.GetString(link, "password_hash")
 	if !VerifyPassword(req.Password, passwordHash) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid password"})
 		return
 	}
 
-	boardId := getString(link, "board_id")
-	role := getString(link, "role")
+	boardId := db.GetString(link, "board_id")
+	role := db.GetString(link, "role")
 
 	c.JSON(http.StatusOK, VerifyResponse{
 		BoardID: boardId,
@@ -294,14 +294,14 @@ func GetPublicBoard(c *gin.Context) {
 	}
 
 	// Verify password again
-	passwordHash := getString(link, "password_hash")
+	passwordHash := db.GetString(link, "password_hash")
 	if !VerifyPassword(password, passwordHash) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
 		return
 	}
 
-	boardId := getString(link, "board_id")
-	role := getString(link, "role")
+	boardId := db.GetString(link, "board_id")
+	role := db.GetString(link, "role")
 
 	// Fetch the board
 	boardData, _, err := db.Client.
@@ -387,12 +387,3 @@ func canManageBoard(boardId, userId string) bool {
 
 	return false
 }
-
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
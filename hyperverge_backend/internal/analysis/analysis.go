@@ -0,0 +1,399 @@
+// Package analysis checks how data flows between the steps of a workflow.
+// Every path from the start node is walked with the values available at
+// each step: the flow inputs and the outputs of the API calls that
+// succeeded before it, plus the environment's variables. A step whose
+// required input isn't available on some path would fail at run time.
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// MaxPaths bounds the paths walked; flows with more are analysed partially
+const MaxPaths = 1000
+
+// Severities
+const (
+	SeverityError   = "error"   // blocks publishing
+	SeverityWarning = "warning" // likely a bug
+	SeverityInfo    = "info"
+)
+
+// Issue kinds
+const (
+	IssueMissingInput = "missing_input" // a required input isn't available on some path
+	IssueTypeMismatch = "type_mismatch" // an input is fed a value of another type
+	IssueUnusedOutput = "unused_output" // no later step, condition or flow output reads an output
+	IssueTruncated    = "truncated"     // the flow has more than MaxPaths paths; the rest weren't checked
+)
+
+// Sources of values that no step produces
+const (
+	SourceFlowInputs  = "flowInputs"  // passed to the flow
+	SourceEnvironment = "environment" // a variable of the environment
+)
+
+// Issue is one problem with the data of a step
+type Issue struct {
+	Kind     string   `json:"kind"`
+	Severity string   `json:"severity"`
+	NodeID   string   `json:"node_id"`
+	Title    string   `json:"title"`
+	Field    string   `json:"field"`
+	Expected string   `json:"expected,omitempty"` // type of the input
+	Actual   string   `json:"actual,omitempty"`   // type of the value fed to it
+	SourceID string   `json:"source_id,omitempty"`
+	Path     []string `json:"path,omitempty"` // node ids of the first path it shows up on
+	Message  string   `json:"message"`
+}
+
+// Analysis is the data-mapping analysis of a workflow graph
+type Analysis struct {
+	Workflow  *flowgraph.Meta `json:"workflow,omitempty"`
+	Paths     int             `json:"paths"`
+	Truncated bool            `json:"truncated"` // more than MaxPaths paths
+	Errors    int             `json:"errors"`
+	Warnings  int             `json:"warnings"`
+	Issues    []Issue         `json:"issues"`
+}
+
+// Passed reports whether the workflow may be published
+func (a *Analysis) Passed() bool {
+	return a.Errors == 0
+}
+
+// Resolver returns the inputs a step needs and the outputs it produces
+type Resolver func(n flowgraph.Node) (inputs, outputs []flowgraph.Field)
+
+// CatalogResolver takes the fields of API nodes from the catalog, where the
+// required flags and types are kept, and falls back to the node's copy for
+// APIs that aren't in it
+func CatalogResolver(ch *catalog.RevisionChecker) Resolver {
+	return func(n flowgraph.Node) ([]flowgraph.Field, []flowgraph.Field) {
+		call := n.APICall()
+		inputs, outputs := call.Inputs, call.Outputs
+		if n.Type == flowgraph.NodeAPIModule && ch != nil {
+			if api, _ := ch.Match(n); api != nil {
+				inputs, outputs = fieldsOf(api.Inputs), fieldsOf(api.Outputs)
+			}
+		}
+		return dataInputs(call, inputs), outputs
+	}
+}
+
+// Check analyses a graph against the current catalog
+func Check(g *flowgraph.Graph, meta *flowgraph.Meta) (*Analysis, error) {
+	ch, err := catalog.NewRevisionChecker()
+	if err != nil {
+		return nil, err
+	}
+	var vars map[string]interface{}
+	if meta != nil {
+		vars = meta.Variables
+	}
+	a := Analyze(g, CatalogResolver(ch), vars)
+	a.Workflow = meta
+	return a, nil
+}
+
+// value is where a value available to a step comes from
+type value struct {
+	name   string
+	typ    string
+	source string // SourceFlowInputs or a node id
+}
+
+type walker struct {
+	g       *flowgraph.Graph
+	resolve Resolver
+	a       *Analysis
+
+	steps   map[string][2][]flowgraph.Field // inputs and outputs, by node id
+	reached []string                        // step ids in the order first reached
+	used    map[string]bool                 // source|key of outputs read by a step
+	seen    map[string]bool                 // issue keys
+}
+
+// Analyze walks every path of g from its start nodes. Variables are the
+// environment's, available to every step.
+func Analyze(g *flowgraph.Graph, resolve Resolver, variables map[string]interface{}) *Analysis {
+	w := &walker{
+		g:       g,
+		resolve: resolve,
+		a:       &Analysis{Issues: []Issue{}},
+		steps:   make(map[string][2][]flowgraph.Field),
+		used:    make(map[string]bool),
+		seen:    make(map[string]bool),
+	}
+
+	available := make(map[string]value)
+	for name := range variables {
+		available[flowgraph.FieldKey(name)] = value{name: name, source: SourceEnvironment}
+	}
+	for _, name := range g.FlowInputs {
+		available[flowgraph.FieldKey(name)] = value{name: name, source: SourceFlowInputs}
+	}
+	for _, s := range g.Starts() {
+		w.walk(s.ID, nil, available)
+	}
+	w.unused()
+	if w.a.Truncated {
+		// Errors on the paths that weren't walked would go unnoticed
+		w.add(Issue{
+			Kind:     IssueTruncated,
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("The flow has more than %d paths; only the first %d were checked", MaxPaths, MaxPaths),
+		})
+	}
+
+	sort.SliceStable(w.a.Issues, func(i, j int) bool {
+		return rank(w.a.Issues[i].Severity) < rank(w.a.Issues[j].Severity)
+	})
+	for _, issue := range w.a.Issues {
+		switch issue.Severity {
+		case SeverityError:
+			w.a.Errors++
+		case SeverityWarning:
+			w.a.Warnings++
+		}
+	}
+	return w.a
+}
+
+func (w *walker) walk(id string, path []string, available map[string]value) {
+	if w.a.Truncated {
+		return
+	}
+	n := w.g.Node(id)
+	if n == nil || contains(path, id) {
+		w.endPath()
+		return
+	}
+	path = append(path[:len(path):len(path)], id)
+
+	produced := []flowgraph.Field(nil)
+	if n.Type == flowgraph.NodeAPIModule || n.Type == flowgraph.NodeModule {
+		inputs, outputs := w.step(*n)
+		w.check(*n, inputs, path, available)
+		produced = outputs
+	}
+
+	branches := w.g.Branches(id)
+	if n.Type == flowgraph.NodeEndStatus || len(branches) == 0 {
+		w.endPath()
+		return
+	}
+	for _, b := range branches {
+		next := available
+		// A failed call produces none of its outputs
+		if len(produced) > 0 && b.Kind != flowgraph.BranchFailure {
+			next = make(map[string]value, len(available)+len(produced))
+			for k, v := range available {
+				next[k] = v
+			}
+			for _, f := range produced {
+				next[flowgraph.FieldKey(f.Name)] = value{name: f.Name, typ: f.Type, source: id}
+			}
+		}
+		w.walk(b.Target, path, next)
+	}
+}
+
+// endPath counts a path, or stops the walk at the first one past MaxPaths
+func (w *walker) endPath() {
+	if w.a.Paths == MaxPaths {
+		w.a.Truncated = true
+		return
+	}
+	w.a.Paths++
+}
+
+func (w *walker) step(n flowgraph.Node) ([]flowgraph.Field, []flowgraph.Field) {
+	if s, ok := w.steps[n.ID]; ok {
+		return s[0], s[1]
+	}
+	inputs, outputs := w.resolve(n)
+	w.steps[n.ID] = [2][]flowgraph.Field{inputs, outputs}
+	w.reached = append(w.reached, n.ID)
+	return inputs, outputs
+}
+
+func (w *walker) check(n flowgraph.Node, inputs []flowgraph.Field, path []string, available map[string]value) {
+	for _, f := range inputs {
+		v, ok := available[flowgraph.FieldKey(f.Name)]
+		if !ok {
+			if f.Required {
+				w.add(Issue{
+					Kind:     IssueMissingInput,
+					Severity: SeverityError,
+					NodeID:   n.ID,
+					Title:    n.Label(),
+					Field:    f.Name,
+					Expected: kind(f.Type),
+					Path:     path,
+					Message:  "Required input " + f.Name + " isn't a flow input, an environment variable, a value set by the request or an output of an earlier step on this path",
+				})
+			}
+			continue
+		}
+		if v.source != SourceFlowInputs && v.source != SourceEnvironment {
+			w.used[v.source+"|"+flowgraph.FieldKey(v.name)] = true
+		}
+		if want, got := kind(f.Type), kind(v.typ); want != "" && got != "" && want != got {
+			w.add(Issue{
+				Kind:     IssueTypeMismatch,
+				Severity: SeverityWarning,
+				NodeID:   n.ID,
+				Title:    n.Label(),
+				Field:    f.Name,
+				Expected: want,
+				Actual:   got,
+				SourceID: v.source,
+				Path:     path,
+				Message:  "Input " + f.Name + " expects " + want + " but " + w.label(v.source) + " outputs " + got,
+			})
+		}
+	}
+}
+
+// unused flags outputs of reached steps that nothing reads
+func (w *walker) unused() {
+	read := w.conditionFields()
+	for _, name := range w.g.FlowOutputs {
+		read[flowgraph.FieldKey(name)] = true
+	}
+	for _, id := range w.reached {
+		n := w.g.Node(id)
+		for _, f := range w.steps[id][1] {
+			k := flowgraph.FieldKey(f.Name)
+			if read[k] || w.used[id+"|"+k] {
+				continue
+			}
+			w.add(Issue{
+				Kind:     IssueUnusedOutput,
+				Severity: SeverityInfo,
+				NodeID:   id,
+				Title:    n.Label(),
+				Field:    f.Name,
+				Actual:   kind(f.Type),
+				Message:  "Output " + f.Name + " isn't read by a later step, a condition or the flow outputs",
+			})
+		}
+	}
+}
+
+// conditionFields are the fields read by the conditions of the graph
+func (w *walker) conditionFields() map[string]bool {
+	out := make(map[string]bool)
+	for _, n := range w.g.Nodes {
+		cond := strings.TrimSpace(n.Str("condition"))
+		if cond == "" || (n.Type != flowgraph.NodeAPIModule && n.Type != flowgraph.NodeCondition) {
+			continue
+		}
+		e, err := flowgraph.ParseExpr(cond)
+		if err != nil {
+			continue
+		}
+		for _, p := range flowgraph.Paths(e) {
+			for _, part := range p {
+				out[flowgraph.FieldKey(part)] = true
+			}
+		}
+	}
+	return out
+}
+
+func (w *walker) add(issue Issue) {
+	k := issue.Kind + "|" + issue.NodeID + "|" + flowgraph.FieldKey(issue.Field) + "|" + issue.SourceID
+	if w.seen[k] {
+		return
+	}
+	w.seen[k] = true
+	w.a.Issues = append(w.a.Issues, issue)
+}
+
+func (w *walker) label(source string) string {
+	if n := w.g.Node(source); n != nil {
+		return n.Label()
+	}
+	return source
+}
+
+// dataInputs drops the inputs sent as headers: credentials come from the
+// environment and the rest are set by the caller, not by other steps. Inputs
+// the request fixes, such as --form countryId=ind, need no source either.
+func dataInputs(call flowgraph.APICall, inputs []flowgraph.Field) []flowgraph.Field {
+	headers := make(map[string]bool)
+	for _, h := range call.Headers {
+		headers[strings.ToLower(h[0])] = true
+	}
+	literals := call.Literals()
+	var out []flowgraph.Field
+	for _, f := range inputs {
+		lower := strings.ToLower(f.Name)
+		if headers[lower] || flowgraph.IsCredential(f.Name) || lower == "content-type" || lower == "transactionid" {
+			continue
+		}
+		if _, ok := literals[flowgraph.FieldKey(f.Name)]; ok {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+func fieldsOf(fields []catalog.Field) []flowgraph.Field {
+	var out []flowgraph.Field
+	for _, f := range fields {
+		if strings.TrimSpace(f.Name) != "" {
+			out = append(out, flowgraph.Field{Name: f.Name, Type: f.Type, Required: f.Required})
+		}
+	}
+	return out
+}
+
+// kind normalises the free-text types of the catalog; "" is unknown
+func kind(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t))
+	switch {
+	case t == "":
+		return ""
+	case strings.Contains(t, "file"), strings.Contains(t, "image"), strings.Contains(t, "binary"):
+		return "file"
+	case strings.Contains(t, "array"), strings.Contains(t, "list"), strings.HasSuffix(t, "[]"):
+		return "array"
+	case strings.Contains(t, "bool"):
+		return "boolean"
+	case strings.Contains(t, "int"), strings.Contains(t, "number"), strings.Contains(t, "float"), strings.Contains(t, "double"):
+		return "number"
+	case strings.Contains(t, "object"), strings.Contains(t, "json"), strings.Contains(t, "dict"), strings.Contains(t, "map"):
+		return "object"
+	case strings.Contains(t, "str"), strings.Contains(t, "text"):
+		return "string"
+	}
+	return ""
+}
+
+func rank(severity string) int {
+	switch severity {
+	case SeverityError:
+		return 0
+	case SeverityWarning:
+		return 1
+	}
+	return 2
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"hypervision_backend/internal/flowgraph"
+)
+
+// readID is an API node whose curl example sets countryId and sends a file
+func readID(id, country string, inputs string) string {
+	return fmt.Sprintf(`{"id": %q, "type": "apiModuleNode", "data": {
+		"title": "Read ID",
+		"curlExample": "curl --location 'https://ind.idv.hyperverge.co/v1/readId' --header 'appId: <appId>' --form 'image=@\"/path/id.jpg\"' --form 'countryId=%s'",
+		"inputs": [%s],
+		"outputs": [{"name": "idNumber", "type": "string"}]
+	}}`, id, country, inputs)
+}
+
+func matchFace(id string) string {
+	return fmt.Sprintf(`{"id": %q, "type": "apiModuleNode", "data": {
+		"title": "Match face",
+		"curlExample": "curl 'https://ind.idv.hyperverge.co/v1/matchFace' -d '{\"idNumber\": \"<idNumber>\"}'",
+		"inputs": [{"name": "idNumber", "type": "string", "required": true}],
+		"outputs": [{"name": "match", "type": "boolean"}]
+	}}`, id)
+}
+
+func graph(t *testing.T, flowInputs string, nodes []string, edges ...string) *flowgraph.Graph {
+	t.Helper()
+	var es []string
+	for i, e := range edges {
+		parts := strings.Fields(e) // source target [handle]
+		handle := ""
+		if len(parts) > 2 {
+			handle = parts[2]
+		}
+		es = append(es, fmt.Sprintf(`{"id": "e%d", "source": %q, "target": %q, "sourceHandle": %q}`, i, parts[0], parts[1], handle))
+	}
+	doc := fmt.Sprintf(`{"flowInputs": %q, "nodes": [{"id": "start", "type": "startNode"}, %s], "edges": [%s]}`,
+		flowInputs, strings.Join(nodes, ","), strings.Join(es, ","))
+	g, err := flowgraph.Parse(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestAnalyze(t *testing.T) {
+	countryRequired := `{"name": "countryId", "type": "string", "required": true}`
+	imageRequired := `{"name": "image", "type": "file", "required": true}`
+	end := `{"id": "end", "type": "endStatusNode", "data": {"status": "auto-approved"}}`
+
+	tests := []struct {
+		name      string
+		g         *flowgraph.Graph
+		variables map[string]interface{}
+		missing   []string // node|field of the missing_input issues
+		truncated bool
+	}{
+		{
+			name: "literal form value is available",
+			g:    graph(t, "", []string{readID("read", "ind", countryRequired), end}, "start read", "read end"),
+		},
+		{
+			name:    "placeholder form value is missing",
+			g:       graph(t, "", []string{readID("read", "<countryId>", countryRequired), end}, "start read", "read end"),
+			missing: []string{"read|countryId"},
+		},
+		{
+			name:      "environment variable is available",
+			g:         graph(t, "", []string{readID("read", "<countryId>", countryRequired), end}, "start read", "read end"),
+			variables: map[string]interface{}{"country_id": "ind"},
+		},
+		{
+			name: "flow input is available",
+			g:    graph(t, "countryId", []string{readID("read", "<countryId>", countryRequired), end}, "start read", "read end"),
+		},
+		{
+			name:    "files are never literals",
+			g:       graph(t, "", []string{readID("read", "ind", imageRequired), end}, "start read", "read end"),
+			missing: []string{"read|image"},
+		},
+		{
+			name: "output of an earlier step",
+			g: graph(t, "", []string{readID("read", "ind", countryRequired), matchFace("match"), end},
+				"start read", "read match success", "match end"),
+		},
+		{
+			name: "failed step produces no outputs",
+			g: graph(t, "", []string{readID("read", "ind", countryRequired), matchFace("match"), end},
+				"start read", "read match failure", "match end"),
+			missing: []string{"match|idNumber"},
+		},
+		{
+			name: "retry loop ends",
+			g: graph(t, "", []string{readID("read", "ind", countryRequired), matchFace("match"), end},
+				"start read", "read match success", "match end success", "match read failure"),
+		},
+		{
+			name:      "too many paths",
+			g:         fanOut(t, 11),
+			truncated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Analyze(tt.g, CatalogResolver(nil), tt.variables)
+
+			var missing []string
+			for _, issue := range a.Issues {
+				if issue.Kind == IssueMissingInput {
+					missing = append(missing, issue.NodeID+"|"+issue.Field)
+				}
+			}
+			if strings.Join(missing, ",") != strings.Join(tt.missing, ",") {
+				t.Errorf("missing inputs = %v, want %v", missing, tt.missing)
+			}
+			if a.Truncated != tt.truncated {
+				t.Errorf("truncated = %v, want %v", a.Truncated, tt.truncated)
+			}
+			if tt.truncated && a.Passed() {
+				t.Error("a truncated analysis passed")
+			}
+			if a.Paths == 0 {
+				t.Error("no paths walked")
+			}
+		})
+	}
+}
+
+func TestPathLimit(t *testing.T) {
+	tests := []struct {
+		paths     int
+		truncated bool
+	}{
+		{MaxPaths - 1, false},
+		{MaxPaths, false},
+		{MaxPaths + 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.paths), func(t *testing.T) {
+			a := Analyze(parallel(t, tt.paths), CatalogResolver(nil), nil)
+			if a.Truncated != tt.truncated {
+				t.Errorf("truncated = %v, want %v", a.Truncated, tt.truncated)
+			}
+			if want := min(tt.paths, MaxPaths); a.Paths != want {
+				t.Errorf("%d paths walked, want %d", a.Paths, want)
+			}
+			if a.Passed() == tt.truncated {
+				t.Errorf("passed = %v with truncated %v", a.Passed(), tt.truncated)
+			}
+		})
+	}
+}
+
+// parallel joins the start node to an end by n edges, giving n paths
+func parallel(t *testing.T, n int) *flowgraph.Graph {
+	edges := make([]string, n)
+	for i := range edges {
+		edges[i] = "start end"
+	}
+	return graph(t, "", []string{`{"id": "end", "type": "endStatusNode", "data": {"status": "auto-approved"}}`}, edges...)
+}
+
+// fanOut chains n conditions whose branches both lead to the next one,
+// giving 2^n paths
+func fanOut(t *testing.T, n int) *flowgraph.Graph {
+	nodes := []string{`{"id": "end", "type": "endStatusNode", "data": {"status": "auto-approved"}}`}
+	edges := []string{"start c0"}
+	for i := 0; i < n; i++ {
+		nodes = append(nodes, fmt.Sprintf(`{"id": "c%d", "type": "conditionNode", "data": {"condition": "x == %d"}}`, i, i))
+		next := fmt.Sprintf("c%d", i+1)
+		if i == n-1 {
+			next = "end"
+		}
+		edges = append(edges, fmt.Sprintf("c%d %s true", i, next), fmt.Sprintf("c%d %s false", i, next))
+	}
+	return graph(t, "", nodes, edges...)
+}
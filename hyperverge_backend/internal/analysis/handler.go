@@ -0,0 +1,24 @@
+package analysis
+
+import (
+	"net/http"
+
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler analyses how data flows between the steps of a workflow
+// (GET /workflows/:id/analysis?version_id=&environment_id=)
+func Handler(c *gin.Context) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	a, err := Check(g, meta)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, a)
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"encoding/json"
+
+	"hypervision_backend/internal/db"
+)
+
+// OwnsClient reports whether the user owns the client
+func OwnsClient(clientId, userId string) bool {
+	if clientId == "" || userId == "" {
+		return false
+	}
+	return exists(db.Client.
+		From("test_clients").
+		Select("id", "", false).
+		Eq("id", clientId).
+		Eq("owner_id", userId).
+		Execute())
+}
+
+// CanAccessBU checks client ownership first, then BU permissions. Viewers
+// can read; writing requires the editor role.
+func CanAccessBU(buId, userId string, write bool) bool {
+	if buId == "" || userId == "" {
+		return false
+	}
+	clientId := ClientOfBU(buId)
+	if clientId == "" {
+		return false
+	}
+	if OwnsClient(clientId, userId) {
+		return true
+	}
+
+	query := db.Client.
+		From("test_bu_permissions").
+		Select("id", "", false).
+		Eq("business_unit_id", buId).
+		Eq("user_id", userId)
+	if write {
+		query = query.Eq("role", "editor")
+	}
+	return exists(query.Execute())
+}
+
+// CanAccessClient grants access to the client owner, and to collaborators
+// on any of the client's BUs (editors when write is set)
+func CanAccessClient(clientId, userId string, write bool) bool {
+	if OwnsClient(clientId, userId) {
+		return true
+	}
+
+	data, _, err := db.Client.
+		From("test_business_units").
+		Select("id", "", false).
+		Eq("client_id", clientId).
+		Execute()
+	if err != nil {
+		return false
+	}
+	var bus []map[string]interface{}
+	if err := json.Unmarshal(data, &bus); err != nil || len(bus) == 0 {
+		return false
+	}
+	buIds := make([]string, 0, len(bus))
+	for _, bu := range bus {
+		if id, ok := bu["id"].(string); ok {
+			buIds = append(buIds, id)
+		}
+	}
+
+	query := db.Client.
+		From("test_bu_permissions").
+		Select("id", "", false).
+		In("business_unit_id", buIds).
+		Eq("user_id", userId)
+	if write {
+		query = query.Eq("role", "editor")
+	}
+	return exists(query.Execute())
+}
+
+// ClientOfBU returns the client a business unit belongs to, or "" when the
+// BU doesn't exist
+func ClientOfBU(buId string) string {
+	data, _, err := db.Client.
+		From("test_business_units").
+		Select("client_id", "", false).
+		Eq("id", buId).
+		Execute()
+	if err != nil {
+		return ""
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(data, &rows); err != nil || len(rows) == 0 {
+		return ""
+	}
+	clientId, _ := rows[0]["client_id"].(string)
+	return clientId
+}
+
+func exists(data []byte, _ int64, err error) bool {
+	if err != nil {
+		return false
+	}
+	var rows []map[string]interface{}
+	return json.Unmarshal(data, &rows) == nil && len(rows) > 0
+}
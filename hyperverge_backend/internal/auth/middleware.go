@@ -37,6 +37,27 @@ func RequireAuth() gin.HandlerFunc {
 		c.Set("userId", user.ID.String())
 		c.Set("email", user.Email)
 		c.Set("name", user.UserMetadata["name"])
+		if role, ok := user.AppMetadata["role"].(string); ok {
+			c.Set("role", role)
+		}
+
+		c.Next()
+	}
+}
+
+// IsAdmin reports whether the authenticated user has the admin role in
+// their Supabase app_metadata.
+func IsAdmin(c *gin.Context) bool {
+	return c.GetString("role") == "admin"
+}
+
+// RequireAdmin must run after RequireAuth
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsAdmin(c) {
+			c.AbortWithStatusJSON(403, gin.H{"error": "admin access required"})
+			return
+		}
 
 		c.Next()
 	}
@@ -69,32 +69,23 @@ func Create(c *gin.Context) {
 
 	board := dbResult[0]
 	response := BoardResponse{
-		ID:          getString(board, "id"),
-		Name:        getString(board, "name"),
-		Description: getString(board, "description"),
-		UserID:      getString(board, "owner_id"),
+		ID:          db.GetString(board, "id"),
+		Name:        db.GetString(board, "name"),
+		Description: db.GetString(board, "description"),
+		UserID:      db.GetString(board, "owner_id"),
 		FlowData: FlowData{
 			Nodes:       []interface{}{},
 			Edges:       []interface{}{},
 			FlowInputs:  "",
 			FlowOutputs: "",
 		},
-		CreatedAt: getString(board, "created_at"),
-		UpdatedAt: getString(board, "updated_at"),
+		CreatedAt: db.GetString(board, "created_at"),
+		UpdatedAt: db.GetString(board, "updated_at"),
 	}
 
 	c.JSON(http.StatusCreated, response)
 }
 
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 func List(c *gin.Context) {
 	userId := c.GetString("userId")
 	fmt.Println(userId)
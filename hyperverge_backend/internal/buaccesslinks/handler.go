@@ -9,6 +9,7 @@ import (
 
 	"hypervision_backend/internal/accesslinks"
 	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/variables"
 
 	"github.com/gin-gonic/gin"
 )
@@ -40,15 +41,6 @@ type VerifyResponse struct {
 	BusinessUnitName string `json:"businessUnitName"`
 }
 
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 func getMap(m map[string]interface{}, key string) map[string]interface{} {
 	if val, ok := m[key]; ok {
 		if str, ok := val.(string); ok {
@@ -134,7 +126,7 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	linkId := getString(dbResult[0], "id")
+	linkId := db.GetString(dbResult[0], "id")
 
 	// Build share URL
 	frontendURL := os.Getenv("FRONTEND_URL")
@@ -252,16 +244,16 @@ func Verify(c *gin.Context) {
 	}
 
 	// Verify password
-	passwordHash := getString(link, "password_hash")
+	passwordHash := db.GetString(link, "password_hash")
 	if !accesslinks.VerifyPassword(req.Password, passwordHash) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid password"})
 		return
 	}
 
-	buId := getString(link, "business_unit_id")
+	buId := db.GetString(link, "business_unit_id")
 	buName := ""
 	if bu, ok := link["test_business_units"].(map[string]interface{}); ok {
-		buName = getString(bu, "name")
+		buName = db.GetString(bu, "name")
 	}
 
 	c.JSON(http.StatusOK, VerifyResponse{
@@ -272,57 +264,12 @@ func Verify(c *gin.Context) {
 
 // GetPublicBUData fetches all BU data for a verified link (PUBLIC - no auth required)
 func GetPublicBUData(c *gin.Context) {
-	linkId := c.Param("linkId")
-
-	// Get password from query param
-	token := c.Query("token")
-	if token == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "token is required"})
-		return
-	}
-
-	// Fetch and validate link
-	linkData, _, err := db.Client.
-		From("test_bu_access_links").
-		Select("*, test_business_units(id, name, description)", "", false).
-		Eq("id", linkId).
-		Execute()
-
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+	link, ok := Authorize(c)
+	if !ok {
 		return
 	}
 
-	var linkResults []map[string]interface{}
-	if err := json.Unmarshal(linkData, &linkResults); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to parse link"})
-		return
-	}
-
-	if len(linkResults) == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
-		return
-	}
-
-	link := linkResults[0]
-
-	// Check expiration
-	if expiresAt, ok := link["expires_at"].(string); ok && expiresAt != "" {
-		expTime, err := time.Parse(time.RFC3339, expiresAt)
-		if err == nil && time.Now().After(expTime) {
-			c.JSON(http.StatusGone, gin.H{"error": "link has expired"})
-			return
-		}
-	}
-
-	// Verify password
-	passwordHash := getString(link, "password_hash")
-	if !accesslinks.VerifyPassword(token, passwordHash) {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
-		return
-	}
-
-	buId := getString(link, "business_unit_id")
+	buId := db.GetString(link, "business_unit_id")
 
 	// Get BU info
 	buInfo := map[string]interface{}{}
@@ -352,6 +299,26 @@ func GetPublicBUData(c *gin.Context) {
 		}
 	}
 
+	// Add the effective variables inherited from the client and BU. The link
+	// is unauthenticated, so inherited secrets stay out of it.
+	if parentLayers, _, err := variables.ParentLayers(buId); err == nil {
+		for i, env := range environments {
+			layers := append([]variables.Layer{}, parentLayers...)
+			layers = append(layers, variables.Layer{
+				Source:    variables.SourceEnvironment,
+				SourceID:  db.GetString(env, "id"),
+				Variables: getMap(env, "variables"),
+			})
+			resolved := variables.Flatten(variables.Resolve(layers...))
+			for key := range resolved {
+				if variables.IsSecret(key) {
+					delete(resolved, key)
+				}
+			}
+			environments[i]["resolved_variables"] = resolved
+		}
+	}
+
 	// Fetch workflows for this BU
 	wfData, _, err := db.Client.
 		From("test_workflows").
@@ -438,7 +405,7 @@ func canManageBU(buId, userId string) bool {
 	}
 
 	bu := buResults[0]
-	clientId := getString(bu, "client_id")
+	clientId := db.GetString(bu, "client_id")
 	fmt.Printf("DEBUG: Found BU client_id: %s\n", clientId)
 
 	// Check if user owns the client
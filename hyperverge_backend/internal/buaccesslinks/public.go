@@ -0,0 +1,78 @@
+package buaccesslinks
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"hypervision_backend/internal/accesslinks"
+	"hypervision_backend/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Authorize validates :linkId and the ?token= password of a public BU link.
+// It returns the link row, with the business unit embedded, or writes the
+// error response and returns false.
+func Authorize(c *gin.Context) (map[string]interface{}, bool) {
+	linkId := c.Param("linkId")
+
+	// Get password from query param
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "token is required"})
+		return nil, false
+	}
+
+	// Fetch and validate link
+	linkData, _, err := db.Client.
+		From("test_bu_access_links").
+		Select("*, test_business_units(id, name, description)", "", false).
+		Eq("id", linkId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+		return nil, false
+	}
+
+	var linkResults []map[string]interface{}
+	if err := json.Unmarshal(linkData, &linkResults); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to parse link"})
+		return nil, false
+	}
+
+	if len(linkResults) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+		return nil, false
+	}
+
+	link := linkResults[0]
+
+	// Check expiration
+	if expiresAt, ok := link["expires_at"].(string); ok && expiresAt != "" {
+		expTime, err := time.Parse(time.RFC3339, expiresAt)
+		if err == nil && time.Now().After(expTime) {
+			c.JSON(http.StatusGone, gin.H{"error": "link has expired"})
+			return nil, false
+		}
+	}
+
+	// Verify password
+	passwordHash := db.GetString(link, "password_hash")
+	if !accesslinks.VerifyPassword(token, passwordHash) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+		return nil, false
+	}
+
+	return link, true
+}
+
+// AuthorizedBusinessUnit is Authorize for handlers that only need the BU id
+func AuthorizedBusinessUnit(c *gin.Context) (string, bool) {
+	link, ok := Authorize(c)
+	if !ok {
+		return "", false
+	}
+	return db.GetString(link, "business_unit_id"), true
+}
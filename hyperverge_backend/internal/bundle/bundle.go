@@ -0,0 +1,266 @@
+// Package bundle exports a client with everything under it into a portable,
+// checksummed archive and imports such an archive into another project.
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// FormatVersion is bumped whenever the layout of Data changes
+const FormatVersion = 1
+
+// Supported archive formats
+const (
+	FormatJSON = "json"
+	FormatTar  = "tar"
+)
+
+// Table names, in the order rows have to be written on import
+const (
+	TableClients       = "test_clients"
+	TableBusinessUnits = "test_business_units"
+	TableEnvironments  = "test_environments"
+	TableWorkflows     = "test_workflows"
+	TableVersions      = "workflow_versions"
+	TableLinks         = "test_workflow_environments"
+	TablePermissions   = "test_bu_permissions"
+)
+
+// Bundle is the archive written by Export. Rows are kept as they come out of
+// the database so columns added later survive a round trip.
+type Bundle struct {
+	FormatVersion  int    `json:"format_version"`
+	ExportedAt     string `json:"exported_at"`
+	SourceClientID string `json:"source_client_id"`
+	Checksum       string `json:"checksum"` // sha256 of the JSON encoding of Data
+	Data           Data   `json:"data"`
+}
+
+type Data struct {
+	Client        map[string]interface{}   `json:"client"`
+	BusinessUnits []map[string]interface{} `json:"business_units"`
+	Environments  []map[string]interface{} `json:"environments"`
+	Workflows     []map[string]interface{} `json:"workflows"`
+	Versions      []map[string]interface{} `json:"versions"`
+	Links         []map[string]interface{} `json:"links"`
+	Permissions   []map[string]interface{} `json:"permissions"`
+}
+
+// manifest is the first entry of a tar archive
+type manifest struct {
+	FormatVersion  int            `json:"format_version"`
+	ExportedAt     string         `json:"exported_at"`
+	SourceClientID string         `json:"source_client_id"`
+	Checksum       string         `json:"checksum"`
+	Counts         map[string]int `json:"counts"`
+}
+
+func newBundle(clientId string, data Data) (*Bundle, error) {
+	checksum, err := checksumOf(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Bundle{
+		FormatVersion:  FormatVersion,
+		ExportedAt:     time.Now().UTC().Format(time.RFC3339),
+		SourceClientID: clientId,
+		Checksum:       checksum,
+		Data:           data,
+	}, nil
+}
+
+// Map keys are sorted by encoding/json, so the encoding is stable
+func checksumOf(data Data) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify checks the format version and the checksum of a bundle
+func (b *Bundle) Verify() error {
+	if b.FormatVersion < 1 || b.FormatVersion > FormatVersion {
+		return fmt.Errorf("unsupported bundle format version %d", b.FormatVersion)
+	}
+	if b.Data.Client == nil {
+		return fmt.Errorf("bundle has no client")
+	}
+	checksum, err := checksumOf(b.Data)
+	if err != nil {
+		return err
+	}
+	if checksum != b.Checksum {
+		return fmt.Errorf("bundle checksum mismatch: expected %s, got %s", b.Checksum, checksum)
+	}
+	return nil
+}
+
+// Write encodes the bundle as JSON or as a gzipped tar with one file per table
+func (b *Bundle) Write(w io.Writer, format string) error {
+	switch format {
+	case "", FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(b)
+	case FormatTar:
+		return b.writeTar(w)
+	}
+	return fmt.Errorf("unsupported format %q", format)
+}
+
+func (b *Bundle) writeTar(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	m := manifest{
+		FormatVersion:  b.FormatVersion,
+		ExportedAt:     b.ExportedAt,
+		SourceClientID: b.SourceClientID,
+		Checksum:       b.Checksum,
+		Counts:         b.Counts(),
+	}
+
+	files := []struct {
+		name  string
+		value interface{}
+	}{
+		{"manifest.json", m},
+		{"data/" + TableClients + ".json", b.Data.Client},
+		{"data/" + TableBusinessUnits + ".json", b.Data.BusinessUnits},
+		{"data/" + TableEnvironments + ".json", b.Data.Environments},
+		{"data/" + TableWorkflows + ".json", b.Data.Workflows},
+		{"data/" + TableVersions + ".json", b.Data.Versions},
+		{"data/" + TableLinks + ".json", b.Data.Links},
+		{"data/" + TablePermissions + ".json", b.Data.Permissions},
+	}
+
+	for _, f := range files {
+		content, err := json.MarshalIndent(f.value, "", "  ")
+		if err != nil {
+			return err
+		}
+		hdr := &tar.Header{
+			Name:    f.name,
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(content); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Counts returns the number of rows per table
+func (b *Bundle) Counts() map[string]int {
+	return map[string]int{
+		TableClients:       1,
+		TableBusinessUnits: len(b.Data.BusinessUnits),
+		TableEnvironments:  len(b.Data.Environments),
+		TableWorkflows:     len(b.Data.Workflows),
+		TableVersions:      len(b.Data.Versions),
+		TableLinks:         len(b.Data.Links),
+		TablePermissions:   len(b.Data.Permissions),
+	}
+}
+
+// Read decodes a bundle in either format and verifies it
+func Read(r io.Reader) (*Bundle, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *Bundle
+	if len(raw) >= 2 && raw[0] == 0x1f && raw[1] == 0x8b {
+		b, err = readTar(raw)
+	} else {
+		b = &Bundle{}
+		err = json.Unmarshal(raw, b)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle: %v", err)
+	}
+
+	if err := b.Verify(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func readTar(raw []byte) (*Bundle, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(gz)
+
+	b := &Bundle{}
+	targets := map[string]interface{}{
+		"data/" + TableClients + ".json":       &b.Data.Client,
+		"data/" + TableBusinessUnits + ".json": &b.Data.BusinessUnits,
+		"data/" + TableEnvironments + ".json":  &b.Data.Environments,
+		"data/" + TableWorkflows + ".json":     &b.Data.Workflows,
+		"data/" + TableVersions + ".json":      &b.Data.Versions,
+		"data/" + TableLinks + ".json":         &b.Data.Links,
+		"data/" + TablePermissions + ".json":   &b.Data.Permissions,
+	}
+
+	seenManifest := false
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		if hdr.Name == "manifest.json" {
+			var m manifest
+			if err := json.Unmarshal(content, &m); err != nil {
+				return nil, fmt.Errorf("manifest: %v", err)
+			}
+			b.FormatVersion = m.FormatVersion
+			b.ExportedAt = m.ExportedAt
+			b.SourceClientID = m.SourceClientID
+			b.Checksum = m.Checksum
+			seenManifest = true
+			continue
+		}
+
+		if target, ok := targets[hdr.Name]; ok {
+			if err := json.Unmarshal(content, target); err != nil {
+				return nil, fmt.Errorf("%s: %v", hdr.Name, err)
+			}
+		}
+	}
+
+	if !seenManifest {
+		return nil, fmt.Errorf("manifest.json missing")
+	}
+	return b, nil
+}
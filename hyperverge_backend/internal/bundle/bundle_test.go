@@ -0,0 +1,197 @@
+package bundle
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func sample() Data {
+	return Data{
+		Client:        map[string]interface{}{"id": "c1", "name": "Acme", "owner_id": "u1"},
+		BusinessUnits: []map[string]interface{}{{"id": "b1", "client_id": "c1", "name": "Retail"}},
+		Environments:  []map[string]interface{}{{"id": "e1", "business_unit_id": "b1", "name": "prod"}},
+		Workflows: []map[string]interface{}{{
+			"id": "w1", "business_unit_id": "b1", "name": "KYC",
+			"active_published_version_id": "v1", "template_id": "t1", "template_version": float64(2),
+		}},
+		Versions:    []map[string]interface{}{{"id": "v1", "workflow_id": "w1", "version_number": "1.0"}},
+		Links:       []map[string]interface{}{{"id": "l1", "workflow_id": "w1", "environment_id": "e1"}},
+		Permissions: []map[string]interface{}{{"id": "p1", "business_unit_id": "b1", "user_id": "u2"}},
+	}
+}
+
+func newImporter(opts ImportOptions) *importer {
+	return &importer{
+		opts:           opts,
+		activeVersions: make(map[string]string),
+		report:         &ImportReport{IDMap: make(map[string]map[string]string)},
+	}
+}
+
+func TestRemapIsStable(t *testing.T) {
+	first, err := newImporter(ImportOptions{Namespace: "staging"}).remap(sample())
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := newImporter(ImportOptions{Namespace: "staging"}).remap(sample())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first, again) {
+		t.Error("re-importing with the same namespace gave different rows")
+	}
+
+	other, err := newImporter(ImportOptions{Namespace: "copy"}).remap(sample())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Client["id"] == first.Client["id"] || other.Workflows[0]["id"] == first.Workflows[0]["id"] {
+		t.Error("another namespace reused the imported IDs")
+	}
+
+	want := uuid.NewSHA1(idNamespace, []byte("staging/"+TableWorkflows+"/w1")).String()
+	if got := first.Workflows[0]["id"]; got != want {
+		t.Errorf("workflow id = %v, want UUIDv5 %s", got, want)
+	}
+	if id, err := uuid.Parse(want); err != nil || id.Version() != 5 {
+		t.Errorf("%s is not a version 5 UUID", want)
+	}
+}
+
+func TestRemap(t *testing.T) {
+	src := sample()
+	imp := newImporter(ImportOptions{OwnerID: "u9"})
+	out, err := imp.remap(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := func(table, sourceId string) interface{} { return imp.report.IDMap[table][sourceId] }
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"client id", out.Client["id"], id(TableClients, "c1")},
+		{"client owner", out.Client["owner_id"], "u9"},
+		{"business unit client", out.BusinessUnits[0]["client_id"], id(TableClients, "c1")},
+		{"environment business unit", out.Environments[0]["business_unit_id"], id(TableBusinessUnits, "b1")},
+		{"environment owner", out.Environments[0]["owner_id"], "u9"},
+		{"workflow business unit", out.Workflows[0]["business_unit_id"], id(TableBusinessUnits, "b1")},
+		{"active version set after the versions", out.Workflows[0]["active_published_version_id"], nil},
+		{"template dropped", out.Workflows[0]["template_id"], nil},
+		{"version workflow", out.Versions[0]["workflow_id"], id(TableWorkflows, "w1")},
+		{"link workflow", out.Links[0]["workflow_id"], id(TableWorkflows, "w1")},
+		{"link environment", out.Links[0]["environment_id"], id(TableEnvironments, "e1")},
+		{"permission business unit", out.Permissions[0]["business_unit_id"], id(TableBusinessUnits, "b1")},
+		{"source rows untouched", src.Workflows[0]["id"], "w1"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+	if got := imp.activeVersions[out.Workflows[0]["id"].(string)]; got != "v1" {
+		t.Errorf("active version of the workflow = %q, want v1", got)
+	}
+	if len(imp.report.Warnings) != 1 || !strings.Contains(imp.report.Warnings[0], "template") {
+		t.Errorf("warnings = %v, want one about the template", imp.report.Warnings)
+	}
+}
+
+func TestRemapUnknownParents(t *testing.T) {
+	tests := []struct {
+		name  string
+		edit  func(*Data)
+		err   string // "" when the row is skipped with a warning instead
+		links int
+	}{
+		{"environment", func(d *Data) { d.Environments[0]["business_unit_id"] = "b9" }, "environment e1 references an unknown business unit", 0},
+		{"workflow", func(d *Data) { d.Workflows[0]["business_unit_id"] = "b9" }, "workflow w1 references an unknown business unit", 0},
+		{"version", func(d *Data) { d.Versions[0]["workflow_id"] = "w9" }, "version v1 references an unknown workflow", 0},
+		{"link", func(d *Data) { d.Links[0]["environment_id"] = "e9" }, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := sample()
+			tt.edit(&src)
+			imp := newImporter(ImportOptions{})
+			out, err := imp.remap(src)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(out.Links) != tt.links {
+				t.Errorf("%d links, want %d", len(out.Links), tt.links)
+			}
+		})
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	b, err := newBundle("c1", sample())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Verify(); err != nil {
+		t.Fatalf("Verify() = %v", err)
+	}
+
+	// Rebuilding the maps in another order keeps the checksum
+	reordered := sample()
+	reordered.Client = map[string]interface{}{"owner_id": "u1", "name": "Acme", "id": "c1"}
+	if sum, _ := checksumOf(reordered); sum != b.Checksum {
+		t.Errorf("checksum changed with map order: %s != %s", sum, b.Checksum)
+	}
+
+	tests := []struct {
+		name string
+		edit func(*Bundle)
+		err  string
+	}{
+		{"changed row", func(b *Bundle) { b.Data.Workflows[0]["name"] = "AML" }, "checksum mismatch"},
+		{"dropped row", func(b *Bundle) { b.Data.Links = nil }, "checksum mismatch"},
+		{"newer format", func(b *Bundle) { b.FormatVersion = FormatVersion + 1 }, "unsupported bundle format"},
+		{"no client", func(b *Bundle) { b.Data.Client = nil }, "bundle has no client"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := newBundle("c1", sample())
+			tt.edit(b)
+			if err := b.Verify(); err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Verify() = %v, want an error containing %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatTar} {
+		t.Run(format, func(t *testing.T) {
+			b, err := newBundle("c1", sample())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			if err := b.Write(&buf, format); err != nil {
+				t.Fatal(err)
+			}
+			got, err := Read(&buf)
+			if err != nil {
+				t.Fatalf("Read() = %v", err)
+			}
+			if got.Checksum != b.Checksum || got.SourceClientID != "c1" {
+				t.Errorf("read %s / %s, want %s / c1", got.Checksum, got.SourceClientID, b.Checksum)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"hypervision_backend/internal/db"
+
+	supabase "github.com/supabase-community/supabase-go"
+)
+
+// Export reads a client and everything under it into a bundle
+func Export(client *supabase.Client, clientId string) (*Bundle, error) {
+	clients, err := selectIn(client, TableClients, "id", []string{clientId})
+	if err != nil {
+		return nil, err
+	}
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("client not found")
+	}
+
+	data := Data{Client: clients[0]}
+
+	if data.BusinessUnits, err = selectIn(client, TableBusinessUnits, "client_id", []string{clientId}); err != nil {
+		return nil, err
+	}
+	buIds := idsOf(data.BusinessUnits)
+
+	if data.Environments, err = selectIn(client, TableEnvironments, "business_unit_id", buIds); err != nil {
+		return nil, err
+	}
+	if data.Workflows, err = selectIn(client, TableWorkflows, "business_unit_id", buIds); err != nil {
+		return nil, err
+	}
+	if data.Permissions, err = selectIn(client, TablePermissions, "business_unit_id", buIds); err != nil {
+		return nil, err
+	}
+
+	workflowIds := idsOf(data.Workflows)
+	if data.Versions, err = selectIn(client, TableVersions, "workflow_id", workflowIds); err != nil {
+		return nil, err
+	}
+	if data.Links, err = selectIn(client, TableLinks, "workflow_id", workflowIds); err != nil {
+		return nil, err
+	}
+
+	return newBundle(clientId, data)
+}
+
+func selectIn(client *supabase.Client, table, column string, values []string) ([]map[string]interface{}, error) {
+	rows := []map[string]interface{}{}
+	if len(values) == 0 {
+		return rows, nil
+	}
+
+	data, _, err := client.
+		From(table).
+		Select("*", "", false).
+		In(column, values).
+		Order("id", nil).
+		Execute()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", table, err)
+	}
+
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", table, err)
+	}
+	return rows, nil
+}
+
+func idsOf(rows []map[string]interface{}) []string {
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, db.GetString(row, "id"))
+	}
+	return ids
+}
@@ -0,0 +1,103 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"hypervision_backend/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxBundleSize bounds the request body accepted by ImportHandler
+const maxBundleSize = 64 << 20
+
+// ExportHandler streams a client bundle. ?format=json (default) or tar.
+func ExportHandler(c *gin.Context) {
+	clientId := c.Param("id")
+	userId := c.GetString("userId")
+	format := c.DefaultQuery("format", FormatJSON)
+
+	if format != FormatJSON && format != FormatTar {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be 'json' or 'tar'"})
+		return
+	}
+
+	// Only the client owner can export it
+	data, _, err := db.Client.
+		From(TableClients).
+		Select("id", "", false).
+		Eq("id", clientId).
+		Eq("owner_id", userId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "database error"})
+		return
+	}
+
+	var clientResults []map[string]interface{}
+	if err := json.Unmarshal(data, &clientResults); err != nil || len(clientResults) == 0 {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to export this client"})
+		return
+	}
+
+	b, err := Export(db.Client, clientId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	filename := fmt.Sprintf("client-%s-%s", clientId, time.Now().UTC().Format("20060102"))
+	if format == FormatTar {
+		c.Header("Content-Type", "application/gzip")
+		c.Header("Content-Disposition", "attachment; filename=\""+filename+".tar.gz\"")
+	} else {
+		c.Header("Content-Type", "application/json")
+		c.Header("Content-Disposition", "attachment; filename=\""+filename+".json\"")
+	}
+	c.Header("X-Bundle-Checksum", b.Checksum)
+	c.Status(http.StatusOK)
+
+	if err := b.Write(c.Writer, format); err != nil {
+		c.Error(err)
+	}
+}
+
+// ImportHandler imports a bundle (JSON or tar.gz request body) as a client
+// owned by the current user. ?dry_run=true only reports the plan and
+// conflicts; ?namespace= imports an independent copy; ?force=true ignores
+// non-blocking conflicts.
+func ImportHandler(c *gin.Context) {
+	userId := c.GetString("userId")
+
+	b, err := Read(http.MaxBytesReader(c.Writer, c.Request.Body, maxBundleSize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := Import(db.Client, b, ImportOptions{
+		DryRun:    c.Query("dry_run") == "true",
+		Namespace: c.Query("namespace"),
+		OwnerID:   userId,
+		Force:     c.Query("force") == "true",
+	})
+
+	if err == ErrConflicts {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "report": report})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "report": report})
+		return
+	}
+
+	if report.DryRun {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+	c.JSON(http.StatusCreated, report)
+}
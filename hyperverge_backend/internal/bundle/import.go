@@ -0,0 +1,430 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"hypervision_backend/internal/db"
+
+	"github.com/google/uuid"
+	supabase "github.com/supabase-community/supabase-go"
+)
+
+// idNamespace seeds the deterministic IDs given to imported rows
+var idNamespace = uuid.MustParse("5d3f8f0e-6c1a-4b8e-9a57-2f0c1e7b9d41")
+
+type ImportOptions struct {
+	// DryRun computes the plan and conflicts without writing anything
+	DryRun bool
+	// Namespace changes the generated IDs. Importing the same bundle twice with
+	// the same namespace updates the first copy; a new namespace makes a
+	// second, independent copy.
+	Namespace string
+	// OwnerID, when set, becomes the owner of the client and its environments
+	OwnerID string
+	// Force writes the bundle even when conflicts were found
+	Force bool
+}
+
+// Conflict is something in the target project that the import would clash with
+type Conflict struct {
+	Table      string `json:"table"`
+	SourceID   string `json:"source_id"`
+	TargetID   string `json:"target_id"`
+	Name       string `json:"name,omitempty"`
+	ExistingID string `json:"existing_id,omitempty"`
+	Reason     string `json:"reason"`
+	Blocking   bool   `json:"blocking"` // Force cannot override this one
+}
+
+type ImportReport struct {
+	DryRun    bool                         `json:"dry_run"`
+	ClientID  string                       `json:"client_id"`
+	IDMap     map[string]map[string]string `json:"id_map"` // table -> source ID -> imported ID
+	Created   map[string]int               `json:"created"`
+	Updated   map[string]int               `json:"updated"`
+	Conflicts []Conflict                   `json:"conflicts"`
+	Warnings  []string                     `json:"warnings"`
+}
+
+// ErrConflicts is returned by Import when conflicts were found and Force is off
+var ErrConflicts = fmt.Errorf("import has conflicts")
+
+type importer struct {
+	client *supabase.Client
+	opts   ImportOptions
+	report *ImportReport
+	// imported workflow ID -> source ID of its active version
+	activeVersions map[string]string
+}
+
+// Import writes a verified bundle into the project behind client. Every row
+// gets a new ID derived from its source ID, so re-running an import upserts
+// the same rows instead of duplicating them.
+func Import(client *supabase.Client, b *Bundle, opts ImportOptions) (*ImportReport, error) {
+	if err := b.Verify(); err != nil {
+		return nil, err
+	}
+
+	imp := &importer{
+		client:         client,
+		opts:           opts,
+		activeVersions: make(map[string]string),
+		report: &ImportReport{
+			DryRun:    opts.DryRun,
+			IDMap:     make(map[string]map[string]string),
+			Created:   make(map[string]int),
+			Updated:   make(map[string]int),
+			Conflicts: []Conflict{},
+			Warnings:  []string{},
+		},
+	}
+
+	plan, err := imp.remap(b.Data)
+	if err != nil {
+		return nil, err
+	}
+	imp.report.ClientID = db.GetString(plan.Client, "id")
+
+	if err := imp.checkConflicts(plan); err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		return imp.report, nil
+	}
+	for _, conflict := range imp.report.Conflicts {
+		if conflict.Blocking || !opts.Force {
+			return imp.report, ErrConflicts
+		}
+	}
+
+	if err := imp.write(plan); err != nil {
+		return imp.report, err
+	}
+	return imp.report, nil
+}
+
+func (imp *importer) newID(table, sourceId string) string {
+	if sourceId == "" {
+		return ""
+	}
+	id := uuid.NewSHA1(idNamespace, []byte(imp.opts.Namespace+"/"+table+"/"+sourceId)).String()
+	if imp.report.IDMap[table] == nil {
+		imp.report.IDMap[table] = make(map[string]string)
+	}
+	imp.report.IDMap[table][sourceId] = id
+	return id
+}
+
+func (imp *importer) mapped(table, sourceId string) (string, bool) {
+	id, ok := imp.report.IDMap[table][sourceId]
+	return id, ok
+}
+
+// remap copies every row with its own and its parents' IDs rewritten
+func (imp *importer) remap(src Data) (Data, error) {
+	var out Data
+
+	out.Client = copyRow(src.Client)
+	out.Client["id"] = imp.newID(TableClients, db.GetString(src.Client, "id"))
+	if imp.opts.OwnerID != "" {
+		out.Client["owner_id"] = imp.opts.OwnerID
+	}
+
+	for _, row := range src.BusinessUnits {
+		r := copyRow(row)
+		r["id"] = imp.newID(TableBusinessUnits, db.GetString(row, "id"))
+		r["client_id"] = out.Client["id"]
+		out.BusinessUnits = append(out.BusinessUnits, r)
+	}
+
+	for _, row := range src.Environments {
+		buId, ok := imp.mapped(TableBusinessUnits, db.GetString(row, "business_unit_id"))
+		if !ok {
+			return out, fmt.Errorf("environment %s references an unknown business unit", db.GetString(row, "id"))
+		}
+		r := copyRow(row)
+		r["id"] = imp.newID(TableEnvironments, db.GetString(row, "id"))
+		r["business_unit_id"] = buId
+		if imp.opts.OwnerID != "" {
+			r["owner_id"] = imp.opts.OwnerID
+		}
+		out.Environments = append(out.Environments, r)
+	}
+
+	for _, row := range src.Workflows {
+		buId, ok := imp.mapped(TableBusinessUnits, db.GetString(row, "business_unit_id"))
+		if !ok {
+			return out, fmt.Errorf("workflow %s references an unknown business unit", db.GetString(row, "id"))
+		}
+		r := copyRow(row)
+		r["id"] = imp.newID(TableWorkflows, db.GetString(row, "id"))
+		r["business_unit_id"] = buId
+		// Set once the versions exist
+		if active := db.GetString(row, "active_published_version_id"); active != "" {
+			imp.activeVersions[db.GetString(r, "id")] = active
+		}
+		r["active_published_version_id"] = nil
+		// Templates are not part of the bundle
+		if db.GetString(r, "template_id") != "" {
+			imp.report.Warnings = append(imp.report.Warnings,
+				fmt.Sprintf("workflow %q loses its template reference", db.GetString(row, "name")))
+		}
+		if _, ok := r["template_id"]; ok {
+			r["template_id"] = nil
+			r["template_version"] = nil
+		}
+		out.Workflows = append(out.Workflows, r)
+	}
+
+	for _, row := range src.Versions {
+		workflowId, ok := imp.mapped(TableWorkflows, db.GetString(row, "workflow_id"))
+		if !ok {
+			return out, fmt.Errorf("version %s references an unknown workflow", db.GetString(row, "id"))
+		}
+		r := copyRow(row)
+		r["id"] = imp.newID(TableVersions, db.GetString(row, "id"))
+		r["workflow_id"] = workflowId
+		out.Versions = append(out.Versions, r)
+	}
+
+	for _, row := range src.Links {
+		workflowId, okW := imp.mapped(TableWorkflows, db.GetString(row, "workflow_id"))
+		envId, okE := imp.mapped(TableEnvironments, db.GetString(row, "environment_id"))
+		if !okW || !okE {
+			imp.report.Warnings = append(imp.report.Warnings,
+				fmt.Sprintf("skipping link %s: workflow or environment not in bundle", db.GetString(row, "id")))
+			continue
+		}
+		r := copyRow(row)
+		r["id"] = imp.newID(TableLinks, db.GetString(row, "id"))
+		r["workflow_id"] = workflowId
+		r["environment_id"] = envId
+		out.Links = append(out.Links, r)
+	}
+
+	for _, row := range src.Permissions {
+		buId, ok := imp.mapped(TableBusinessUnits, db.GetString(row, "business_unit_id"))
+		if !ok {
+			continue
+		}
+		r := copyRow(row)
+		r["id"] = imp.newID(TablePermissions, db.GetString(row, "id"))
+		r["business_unit_id"] = buId
+		out.Permissions = append(out.Permissions, r)
+	}
+
+	return out, nil
+}
+
+// checkConflicts counts creates vs updates and looks for rows in the target
+// project that would clash with the bundle
+func (imp *importer) checkConflicts(plan Data) error {
+	// The client itself: an existing copy must belong to the importing user
+	existing, err := selectIn(imp.client, TableClients, "id", []string{db.GetString(plan.Client, "id")})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		imp.report.Updated[TableClients] = 1
+		if imp.opts.OwnerID != "" && db.GetString(existing[0], "owner_id") != imp.opts.OwnerID {
+			imp.addConflict(TableClients, plan.Client, db.GetString(existing[0], "id"), "client exists and belongs to another user")
+			imp.report.Conflicts[len(imp.report.Conflicts)-1].Blocking = true
+		}
+	} else {
+		imp.report.Created[TableClients] = 1
+		if imp.opts.OwnerID != "" {
+			data, _, err := imp.client.
+				From(TableClients).
+				Select("id", "", false).
+				Eq("owner_id", imp.opts.OwnerID).
+				Eq("name", db.GetString(plan.Client, "name")).
+				Execute()
+			if err == nil {
+				var same []map[string]interface{}
+				json.Unmarshal(data, &same)
+				for _, s := range same {
+					imp.addConflict(TableClients, plan.Client, db.GetString(s, "id"), "a client with this name already exists")
+				}
+			}
+		}
+	}
+
+	// Rows that already exist are upserted by ID, so each has to hang off a
+	// row of this bundle; otherwise a crafted bundle could overwrite another
+	// client's data
+	clientIds := map[string]bool{db.GetString(plan.Client, "id"): true}
+	buIds := idSet(plan.BusinessUnits)
+	workflowIds := idSet(plan.Workflows)
+
+	tables := []struct {
+		table  string
+		rows   []map[string]interface{}
+		parent string // column whose siblings must have unique names
+		owner  string // column pointing at the row's parent
+		owners map[string]bool
+	}{
+		{TableBusinessUnits, plan.BusinessUnits, "client_id", "client_id", clientIds},
+		{TableEnvironments, plan.Environments, "business_unit_id", "business_unit_id", buIds},
+		{TableWorkflows, plan.Workflows, "business_unit_id", "business_unit_id", buIds},
+		{TableVersions, plan.Versions, "", "workflow_id", workflowIds},
+		{TableLinks, plan.Links, "", "workflow_id", workflowIds},
+		{TablePermissions, plan.Permissions, "", "business_unit_id", buIds},
+	}
+
+	for _, t := range tables {
+		ids := idsOf(t.rows)
+		existingRows, err := selectIn(imp.client, t.table, "id", ids)
+		if err != nil {
+			return err
+		}
+		exists := make(map[string]bool)
+		for _, row := range existingRows {
+			exists[db.GetString(row, "id")] = true
+		}
+		imp.report.Updated[t.table] = len(exists)
+		imp.report.Created[t.table] = len(t.rows) - len(exists)
+
+		for _, row := range existingRows {
+			if !t.owners[db.GetString(row, t.owner)] {
+				imp.addConflict(t.table, row, db.GetString(row, "id"), "row exists and belongs to another client")
+				imp.report.Conflicts[len(imp.report.Conflicts)-1].Blocking = true
+			}
+		}
+
+		if t.parent == "" {
+			continue
+		}
+
+		// Another row with the same name under the same parent
+		parents := uniqueValues(t.rows, t.parent)
+		siblings, err := selectIn(imp.client, t.table, t.parent, parents)
+		if err != nil {
+			return err
+		}
+		byName := make(map[string]string)
+		for _, s := range siblings {
+			byName[db.GetString(s, t.parent)+"/"+db.GetString(s, "name")] = db.GetString(s, "id")
+		}
+		for _, row := range t.rows {
+			other, ok := byName[db.GetString(row, t.parent)+"/"+db.GetString(row, "name")]
+			if ok && other != db.GetString(row, "id") {
+				imp.addConflict(t.table, row, other, "another row with this name already exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (imp *importer) addConflict(table string, row map[string]interface{}, existingId, reason string) {
+	targetId := db.GetString(row, "id")
+	sourceId := ""
+	for src, dst := range imp.report.IDMap[table] {
+		if dst == targetId {
+			sourceId = src
+			break
+		}
+	}
+	imp.report.Conflicts = append(imp.report.Conflicts, Conflict{
+		Table:      table,
+		SourceID:   sourceId,
+		TargetID:   targetId,
+		Name:       db.GetString(row, "name"),
+		ExistingID: existingId,
+		Reason:     reason,
+	})
+}
+
+func (imp *importer) write(plan Data) error {
+	steps := []struct {
+		table      string
+		rows       []map[string]interface{}
+		onConflict string
+	}{
+		{TableClients, []map[string]interface{}{plan.Client}, "id"},
+		{TableBusinessUnits, plan.BusinessUnits, "id"},
+		{TableEnvironments, plan.Environments, "id"},
+		{TableWorkflows, plan.Workflows, "id"},
+		{TableVersions, plan.Versions, "id"},
+		{TableLinks, plan.Links, "workflow_id,environment_id"},
+	}
+
+	for _, s := range steps {
+		if err := imp.upsert(s.table, s.rows, s.onConflict); err != nil {
+			return err
+		}
+	}
+
+	// Now that versions exist, restore each workflow's active version
+	for targetWf, activeSource := range imp.activeVersions {
+		activeTarget, ok := imp.mapped(TableVersions, activeSource)
+		if !ok {
+			continue
+		}
+		_, _, err := imp.client.
+			From(TableWorkflows).
+			Update(map[string]interface{}{"active_published_version_id": activeTarget}, "", "").
+			Eq("id", targetWf).
+			Execute()
+		if err != nil {
+			return fmt.Errorf("failed to set active version of workflow %s: %v", targetWf, err)
+		}
+	}
+
+	// Collaborators point at auth users that may not exist in this project,
+	// so they are written one by one and failures are only reported
+	for _, row := range plan.Permissions {
+		if err := imp.upsert(TablePermissions, []map[string]interface{}{row}, "id"); err != nil {
+			imp.report.Warnings = append(imp.report.Warnings,
+				fmt.Sprintf("collaborator %s not imported: %v", db.GetString(row, "user_id"), err))
+		}
+	}
+
+	return nil
+}
+
+func (imp *importer) upsert(table string, rows []map[string]interface{}, onConflict string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	_, _, err := imp.client.
+		From(table).
+		Upsert(rows, onConflict, "", "").
+		Execute()
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %v", table, err)
+	}
+	return nil
+}
+
+func idSet(rows []map[string]interface{}) map[string]bool {
+	ids := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		ids[db.GetString(row, "id")] = true
+	}
+	return ids
+}
+
+func copyRow(row map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(row))
+	for k, v := range row {
+		out[k] = v
+	}
+	return out
+}
+
+func uniqueValues(rows []map[string]interface{}, column string) []string {
+	seen := make(map[string]bool)
+	var values []string
+	for _, row := range rows {
+		if v := db.GetString(row, column); v != "" && !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
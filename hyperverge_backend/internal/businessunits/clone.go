@@ -0,0 +1,93 @@
+// internal/businessunits/clone.go
+package businessunits
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CloneBUReq struct {
+	TargetClientID string `json:"target_client_id"` // Optional: defaults to the source BU's client
+	Name           string `json:"name"`             // Optional: defaults to "<name> (copy)"
+	IncludeSecrets bool   `json:"include_secrets"`  // Copy environment api keys, headers and secret variables
+}
+
+// CloneReport maps every copied row's old ID to its new ID
+type CloneReport struct {
+	BusinessUnitID string            `json:"business_unit_id"`
+	BusinessUnits  map[string]string `json:"business_units"`
+	Environments   map[string]string `json:"environments"`
+	Workflows      map[string]string `json:"workflows"`
+	Versions       map[string]string `json:"versions"`
+	Links          map[string]string `json:"links"`
+	SecretsCopied  bool              `json:"secrets_copied"`
+}
+
+// Clone deep-copies a business unit, under the same client or another one.
+// The copy is done by the clone_business_unit database function so it either
+// fully succeeds or leaves nothing behind.
+func Clone(c *gin.Context) {
+	buId := c.Param("buId")
+	userId := c.GetString("userId")
+
+	var req CloneBUReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	buData, _, err := db.Client.
+		From("test_business_units").
+		Select("id, client_id", "", false).
+		Eq("id", buId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "database error"})
+		return
+	}
+
+	var buResults []map[string]interface{}
+	if err := json.Unmarshal(buData, &buResults); err != nil || len(buResults) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "business unit not found"})
+		return
+	}
+
+	sourceClientId := db.GetString(buResults[0], "client_id")
+	if req.TargetClientID == "" {
+		req.TargetClientID = sourceClientId
+	}
+
+	// Reading is enough to copy a BU; copying its secrets needs write access
+	if !auth.CanAccessBU(buId, userId, req.IncludeSecrets) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to clone this business unit"})
+		return
+	}
+
+	// Same rule as creating a BU: only the client owner can add one
+	if !auth.OwnsClient(req.TargetClientID, userId) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to create BU in the target client"})
+		return
+	}
+
+	var report CloneReport
+	err = db.CallRPC(db.Client, "clone_business_unit", map[string]interface{}{
+		"p_source_bu_id":     buId,
+		"p_target_client_id": req.TargetClientID,
+		"p_name":             req.Name,
+		"p_owner_id":         userId,
+		"p_include_secrets":  req.IncludeSecrets,
+	}, &report)
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to clone business unit: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
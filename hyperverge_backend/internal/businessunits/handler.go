@@ -30,15 +30,6 @@ type BUResponse struct {
 	UpdatedAt   string `json:"updated_at"`
 }
 
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 func Create(c *gin.Context) {
 	clientId := c.Param("id")
 	var req CreateBUReq
@@ -100,13 +91,13 @@ func Create(c *gin.Context) {
 
 	bu := dbResult[0]
 	response := BUResponse{
-		ID:          getString(bu, "id"),
-		Name:        getString(bu, "name"),
-		Description: getString(bu, "description"),
-		ClientID:    getString(bu, "client_id"),
+		ID:          db.GetString(bu, "id"),
+		Name:        db.GetString(bu, "name"),
+		Description: db.GetString(bu, "description"),
+		ClientID:    db.GetString(bu, "client_id"),
 		OwnerID:     userId, // Set from the client owner who created this BU
-		CreatedAt:   getString(bu, "created_at"),
-		UpdatedAt:   getString(bu, "updated_at"),
+		CreatedAt:   db.GetString(bu, "created_at"),
+		UpdatedAt:   db.GetString(bu, "updated_at"),
 	}
 
 	c.JSON(http.StatusCreated, response)
@@ -213,7 +204,7 @@ func Delete(c *gin.Context) {
 	}
 
 	buResult := buResults[0]
-	clientId := getString(buResult, "client_id")
+	clientId := db.GetString(buResult, "client_id")
 
 	// Verify user owns the client
 	clientData, _, err := db.Client.
@@ -278,7 +269,7 @@ func Share(c *gin.Context) {
 	}
 
 	buResult := buResults[0]
-	clientId := getString(buResult, "client_id")
+	clientId := db.GetString(buResult, "client_id")
 
 	// Verify user owns the client
 	clientData, _, err := db.Client.
@@ -349,7 +340,7 @@ func ListCollaborators(c *gin.Context) {
 	}
 
 	buResult := buResults[0]
-	clientId := getString(buResult, "client_id")
+	clientId := db.GetString(buResult, "client_id")
 
 	// Verify user owns the client
 	clientData, _, err := db.Client.
@@ -415,7 +406,7 @@ func RemoveCollaborator(c *gin.Context) {
 	}
 
 	buResult := buResults[0]
-	clientId := getString(buResult, "client_id")
+	clientId := db.GetString(buResult, "client_id")
 
 	// Verify user owns the client
 	clientData, _, err := db.Client.
@@ -0,0 +1,366 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// ListLegacy lists the original api_documentation table
+// (GET /documentation?category=)
+func ListLegacy(c *gin.Context) {
+	apis, err := LoadLegacyAPIs(Filter{Category: c.Query("category")})
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, apis)
+}
+
+// ListAPIs lists APIs with their inputs and outputs
+// (GET /documentation/new?category=&region=&input=&output=&sort=&limit=&cursor=)
+func ListAPIs(c *gin.Context) {
+	search, ok := readSearch(c)
+	if !ok {
+		return
+	}
+	writePage(c, search)
+}
+
+// SearchAPIs finds APIs by name, input or output
+// (GET /documentation/new/search?q=, with the filters of ListAPIs)
+func SearchAPIs(c *gin.Context) {
+	search, ok := readSearch(c)
+	if !ok {
+		return
+	}
+	if search.Text == "" && search.Input == "" && search.Output == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Search query is required"})
+		return
+	}
+	writePage(c, search)
+}
+
+// SearchCatalog ranks APIs and modules by a free-text query over their
+// names, descriptions, fields and errors, tolerating typos
+// (GET /documentation/search?q=&kind=&category=&limit=)
+func SearchCatalog(c *gin.Context) {
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Search query is required"})
+		return
+	}
+	opts := SearchOptions{Kind: c.Query("kind"), Category: c.Query("category"), Limit: 20}
+	if opts.Kind != "" && opts.Kind != KindAPI && opts.Kind != KindModule {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be api or module"})
+		return
+	}
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 || n > MaxLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("limit must be between 1 and %d", MaxLimit)})
+			return
+		}
+		opts.Limit = n
+	}
+
+	results, backend, err := FullText(q, opts)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, SearchResults{Query: q, Backend: backend, Total: len(results), Results: results})
+}
+
+// GetAPI returns an API with its inputs and outputs
+// (GET /documentation/new/:apiId)
+func GetAPI(c *gin.Context) {
+	api, err := LoadAPI(c.Param("apiId"))
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, api)
+}
+
+// CreateAPI adds an API to the catalog (POST /documentation/new, admins only)
+func CreateAPI(c *gin.Context) {
+	var req APIReq
+	if !bind(c, &req, req.Validate) {
+		return
+	}
+	api, err := SaveAPI("", req)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, api)
+}
+
+// UpdateAPI replaces an API with its inputs and outputs
+// (PUT /documentation/new/:apiId, admins only)
+func UpdateAPI(c *gin.Context) {
+	var req APIReq
+	if !bind(c, &req, req.Validate) {
+		return
+	}
+	api, err := SaveAPI(c.Param("apiId"), req)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, api)
+}
+
+// DeleteAPI removes an API (DELETE /documentation/new/:apiId, admins only)
+func DeleteAPI(c *gin.Context) {
+	if err := RemoveAPI(c.Param("apiId")); err != nil {
+		fail(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListRevisions lists the revisions of an API, newest first
+// (GET /documentation/new/:apiId/revisions)
+func ListRevisions(c *gin.Context) {
+	revisions, err := LoadRevisions(c.Param("apiId"))
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, revisions)
+}
+
+// GetRevision returns a revision of an API
+// (GET /documentation/new/:apiId/revisions/:revision)
+func GetRevision(c *gin.Context) {
+	n, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || n < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "revision must be a positive number"})
+		return
+	}
+	revision, err := LoadRevision(c.Param("apiId"), n)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, revision)
+}
+
+// CheckWorkflow lists the catalog API nodes of a workflow and whether they
+// were copied from the latest revision of their API
+// (GET /workflows/:id/catalog-revisions?version_id=&environment_id=)
+func CheckWorkflow(c *gin.Context) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	checker, err := NewRevisionChecker()
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, checker.Check(g, meta))
+}
+
+// CheckBusinessUnit flags the workflows of a business unit whose draft or
+// active version has API nodes copied from an older catalog revision
+// (GET /business-units/:buId/catalog-revisions)
+func CheckBusinessUnit(c *gin.Context) {
+	buId := c.Param("buId")
+	if !auth.CanAccessBU(buId, c.GetString("userId"), false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+
+	var rows []map[string]interface{}
+	query := db.Client.
+		From("test_workflows").
+		Select("id, active_published_version_id", "", false).
+		Eq("business_unit_id", buId).
+		Order("created_at", &postgrest.OrderOpts{Ascending: true})
+	if err := execute(query, &rows); err != nil {
+		fail(c, err)
+		return
+	}
+	checker, err := NewRevisionChecker()
+	if err != nil {
+		fail(c, err)
+		return
+	}
+
+	flagged := []*WorkflowRevisions{}
+	for _, row := range rows {
+		id := db.GetString(row, "id")
+		sources := []flowgraph.Source{{WorkflowID: id}}
+		if active := db.GetString(row, "active_published_version_id"); active != "" {
+			sources = append(sources, flowgraph.Source{WorkflowID: id, VersionID: active})
+		}
+		for _, src := range sources {
+			g, meta, err := flowgraph.Load(src)
+			if errors.Is(err, flowgraph.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "workflow " + id + ": " + err.Error()})
+				return
+			}
+			if wr := checker.Check(g, meta); wr.Outdated > 0 {
+				flagged = append(flagged, wr)
+			}
+		}
+	}
+	c.JSON(http.StatusOK, flagged)
+}
+
+// ListModules lists SDK modules (GET /modules?category=)
+func ListModules(c *gin.Context) {
+	modules, err := LoadModules(Filter{Category: c.Query("category")})
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, modules)
+}
+
+// SearchModules finds SDK modules by name (GET /modules/search?q=&category=)
+func SearchModules(c *gin.Context) {
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Search query is required"})
+		return
+	}
+	modules, err := LoadModules(Filter{Category: c.Query("category"), Query: q})
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, modules)
+}
+
+// GetModule returns an SDK module (GET /modules/:moduleId)
+func GetModule(c *gin.Context) {
+	m, err := LoadModule(c.Param("moduleId"))
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, m)
+}
+
+// CreateModule adds an SDK module (POST /modules, admins only)
+func CreateModule(c *gin.Context) {
+	var req ModuleReq
+	if !bind(c, &req, func() error { return req.Validate(true) }) {
+		return
+	}
+	m, err := AddModule(req)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, m)
+}
+
+// UpdateModule replaces an SDK module (PUT /modules/:moduleId, admins only)
+func UpdateModule(c *gin.Context) {
+	var req ModuleReq
+	if !bind(c, &req, func() error { return req.Validate(false) }) {
+		return
+	}
+	m, err := SaveModule(c.Param("moduleId"), req)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, m)
+}
+
+// DeleteModule removes an SDK module (DELETE /modules/:moduleId, admins only)
+func DeleteModule(c *gin.Context) {
+	if err := RemoveModule(c.Param("moduleId")); err != nil {
+		fail(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// readSearch reads the search of the API list routes; on failure it has
+// already written the error response
+func readSearch(c *gin.Context) (Search, bool) {
+	s := Search{
+		Text:     c.Query("q"),
+		Category: c.Query("category"),
+		Region:   c.Query("region"),
+		Input:    c.Query("input"),
+		Output:   c.Query("output"),
+		Sort:     c.Query("sort"),
+		Cursor:   c.Query("cursor"),
+	}
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+			return s, false
+		}
+		s.Limit = n
+	}
+	if err := s.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return s, false
+	}
+	return s, true
+}
+
+// writePage responds with the page's APIs. The response stays a plain list;
+// the cursor of the next page is in the X-Next-Cursor header.
+func writePage(c *gin.Context, s Search) {
+	page, err := FindAPIs(s)
+	if errors.Is(err, ErrBadCursor) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	if page.Next != "" {
+		c.Header("X-Next-Cursor", page.Next)
+	}
+	c.JSON(http.StatusOK, page.APIs)
+}
+
+// bind decodes and validates a request body; on failure it has already
+// written the error response
+func bind(c *gin.Context, req interface{}, validate func() error) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	if err := validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+func fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "documentation not found"})
+	case errors.Is(err, ErrConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
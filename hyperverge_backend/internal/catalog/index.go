@@ -0,0 +1,307 @@
+package catalog
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Result kinds
+const (
+	KindAPI    = "api"
+	KindModule = "module"
+)
+
+// Indexed fields, in the order of fieldNames
+const (
+	fieldName = iota
+	fieldDescription
+	fieldInputs
+	fieldOutputs
+	fieldErrors
+	numFields
+)
+
+var fieldNames = [numFields]string{"name", "description", "inputs", "outputs", "errors"}
+
+// fieldWeights rank a match in a name above one in a description
+var fieldWeights = [numFields]float64{4, 1, 2, 2, 0.5}
+
+// maxAge bounds how stale the index gets when the catalog is changed
+// outside this process, by cmd/import for instance
+const maxAge = 10 * time.Minute
+
+// Result is a ranked search hit. Highlights maps matched fields to the
+// matching text, HTML-escaped, with the matched words in <mark>.
+type Result struct {
+	Kind        string              `json:"kind"`
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Category    string              `json:"category"`
+	URL         string              `json:"url,omitempty"`
+	Description string              `json:"description"`
+	Score       float64             `json:"score"`
+	Highlights  map[string][]string `json:"highlights"`
+}
+
+// SearchResults is the response of a full-text search
+type SearchResults struct {
+	Query   string   `json:"query"`
+	Backend string   `json:"backend"`
+	Total   int      `json:"total"`
+	Results []Result `json:"results"`
+}
+
+// SearchOptions narrows a full-text search
+type SearchOptions struct {
+	Kind     string // KindAPI or KindModule; both when empty
+	Category string
+	Limit    int
+}
+
+// Index is an inverted index over the API and module catalog
+type Index struct {
+	docs   []doc
+	terms  map[string][]posting
+	avgLen [numFields]float64
+	built  time.Time
+}
+
+type doc struct {
+	kind, id, name, category, url, description string
+	texts                                      [numFields][]string
+	lengths                                    [numFields]int
+}
+
+type posting struct {
+	doc, field, tf int
+}
+
+// NewIndex indexes APIs and modules
+func NewIndex(apis []API, modules []Module) *Index {
+	idx := &Index{terms: make(map[string][]posting), built: time.Now()}
+	for _, a := range apis {
+		d := doc{kind: KindAPI, id: a.ID, name: a.Name, category: a.Category, url: a.URL, description: a.Description}
+		d.texts[fieldName] = []string{a.Name}
+		d.texts[fieldDescription] = []string{a.Description}
+		for _, f := range a.Inputs {
+			d.texts[fieldInputs] = append(d.texts[fieldInputs], f.Name)
+		}
+		for _, f := range a.Outputs {
+			d.texts[fieldOutputs] = append(d.texts[fieldOutputs], f.Name)
+		}
+		d.texts[fieldErrors] = append(texts(a.ErrorDetails), texts(a.FailureResponses)...)
+		idx.add(d)
+	}
+	for _, m := range modules {
+		d := doc{kind: KindModule, id: m.ID, name: m.Name, category: m.Category, description: m.Description}
+		d.texts[fieldName] = []string{m.Name, m.ID}
+		d.texts[fieldDescription] = []string{m.Description, m.Category}
+		idx.add(d)
+	}
+
+	var total [numFields]int
+	for _, d := range idx.docs {
+		for f := range d.lengths {
+			total[f] += d.lengths[f]
+		}
+	}
+	for f := range total {
+		if len(idx.docs) > 0 {
+			idx.avgLen[f] = math.Max(1, float64(total[f])/float64(len(idx.docs)))
+		}
+	}
+	return idx
+}
+
+func (idx *Index) add(d doc) {
+	n := len(idx.docs)
+	for f, values := range d.texts {
+		counts := make(map[string]int)
+		for _, v := range values {
+			for _, t := range tokenize(v) {
+				counts[t.term]++
+				d.lengths[f]++
+			}
+		}
+		for term, tf := range counts {
+			idx.terms[term] = append(idx.terms[term], posting{doc: n, field: f, tf: tf})
+		}
+	}
+	idx.docs = append(idx.docs, d)
+}
+
+// Search ranks the documents matching any word of the query with BM25 over
+// the weighted fields. Words match their typos and, at the end of the
+// query, the words they start. Documents matching more of the
+// query's words rank higher.
+func (idx *Index) Search(query string, opts SearchOptions) []Result {
+	query = strings.TrimSpace(query)
+	tokens := tokenize(query)
+	words := unique(tokens)
+	if len(words) == 0 {
+		return []Result{}
+	}
+	// The word being typed may be incomplete
+	typing := make(map[string]bool)
+	for _, t := range tokens {
+		if t.end == len(query) {
+			typing[t.term] = true
+		}
+	}
+
+	const k1, b = 1.2, 0.75
+	scores := make(map[int]float64)
+	covered := make(map[int]int)
+	matched := make(map[int]map[string]bool)
+
+	for _, word := range words {
+		best := make(map[int]float64)
+		for term, weight := range idx.expand(word, typing[word]) {
+			postings := idx.terms[term]
+			idf := math.Log(1 + (float64(len(idx.docs))-float64(len(postings))+0.5)/(float64(len(postings))+0.5))
+			for _, p := range postings {
+				d := idx.docs[p.doc]
+				if !opts.matches(d) {
+					continue
+				}
+				tf := float64(p.tf)
+				norm := tf * (k1 + 1) / (tf + k1*(1-b+b*float64(d.lengths[p.field])/idx.avgLen[p.field]))
+				s := weight * fieldWeights[p.field] * idf * norm
+				best[p.doc] = math.Max(best[p.doc], s)
+				if matched[p.doc] == nil {
+					matched[p.doc] = make(map[string]bool)
+				}
+				matched[p.doc][term] = true
+			}
+		}
+		for d, s := range best {
+			scores[d] += s
+			covered[d]++
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for n, s := range scores {
+		d := idx.docs[n]
+		coverage := float64(covered[n]) / float64(len(words))
+		results = append(results, Result{
+			Kind:        d.kind,
+			ID:          d.id,
+			Name:        d.name,
+			Category:    d.category,
+			URL:         d.url,
+			Description: d.description,
+			Score:       math.Round(s*coverage*coverage*1000) / 1000,
+			Highlights:  highlight(d, matched[n]),
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results
+}
+
+// expand maps a query word to the index terms it matches and how much each
+// counts: exact 1, a typo 0.8 or 0.6, a completion of a prefix 0.7
+func (idx *Index) expand(word string, prefix bool) map[string]float64 {
+	out := make(map[string]float64)
+	if _, ok := idx.terms[word]; ok {
+		out[word] = 1
+	}
+	maxDist := 0
+	switch n := len([]rune(word)); {
+	case n >= 8:
+		maxDist = 2
+	case n >= 4:
+		maxDist = 1
+	}
+	for term := range idx.terms {
+		if term == word {
+			continue
+		}
+		if prefix && len(word) >= 3 && strings.HasPrefix(term, word) {
+			out[term] = math.Max(out[term], 0.7)
+			continue
+		}
+		if maxDist > 0 {
+			if d := distance(word, term, maxDist); d <= maxDist {
+				out[term] = math.Max(out[term], 1-0.2*float64(d))
+			}
+		}
+	}
+	return out
+}
+
+func (o SearchOptions) matches(d doc) bool {
+	return (o.Kind == "" || o.Kind == d.kind) && (o.Category == "" || o.Category == d.category)
+}
+
+// The shared index, built on first use and dropped whenever the catalog
+// changes through this process
+var (
+	indexMu sync.Mutex
+	current *Index
+)
+
+// CurrentIndex returns the catalog index, rebuilding it when it was
+// invalidated or is older than maxAge
+func CurrentIndex() (*Index, error) {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+	if current != nil && time.Since(current.built) < maxAge {
+		return current, nil
+	}
+
+	apis, err := LoadAPIs(Filter{})
+	if err != nil {
+		return nil, err
+	}
+	modules, err := LoadModules(Filter{})
+	if err != nil {
+		return nil, err
+	}
+	current = NewIndex(apis, modules)
+	return current, nil
+}
+
+// invalidate drops the index after a catalog change
+func invalidate() {
+	indexMu.Lock()
+	current = nil
+	indexMu.Unlock()
+}
+
+// texts collects the strings of a JSON value, such as the descriptions in
+// error_details
+func texts(v interface{}) []string {
+	var out []string
+	switch val := v.(type) {
+	case string:
+		if strings.TrimSpace(val) != "" {
+			out = append(out, val)
+		}
+	case []interface{}:
+		for _, item := range val {
+			out = append(out, texts(item)...)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			out = append(out, texts(val[k])...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,143 @@
+package catalog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testIndex() *Index {
+	return NewIndex([]API{
+		{
+			ID: "a1", Name: "Verify PAN", Category: "india", URL: "https://ind.idv.hyperverge.co/v1/verifyPAN",
+			Description: "Checks a PAN number against the <NSDL> database & returns the name",
+			Inputs:      []Field{{Name: "panNumber"}},
+			Outputs:     []Field{{Name: "name"}},
+		},
+		{
+			ID: "a2", Name: "Aadhaar OKYC", Category: "india",
+			Description: "Fetches the address from an Aadhaar number",
+			Inputs:      []Field{{Name: "aadhaarNumber"}},
+		},
+		{
+			ID: "a3", Name: "Face match", Category: "global",
+			Description: "Compares a selfie with the photo on a document",
+			Outputs:     []Field{{Name: "matchScore"}},
+		},
+	}, []Module{
+		{ID: "selfie", Name: "Selfie capture", Category: "liveness", Description: "Takes a selfie and checks liveness"},
+	})
+}
+
+func TestSearch(t *testing.T) {
+	idx := testIndex()
+	tests := []struct {
+		name  string
+		query string
+		opts  SearchOptions
+		want  []string // ids in rank order
+	}{
+		{"exact word", "aadhaar", SearchOptions{}, []string{"a2"}},
+		{"name ranks above description", "selfie", SearchOptions{}, []string{"selfie", "a3"}},
+		{"plural matches singular", "documents", SearchOptions{}, []string{"a3"}},
+		{"camelCase part", "pan number", SearchOptions{}, []string{"a1", "a2"}},
+		{"one typo", "adhaar", SearchOptions{}, []string{"a2"}},
+		{"transposed letters", "vreify", SearchOptions{}, []string{"a1"}},
+		{"two typos in a long word", "livenesss", SearchOptions{}, []string{"selfie"}},
+		{"short words need an exact match", "pam", SearchOptions{}, []string{}},
+		{"prefix of the word being typed", "fac", SearchOptions{}, []string{"a3"}},
+		{"prefix only at the end of the query", "fac match", SearchOptions{}, []string{"a3"}},
+		{"more words matched ranks higher", "face selfie", SearchOptions{}, []string{"a3", "selfie"}},
+		{"kind filter", "selfie", SearchOptions{Kind: KindAPI}, []string{"a3"}},
+		{"category filter", "selfie", SearchOptions{Category: "global"}, []string{"a3"}},
+		{"limit", "selfie", SearchOptions{Limit: 1}, []string{"selfie"}},
+		{"empty query", "  ", SearchOptions{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := []string{}
+			for _, r := range idx.Search(tt.query, tt.opts) {
+				ids = append(ids, r.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	idx := testIndex()
+	tests := []struct {
+		word   string
+		prefix bool
+		term   string
+		want   float64 // 0 when the term doesn't match
+	}{
+		{"aadhaar", false, "aadhaar", 1},
+		{"adhaar", false, "aadhaar", 0.8},
+		{"livenesss", false, "liveness", 0.8},
+		{"lievnesss", false, "liveness", 0.6},
+		{"fac", true, "face", 0.7},
+		{"fac", false, "face", 0},
+		{"fa", true, "face", 0},
+		{"pam", false, "pan", 0},
+	}
+	for _, tt := range tests {
+		if got := idx.expand(tt.word, tt.prefix)[tt.term]; got != tt.want {
+			t.Errorf("expand(%q, %v)[%q] = %v, want %v", tt.word, tt.prefix, tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	long := strings.Repeat("lorem ipsum ", 20) + "the selfie is checked " + strings.Repeat("dolor sit ", 20)
+	tests := []struct {
+		name    string
+		text    string
+		matched []string
+		want    string
+	}{
+		{"word", "Face match", []string{"match"}, "Face <mark>match</mark>"},
+		{"html is escaped", "Checks <NSDL> & returns", []string{"nsdl"}, "Checks &lt;<mark>NSDL</mark>&gt; &amp; returns"},
+		{"markup in a match is escaped", `a "<b>" tag`, []string{"b"}, `a &#34;&lt;<mark>b</mark>&gt;&#34; tag`},
+		{"camelCase word marked once", "panNumber", []string{"pan", "pannumber"}, "<mark>panNumber</mark>"},
+		{"stemmed match", "Documents", []string{"document"}, "<mark>Documents</mark>"},
+		{"nothing matched", "Face match", []string{"pan"}, ""},
+		{"long text cut around the match", long, []string{"selfie"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := make(map[string]bool)
+			for _, m := range tt.matched {
+				matched[m] = true
+			}
+			got := mark(tt.text, matched)
+			if tt.text == long {
+				if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") || !strings.Contains(got, "<mark>selfie</mark>") {
+					t.Errorf("mark() = %q, want a snippet around the match", got)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("mark() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchHighlights(t *testing.T) {
+	results := testIndex().Search("nsdl pan", SearchOptions{})
+	if len(results) == 0 || results[0].ID != "a1" {
+		t.Fatalf("results = %v, want Verify PAN first", results)
+	}
+	h := results[0].Highlights
+	want := map[string][]string{
+		"name":        {"Verify <mark>PAN</mark>"},
+		"description": {"Checks a <mark>PAN</mark> number against the &lt;<mark>NSDL</mark>&gt; database &amp; returns the name"},
+		"inputs":      {"<mark>panNumber</mark>"},
+	}
+	if !reflect.DeepEqual(h, want) {
+		t.Errorf("highlights = %v, want %v", h, want)
+	}
+}
@@ -0,0 +1,101 @@
+// Package catalog is the documentation of HyperVerge APIs (with their
+// inputs and outputs) and SDK modules that workflow nodes are built from.
+// Everyone signed in can read it; only admins can change it.
+package catalog
+
+// API categories
+const (
+	CategoryIndia  = "india_api"
+	CategoryGlobal = "global_api"
+)
+
+// API is a row of api_documentation_new with its inputs and outputs. The
+// JSON names are the table's, so responses keep the shape PostgREST gave.
+type API struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name"`
+	Description      string        `json:"description"`
+	URL              string        `json:"url"`
+	Category         string        `json:"category"`
+	CurlExample      string        `json:"curl_example"`
+	SuccessResponse  interface{}   `json:"success_response"`
+	FailureResponses []interface{} `json:"failure_responses"`
+	ErrorDetails     []interface{} `json:"error_details"`
+	CreatedAt        string        `json:"created_at,omitempty"`
+	UpdatedAt        string        `json:"updated_at,omitempty"`
+	Revision         int           `json:"revision"`
+	Inputs           []Field       `json:"api_inputs_new"`
+	Outputs          []Field       `json:"api_outputs_new"`
+}
+
+// Field is a row of api_inputs_new or api_outputs_new
+type Field struct {
+	ID          string `json:"id,omitempty"`
+	APIID       string `json:"api_id,omitempty"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+// Module is a row of module_documentation_new. Its id is the moduleType
+// SDK module nodes refer to.
+type Module struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Category    string   `json:"category"`
+	Color       string   `json:"color"`
+	Icon        string   `json:"icon"`
+	CspURLs     []string `json:"csp_urls"`
+	IPAddresses []string `json:"ip_addresses"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+	UpdatedAt   string   `json:"updated_at,omitempty"`
+}
+
+// LegacyAPI is a row of the original api_documentation table
+type LegacyAPI struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name"`
+	Description      string        `json:"description"`
+	URL              string        `json:"url"`
+	Category         string        `json:"category"`
+	CurlExample      string        `json:"curl_example"`
+	SuccessResponse  interface{}   `json:"success_response"`
+	FailureResponses []interface{} `json:"failure_responses"`
+	ErrorDetails     []interface{} `json:"error_details"`
+	CreatedAt        string        `json:"created_at"`
+	UpdatedAt        string        `json:"updated_at"`
+}
+
+type APIReq struct {
+	Name             string        `json:"name"`
+	Description      string        `json:"description"`
+	URL              string        `json:"url"`
+	Category         string        `json:"category"`
+	CurlExample      string        `json:"curl_example"`
+	SuccessResponse  interface{}   `json:"success_response"`
+	FailureResponses []interface{} `json:"failure_responses"`
+	ErrorDetails     []interface{} `json:"error_details"`
+	Inputs           []FieldReq    `json:"inputs"`  // Replaces all inputs
+	Outputs          []FieldReq    `json:"outputs"` // Replaces all outputs
+}
+
+type FieldReq struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+}
+
+type ModuleReq struct {
+	ID          string   `json:"id"` // Required on create, ignored on update
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Category    string   `json:"category"`
+	Color       string   `json:"color"`
+	Icon        string   `json:"icon"`
+	CspURLs     []string `json:"csp_urls"`
+	IPAddresses []string `json:"ip_addresses"`
+}
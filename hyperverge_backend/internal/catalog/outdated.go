@@ -0,0 +1,183 @@
+package catalog
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Node statuses
+const (
+	NodeCurrent  = "current"
+	NodeOutdated = "outdated"
+	NodeUnknown  = "unknown" // edited on the canvas, or copied before revisions were kept
+)
+
+// NodeRevision is an API node of a workflow and the catalog revision its
+// data was copied from
+type NodeRevision struct {
+	NodeID   string   `json:"node_id"`
+	Title    string   `json:"title"`
+	APIID    string   `json:"api_id"`
+	APIName  string   `json:"api_name"`
+	Revision int      `json:"revision,omitempty"` // 0 when unknown
+	Latest   int      `json:"latest"`
+	Status   string   `json:"status"`
+	Changes  *Changes `json:"changes,omitempty"` // since Revision, when outdated
+}
+
+// WorkflowRevisions lists the catalog API nodes of a workflow graph
+type WorkflowRevisions struct {
+	Workflow *flowgraph.Meta `json:"workflow"`
+	Nodes    []NodeRevision  `json:"nodes"`
+	Outdated int             `json:"outdated"`
+}
+
+// nodeAPIID is the API id in the id the canvas gives nodes dropped from the
+// catalog: api-doc-<api id>-<timestamp>
+var nodeAPIID = regexp.MustCompile(`^api-doc-([0-9a-fA-F-]{36})-`)
+
+// documentationHost serves the pages the catalog is scraped from
+var documentationHost = regexp.MustCompile(`^https?://documentation\.hyperverge\.co/`)
+
+// RevisionChecker finds the catalog revision workflow nodes were copied
+// from. It loads the catalog once, so one checker serves many workflows.
+type RevisionChecker struct {
+	byID      map[string]*API
+	byURL     map[string]*API
+	revisions map[string][]Revision
+}
+
+func NewRevisionChecker() (*RevisionChecker, error) {
+	apis, err := LoadAPIs(Filter{})
+	if err != nil {
+		return nil, err
+	}
+	ch := &RevisionChecker{byID: make(map[string]*API), byURL: make(map[string]*API)}
+	ids := make([]string, 0, len(apis))
+	for i := range apis {
+		a := &apis[i]
+		ch.byID[a.ID] = a
+		ch.byURL[a.URL] = a
+		ids = append(ids, a.ID)
+	}
+	if ch.revisions, err = loadRevisionsOf(ids); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// Check lists the API nodes of g that were copied from the catalog. A node
+// is outdated when it was copied from an older revision of its API.
+func (ch *RevisionChecker) Check(g *flowgraph.Graph, meta *flowgraph.Meta) *WorkflowRevisions {
+	wr := &WorkflowRevisions{Workflow: meta, Nodes: []NodeRevision{}}
+	for _, n := range g.Nodes {
+		if n.Type != flowgraph.NodeAPIModule {
+			continue
+		}
+		api, _ := ch.Match(n)
+		if api == nil {
+			continue
+		}
+
+		nr := NodeRevision{
+			NodeID:   n.ID,
+			Title:    n.Label(),
+			APIID:    api.ID,
+			APIName:  api.Name,
+			Revision: ch.copiedFrom(n, api),
+			Latest:   api.Revision,
+		}
+		switch {
+		case nr.Revision == 0:
+			nr.Status = NodeUnknown
+		case nr.Revision >= nr.Latest:
+			nr.Status = NodeCurrent
+		default:
+			nr.Status = NodeOutdated
+			if old := ch.revision(api.ID, nr.Revision); old != nil {
+				changes := Compare(old.Snapshot, api.Request())
+				nr.Changes = &changes
+			}
+			wr.Outdated++
+		}
+		wr.Nodes = append(wr.Nodes, nr)
+	}
+	sort.SliceStable(wr.Nodes, func(i, j int) bool { return wr.Nodes[i].Title < wr.Nodes[j].Title })
+	return wr
+}
+
+// Match returns the catalog API a node was created from: recorded on the
+// node by the canvas, else taken from its id or its documentation URL.
+// fromCatalog reports whether the node looks created from the catalog at
+// all, so a nil API with fromCatalog means the API was removed.
+func (ch *RevisionChecker) Match(n flowgraph.Node) (api *API, fromCatalog bool) {
+	id := n.Str("catalogApiId")
+	if m := nodeAPIID.FindStringSubmatch(n.ID); id == "" && m != nil {
+		id = m[1]
+	}
+	if api := ch.byID[id]; api != nil {
+		return api, true
+	}
+	for _, key := range []string{"docUrl", "endpoint"} {
+		if api := ch.byURL[n.Str(key)]; api != nil {
+			return api, true
+		}
+	}
+	return nil, id != "" || documentationHost.MatchString(n.Str("docUrl"))
+}
+
+// Revisions returns the revisions of an API, newest first
+func (ch *RevisionChecker) Revisions(apiID string) []Revision {
+	return ch.revisions[apiID]
+}
+
+// copiedFrom is the revision recorded on the node, else the newest revision
+// whose inputs, outputs and curl example the node still has
+func (ch *RevisionChecker) copiedFrom(n flowgraph.Node, api *API) int {
+	if rev, ok := n.Data["catalogRevision"].(float64); ok && rev > 0 {
+		return int(rev)
+	}
+	call := n.APICall()
+	fp := fingerprint(call.Inputs, call.Outputs, n.Str("curlExample"))
+	for _, r := range ch.revisions[api.ID] {
+		if fp == fingerprint(fieldsOf(r.Snapshot.Inputs), fieldsOf(r.Snapshot.Outputs), r.Snapshot.CurlExample) {
+			return r.Revision
+		}
+	}
+	// Before revisions are kept, the API itself is the only known state
+	if len(ch.revisions[api.ID]) == 0 {
+		req := api.Request()
+		if fp == fingerprint(fieldsOf(req.Inputs), fieldsOf(req.Outputs), req.CurlExample) {
+			return api.Revision
+		}
+	}
+	return 0
+}
+
+func (ch *RevisionChecker) revision(apiID string, n int) *Revision {
+	for i, r := range ch.revisions[apiID] {
+		if r.Revision == n {
+			return &ch.revisions[apiID][i]
+		}
+	}
+	return nil
+}
+
+// fingerprint is what a node copies of an API, in a comparable form
+func fingerprint(inputs, outputs []flowgraph.Field, curl string) string {
+	data, _ := json.Marshal([]interface{}{inputs, outputs, curl})
+	return string(data)
+}
+
+func fieldsOf(fields []FieldReq) []flowgraph.Field {
+	var out []flowgraph.Field
+	for _, f := range fields {
+		if f.Name != "" {
+			out = append(out, flowgraph.Field{Name: f.Name, Type: f.Type, Required: f.Required})
+		}
+	}
+	return out
+}
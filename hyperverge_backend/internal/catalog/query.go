@@ -0,0 +1,205 @@
+package catalog
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/supabase-community/postgrest-go"
+)
+
+// SortColumns are the columns APIs can be sorted by. They are never null,
+// which keyset pagination relies on.
+var SortColumns = []string{"name", "category", "created_at", "updated_at"}
+
+// MaxLimit caps a page
+const MaxLimit = 200
+
+// ErrBadCursor is returned for a cursor that wasn't issued for the same sort
+var ErrBadCursor = fmt.Errorf("invalid cursor")
+
+// regionCode is a regional host prefix such as ind, usa or zaf
+var regionCode = regexp.MustCompile(`^[a-z]{2,4}$`)
+
+// Search selects APIs. Every value is sent as data: plain filters go in
+// their own URL parameter, and values inside logic trees are quoted, so
+// user input can't add filters or break the query.
+type Search struct {
+	Text     string // name contains
+	Category string
+	Region   string // served from a regional host, e.g. ind or usa
+	Input    string // has an input whose name contains
+	Output   string // has an output whose name contains
+	Sort     string // one of SortColumns, "-" prefixed for descending
+	Limit    int    // 0 returns every match
+	Cursor   string // Page.Next of the previous page
+}
+
+// Page is a page of APIs. Next is empty on the last page.
+type Page struct {
+	APIs []API
+	Next string
+}
+
+type cursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+// Validate checks the sort, limit and region and fills in defaults
+func (s *Search) Validate() error {
+	s.Text = strings.TrimSpace(s.Text)
+	s.Input = strings.TrimSpace(s.Input)
+	s.Output = strings.TrimSpace(s.Output)
+	s.Region = strings.ToLower(strings.TrimSpace(s.Region))
+	if s.Sort == "" {
+		s.Sort = "name"
+	}
+	if !contains(SortColumns, strings.TrimPrefix(s.Sort, "-")) {
+		return fmt.Errorf("sort must be one of %s, optionally prefixed with -", strings.Join(SortColumns, ", "))
+	}
+	if s.Limit < 0 || s.Limit > MaxLimit {
+		return fmt.Errorf("limit must be between 1 and %d", MaxLimit)
+	}
+	if s.Region != "" && !regionCode.MatchString(s.Region) {
+		return fmt.Errorf("region must be a regional host prefix such as ind or usa")
+	}
+	if s.Cursor != "" && s.Limit == 0 {
+		return fmt.Errorf("cursor needs a limit")
+	}
+	return nil
+}
+
+// FindAPIs runs a validated search
+func FindAPIs(s Search) (*Page, error) {
+	column := strings.TrimPrefix(s.Sort, "-")
+	ascending := !strings.HasPrefix(s.Sort, "-")
+
+	// The filtered embeddings are aliased so the api_inputs_new and
+	// api_outputs_new lists in the response stay complete
+	columns := apiColumns
+	if s.Input != "" {
+		columns += ", input_match:api_inputs_new!inner(name)"
+	}
+	if s.Output != "" {
+		columns += ", output_match:api_outputs_new!inner(name)"
+	}
+	query := client().From("api_documentation_new").Select(columns, "", false)
+
+	if s.Category != "" {
+		query = query.Eq("category", s.Category)
+	}
+	if s.Text != "" {
+		query = query.Ilike("name", likePattern(s.Text))
+	}
+	if s.Input != "" {
+		query = query.Ilike("input_match.name", likePattern(s.Input))
+	}
+	if s.Output != "" {
+		query = query.Ilike("output_match.name", likePattern(s.Output))
+	}
+
+	var tree []string
+	if s.Region != "" {
+		region := []string{
+			condition("curl_example", "ilike", "*://"+s.Region+".*"),
+			condition("curl_example", "ilike", "*://"+s.Region+"-*"),
+		}
+		// India APIs are only served from India
+		if s.Region == "ind" {
+			region = append(region, condition("category", "eq", CategoryIndia))
+		}
+		tree = append(tree, "or("+strings.Join(region, ",")+")")
+	}
+	if s.Cursor != "" {
+		after, err := decodeCursor(s.Cursor, s.Sort)
+		if err != nil {
+			return nil, err
+		}
+		op := "gt"
+		if !ascending {
+			op = "lt"
+		}
+		tree = append(tree, "or("+condition(column, op, after.Value)+
+			",and("+condition(column, "eq", after.Value)+","+condition("id", op, after.ID)+"))")
+	}
+	if len(tree) > 0 {
+		query = query.And(strings.Join(tree, ","), "")
+	}
+
+	query = query.
+		Order(column, &postgrest.OrderOpts{Ascending: ascending}).
+		Order("id", &postgrest.OrderOpts{Ascending: ascending})
+	if s.Limit > 0 {
+		// One more row tells whether there is a next page
+		query = query.Limit(s.Limit+1, "")
+	}
+
+	apis := []API{}
+	if err := execute(query, &apis); err != nil {
+		return nil, err
+	}
+	for i := range apis {
+		apis[i].normalize()
+	}
+
+	page := &Page{APIs: apis}
+	if s.Limit > 0 && len(apis) > s.Limit {
+		page.APIs = apis[:s.Limit]
+		last := page.APIs[s.Limit-1]
+		page.Next = encodeCursor(cursor{Sort: s.Sort, Value: last.sortValue(column), ID: last.ID})
+	}
+	return page, nil
+}
+
+func (a API) sortValue(column string) string {
+	switch column {
+	case "category":
+		return a.Category
+	case "created_at":
+		return a.CreatedAt
+	case "updated_at":
+		return a.UpdatedAt
+	}
+	return a.Name
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s, sort string) (cursor, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || json.Unmarshal(data, &c) != nil || c.Sort != sort || c.ID == "" {
+		return cursor{}, ErrBadCursor
+	}
+	return c, nil
+}
+
+// condition writes a column.operator."value" item of a PostgREST logic
+// tree. Quoting keeps , . : ( ) in the value from being read as syntax.
+func condition(column, operator, value string) string {
+	return column + "." + operator + "." + quote(value)
+}
+
+func quote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
+
+// likePattern matches names containing text. LIKE wildcards in the text are
+// escaped; PostgREST reads every * as a wildcard, so those match any single
+// character instead.
+func likePattern(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\\`)
+	text = strings.ReplaceAll(text, `%`, `\%`)
+	text = strings.ReplaceAll(text, `_`, `\_`)
+	text = strings.ReplaceAll(text, `*`, `_`)
+	return "*" + text + "*"
+}
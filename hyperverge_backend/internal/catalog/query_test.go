@@ -0,0 +1,39 @@
+package catalog
+
+import "testing"
+
+func TestQuote(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{`face`, `"face"`},
+		{`a,b`, `"a,b"`},
+		{`name.eq.x)`, `"name.eq.x)"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`back\slash`, `"back\\slash"`},
+		{`\"`, `"\\\""`},
+		{`O'Brien`, `"O'Brien"`},
+		{``, `""`},
+	}
+	for _, tt := range tests {
+		if got := quote(tt.in); got != tt.want {
+			t.Errorf("quote(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLikePattern(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{`face`, `*face*`},
+		{`100%`, `*100\%*`},
+		{`face_match`, `*face\_match*`},
+		{`a*b`, `*a_b*`},
+		{`c:\tmp`, `*c:\\tmp*`},
+		{`%_\`, `*\%\_\\*`},
+		{`O'Brien`, `*O'Brien*`},
+		{``, `**`},
+	}
+	for _, tt := range tests {
+		if got := likePattern(tt.in); got != tt.want {
+			t.Errorf("likePattern(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"hypervision_backend/internal/db"
+
+	"github.com/supabase-community/postgrest-go"
+)
+
+// Where a revision came from
+const (
+	SourceEdit   = "edit"
+	SourceImport = "import"
+)
+
+// How a field or an imported API changed
+const (
+	ChangeAdded     = "added"
+	ChangeChanged   = "changed"
+	ChangeRemoved   = "removed"
+	ChangeUnchanged = "unchanged"
+)
+
+// Revision is a saved state of an API and what changed from the one before
+type Revision struct {
+	ID        string  `json:"id"`
+	APIID     string  `json:"api_id"`
+	Revision  int     `json:"revision"`
+	Source    string  `json:"source"`
+	Snapshot  APIReq  `json:"snapshot"`
+	Changes   Changes `json:"changes"`
+	CreatedAt string  `json:"created_at"`
+}
+
+// Changes lists what differs between two revisions of an API
+type Changes struct {
+	Attributes []string      `json:"attributes"` // changed columns, e.g. description or error_details
+	Fields     []FieldChange `json:"fields"`
+}
+
+// FieldChange is an input or output that was added, changed or removed
+type FieldChange struct {
+	Kind   string    `json:"kind"` // input or output
+	Name   string    `json:"name"`
+	Change string    `json:"change"`
+	From   *FieldReq `json:"from,omitempty"`
+	To     *FieldReq `json:"to,omitempty"`
+}
+
+// ImportResult is what importing an API did to the catalog
+type ImportResult struct {
+	APIID    string  `json:"api_id"`
+	Name     string  `json:"name"`
+	URL      string  `json:"url"`
+	Status   string  `json:"status"` // added, changed or unchanged
+	Revision int     `json:"revision"`
+	Changes  Changes `json:"changes"`
+}
+
+// Empty reports whether nothing changed
+func (c Changes) Empty() bool {
+	return len(c.Attributes) == 0 && len(c.Fields) == 0
+}
+
+// Count returns the number of fields with the given change
+func (c Changes) Count(change string) int {
+	n := 0
+	for _, f := range c.Fields {
+		if f.Change == change {
+			n++
+		}
+	}
+	return n
+}
+
+// Compare lists the changes from one state of an API to another. Both must
+// have been validated, or come from the catalog.
+func Compare(from, to APIReq) Changes {
+	changes := Changes{Attributes: []string{}, Fields: []FieldChange{}}
+	attributes := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"name", from.Name, to.Name},
+		{"description", from.Description, to.Description},
+		{"url", from.URL, to.URL},
+		{"category", from.Category, to.Category},
+		{"curl_example", from.CurlExample, to.CurlExample},
+		{"success_response", from.SuccessResponse, to.SuccessResponse},
+		{"failure_responses", from.FailureResponses, to.FailureResponses},
+		{"error_details", from.ErrorDetails, to.ErrorDetails},
+	}
+	for _, a := range attributes {
+		if !sameJSON(a.from, a.to) {
+			changes.Attributes = append(changes.Attributes, a.name)
+		}
+	}
+	changes.Fields = append(compareFields("input", from.Inputs, to.Inputs), compareFields("output", from.Outputs, to.Outputs)...)
+	return changes
+}
+
+func compareFields(kind string, from, to []FieldReq) []FieldChange {
+	before := make(map[string]FieldReq)
+	for _, f := range from {
+		before[f.Name] = f
+	}
+	var out []FieldChange
+	seen := make(map[string]bool)
+	for i := range to {
+		f := to[i]
+		seen[f.Name] = true
+		old, ok := before[f.Name]
+		switch {
+		case !ok:
+			out = append(out, FieldChange{Kind: kind, Name: f.Name, Change: ChangeAdded, To: &f})
+		case old != f:
+			out = append(out, FieldChange{Kind: kind, Name: f.Name, Change: ChangeChanged, From: &old, To: &f})
+		}
+	}
+	for i := range from {
+		f := from[i]
+		if !seen[f.Name] {
+			out = append(out, FieldChange{Kind: kind, Name: f.Name, Change: ChangeRemoved, From: &f})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// sameJSON compares JSON values the way they are stored, so key order and
+// number types don't count
+func sameJSON(a, b interface{}) bool {
+	x, errX := json.Marshal(a)
+	y, errY := json.Marshal(b)
+	if errX != nil || errY != nil {
+		return false
+	}
+	var u, v interface{}
+	json.Unmarshal(x, &u)
+	json.Unmarshal(y, &v)
+	x, _ = json.Marshal(u)
+	y, _ = json.Marshal(v)
+	return string(x) == string(y)
+}
+
+// Request returns the API as it would be saved
+func (a API) Request() APIReq {
+	req := APIReq{
+		Name:             a.Name,
+		Description:      a.Description,
+		URL:              a.URL,
+		Category:         a.Category,
+		CurlExample:      a.CurlExample,
+		SuccessResponse:  a.SuccessResponse,
+		FailureResponses: a.FailureResponses,
+		ErrorDetails:     a.ErrorDetails,
+		Inputs:           []FieldReq{},
+		Outputs:          []FieldReq{},
+	}
+	for _, f := range a.Inputs {
+		req.Inputs = append(req.Inputs, FieldReq{Name: f.Name, Type: f.Type, Description: f.Description, Required: f.Required})
+	}
+	for _, f := range a.Outputs {
+		req.Outputs = append(req.Outputs, FieldReq{Name: f.Name, Type: f.Type, Description: f.Description, Required: f.Required})
+	}
+	return req
+}
+
+// ImportAPI adds an API or updates the one with the same URL. Importing the
+// same documentation twice changes nothing the second time. The request must
+// have been validated.
+func ImportAPI(req APIReq) (*ImportResult, error) {
+	id, err := apiIDByURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	api, changes, err := save(id, req, SourceImport)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{APIID: api.ID, Name: api.Name, URL: api.URL, Revision: api.Revision, Changes: changes}
+	switch {
+	case id == "":
+		result.Status = ChangeAdded
+	case changes.Empty():
+		result.Status = ChangeUnchanged
+	default:
+		result.Status = ChangeChanged
+	}
+	return result, nil
+}
+
+// PreviewImport reports what ImportAPI would do, without changing the
+// catalog. The request must have been validated.
+func PreviewImport(req APIReq) (*ImportResult, error) {
+	id, err := apiIDByURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return &ImportResult{Name: req.Name, URL: req.URL, Status: ChangeAdded, Revision: 1, Changes: Compare(APIReq{}, req)}, nil
+	}
+
+	current, err := LoadAPI(id)
+	if err != nil {
+		return nil, err
+	}
+	result := &ImportResult{APIID: id, Name: req.Name, URL: req.URL, Status: ChangeUnchanged, Revision: current.Revision}
+	result.Changes = Compare(current.Request(), req)
+	if !result.Changes.Empty() {
+		result.Status = ChangeChanged
+		result.Revision++
+	}
+	return result, nil
+}
+
+// LoadRevisions lists the revisions of an API, newest first
+func LoadRevisions(apiID string) ([]Revision, error) {
+	if _, err := LoadAPI(apiID); err != nil {
+		return nil, err
+	}
+	query := client().
+		From("api_documentation_revisions").
+		Select("*", "", false).
+		Eq("api_id", apiID).
+		Order("revision", &postgrest.OrderOpts{Ascending: false})
+
+	revisions := []Revision{}
+	return revisions, execute(query, &revisions)
+}
+
+func LoadRevision(apiID string, revision int) (*Revision, error) {
+	var revisions []Revision
+	query := client().
+		From("api_documentation_revisions").
+		Select("*", "", false).
+		Eq("api_id", apiID).
+		Eq("revision", strconv.Itoa(revision))
+	if err := execute(query, &revisions); err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, ErrNotFound
+	}
+	return &revisions[0], nil
+}
+
+// loadRevisionsOf returns the revisions of several APIs by API, newest first
+func loadRevisionsOf(apiIDs []string) (map[string][]Revision, error) {
+	out := make(map[string][]Revision)
+	if len(apiIDs) == 0 {
+		return out, nil
+	}
+	query := client().
+		From("api_documentation_revisions").
+		Select("*", "", false).
+		In("api_id", apiIDs).
+		Order("revision", &postgrest.OrderOpts{Ascending: false})
+
+	var revisions []Revision
+	if err := execute(query, &revisions); err != nil {
+		return nil, err
+	}
+	for _, r := range revisions {
+		out[r.APIID] = append(out[r.APIID], r)
+	}
+	return out, nil
+}
+
+func apiIDByURL(url string) (string, error) {
+	var rows []map[string]interface{}
+	err := execute(client().From("api_documentation_new").Select("id", "", false).Eq("url", url), &rows)
+	if err != nil {
+		return "", err
+	}
+	if len(rows) == 0 {
+		return "", nil
+	}
+	if len(rows) > 1 {
+		return "", fmt.Errorf("%d APIs have the url %s", len(rows), url)
+	}
+	return db.GetString(rows[0], "id"), nil
+}
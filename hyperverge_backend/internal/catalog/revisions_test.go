@@ -0,0 +1,186 @@
+package catalog
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"hypervision_backend/internal/flowgraph"
+)
+
+func TestCompare(t *testing.T) {
+	base := APIReq{
+		Name:            "Verify PAN",
+		URL:             "https://documentation.hyperverge.co/api-reference/india/verify-pan",
+		SuccessResponse: map[string]interface{}{"status": "success", "code": float64(200)},
+		Inputs:          []FieldReq{{Name: "pan", Type: "string", Required: true}, {Name: "name", Type: "string"}},
+		Outputs:         []FieldReq{{Name: "status", Type: "string"}},
+	}
+
+	tests := []struct {
+		name       string
+		edit       func(*APIReq)
+		attributes []string
+		fields     []string // kind name change
+	}{
+		{name: "unchanged", edit: func(*APIReq) {}},
+		{
+			name:       "same JSON, other key order and number type",
+			edit:       func(a *APIReq) { a.SuccessResponse = map[string]interface{}{"code": 200, "status": "success"} },
+			attributes: nil,
+		},
+		{
+			name:       "changed response and moved doc URL",
+			edit:       func(a *APIReq) { a.SuccessResponse = nil; a.URL += "-v2" },
+			attributes: []string{"url", "success_response"},
+		},
+		{
+			name:   "added input",
+			edit:   func(a *APIReq) { a.Inputs = append(a.Inputs, FieldReq{Name: "dob", Type: "string", Required: true}) },
+			fields: []string{"input dob added"},
+		},
+		{
+			name:   "input made required",
+			edit:   func(a *APIReq) { a.Inputs = []FieldReq{a.Inputs[0], {Name: "name", Type: "string", Required: true}} },
+			fields: []string{"input name changed"},
+		},
+		{
+			name:   "removed output",
+			edit:   func(a *APIReq) { a.Outputs = nil },
+			fields: []string{"output status removed"},
+		},
+		{
+			name: "renamed input is removed and added",
+			edit: func(a *APIReq) {
+				a.Inputs = []FieldReq{{Name: "panNumber", Type: "string", Required: true}, a.Inputs[1]}
+			},
+			fields: []string{"input pan removed", "input panNumber added"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := base
+			to.Inputs = append([]FieldReq(nil), base.Inputs...)
+			to.Outputs = append([]FieldReq(nil), base.Outputs...)
+			tt.edit(&to)
+
+			c := Compare(base, to)
+			if strings.Join(c.Attributes, ",") != strings.Join(tt.attributes, ",") {
+				t.Errorf("attributes = %v, want %v", c.Attributes, tt.attributes)
+			}
+			var fields []string
+			for _, f := range c.Fields {
+				fields = append(fields, f.Kind+" "+f.Name+" "+f.Change)
+				if (f.Change == ChangeAdded) != (f.From == nil) || (f.Change == ChangeRemoved) != (f.To == nil) {
+					t.Errorf("%s %s: from %v, to %v", f.Name, f.Change, f.From, f.To)
+				}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("fields = %v, want %v", fields, tt.fields)
+			}
+			if c.Empty() != (len(tt.attributes) == 0 && len(tt.fields) == 0) {
+				t.Errorf("Empty() = %v", c.Empty())
+			}
+		})
+	}
+}
+
+const panID = "0b7f6c1e-2d3a-4c5b-8e9f-1a2b3c4d5e6f"
+
+func testChecker() *RevisionChecker {
+	api := &API{
+		ID: panID, Name: "Verify PAN", Revision: 2,
+		URL:         "https://documentation.hyperverge.co/api-reference/india/verify-pan",
+		CurlExample: "curl https://ind.idv.hyperverge.co/v1/verifyPAN",
+		Inputs:      []Field{{Name: "pan", Type: "string", Required: true}, {Name: "dob", Type: "string", Required: true}},
+	}
+	old := api.Request()
+	old.Inputs = old.Inputs[:1]
+	return &RevisionChecker{
+		byID:  map[string]*API{api.ID: api},
+		byURL: map[string]*API{api.URL: api},
+		revisions: map[string][]Revision{api.ID: {
+			{APIID: api.ID, Revision: 2, Snapshot: api.Request()},
+			{APIID: api.ID, Revision: 1, Snapshot: old},
+		}},
+	}
+}
+
+func node(t *testing.T, id, data string) flowgraph.Node {
+	t.Helper()
+	g, err := flowgraph.Parse(fmt.Sprintf(`{"nodes": [{"id": %q, "type": "apiModuleNode", "data": {%s}}], "edges": []}`, id, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g.Nodes[0]
+}
+
+func TestMatch(t *testing.T) {
+	ch := testChecker()
+	tests := []struct {
+		name        string
+		id, data    string
+		api         bool
+		fromCatalog bool
+	}{
+		{"recorded id", "n1", `"catalogApiId": "` + panID + `"`, true, true},
+		{"id from the canvas", "api-doc-" + panID + "-1712345678", ``, true, true},
+		{"documentation URL", "n1", `"docUrl": "https://documentation.hyperverge.co/api-reference/india/verify-pan"`, true, true},
+		{"endpoint", "n1", `"endpoint": "https://documentation.hyperverge.co/api-reference/india/verify-pan"`, true, true},
+		{"removed API", "n1", `"catalogApiId": "11111111-2222-3333-4444-555555555555"`, false, true},
+		{"moved documentation page", "n1", `"docUrl": "https://documentation.hyperverge.co/api-reference/india/pan-v2"`, false, true},
+		{"built on the canvas", "n1", `"title": "My API"`, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, fromCatalog := ch.Match(node(t, tt.id, tt.data))
+			if (api != nil) != tt.api || fromCatalog != tt.fromCatalog {
+				t.Errorf("Match() = %v, %v, want api %v, %v", api, fromCatalog, tt.api, tt.fromCatalog)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	ch := testChecker()
+	curl := `"curlExample": "curl https://ind.idv.hyperverge.co/v1/verifyPAN", "catalogApiId": "` + panID + `"`
+	tests := []struct {
+		name     string
+		data     string
+		revision int
+		status   string
+		changes  []string
+	}{
+		{"recorded latest revision", curl + `, "catalogRevision": 2`, 2, NodeCurrent, nil},
+		{"recorded older revision", curl + `, "catalogRevision": 1`, 1, NodeOutdated, []string{"input dob added"}},
+		{"fields of the latest revision", curl + `, "inputs": [{"name": "pan", "type": "string", "required": true}, {"name": "dob", "type": "string", "required": true}]`, 2, NodeCurrent, nil},
+		{"fields of an older revision", curl + `, "inputs": [{"name": "pan", "type": "string", "required": true}]`, 1, NodeOutdated, []string{"input dob added"}},
+		{"edited on the canvas", curl + `, "inputs": [{"name": "pan", "type": "number"}]`, 0, NodeUnknown, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &flowgraph.Graph{Nodes: []flowgraph.Node{node(t, "n1", tt.data)}}
+			wr := ch.Check(g, nil)
+			if len(wr.Nodes) != 1 {
+				t.Fatalf("%d nodes, want 1", len(wr.Nodes))
+			}
+			nr := wr.Nodes[0]
+			if nr.Revision != tt.revision || nr.Status != tt.status || nr.Latest != 2 {
+				t.Errorf("revision %d of %d, %s; want %d of 2, %s", nr.Revision, nr.Latest, nr.Status, tt.revision, tt.status)
+			}
+			var changes []string
+			if nr.Changes != nil {
+				for _, f := range nr.Changes.Fields {
+					changes = append(changes, f.Kind+" "+f.Name+" "+f.Change)
+				}
+			}
+			if !reflect.DeepEqual(changes, tt.changes) {
+				t.Errorf("changes = %v, want %v", changes, tt.changes)
+			}
+			if want := tt.status == NodeOutdated; (wr.Outdated == 1) != want {
+				t.Errorf("outdated = %d", wr.Outdated)
+			}
+		})
+	}
+}
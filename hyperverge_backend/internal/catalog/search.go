@@ -0,0 +1,63 @@
+package catalog
+
+import (
+	"os"
+
+	"hypervision_backend/internal/db"
+)
+
+// Search backends
+const (
+	BackendIndex    = "index"
+	BackendPostgres = "postgres"
+)
+
+// SearchBackend is where full-text searches run: the in-process index, or
+// the search_catalog function of migration_catalog_search.sql when
+// CATALOG_SEARCH=postgres
+func SearchBackend() string {
+	if os.Getenv("CATALOG_SEARCH") == BackendPostgres {
+		return BackendPostgres
+	}
+	return BackendIndex
+}
+
+// FullText ranks the APIs and modules matching a free-text query, tolerating
+// typos, and returns the backend that ran it
+func FullText(query string, opts SearchOptions) ([]Result, string, error) {
+	backend := SearchBackend()
+	if backend == BackendPostgres {
+		results, err := searchPostgres(query, opts)
+		return results, backend, err
+	}
+
+	idx, err := CurrentIndex()
+	if err != nil {
+		return nil, backend, err
+	}
+	return idx.Search(query, opts), backend, nil
+}
+
+func searchPostgres(query string, opts SearchOptions) ([]Result, error) {
+	params := map[string]interface{}{"p_query": query, "p_limit": opts.Limit}
+	if opts.Kind != "" {
+		params["p_kind"] = opts.Kind
+	}
+	if opts.Category != "" {
+		params["p_category"] = opts.Category
+	}
+	if opts.Limit == 0 {
+		params["p_limit"] = MaxLimit
+	}
+
+	results := []Result{}
+	if err := db.CallRPC(client(), "search_catalog", params, &results); err != nil {
+		return nil, rpcError(err)
+	}
+	for i := range results {
+		if results[i].Highlights == nil {
+			results[i].Highlights = map[string][]string{}
+		}
+	}
+	return results, nil
+}
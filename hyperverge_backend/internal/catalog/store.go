@@ -0,0 +1,333 @@
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"hypervision_backend/internal/db"
+
+	"github.com/supabase-community/postgrest-go"
+	"github.com/supabase-community/supabase-go"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("already exists")
+)
+
+// apiColumns selects an API with its inputs and outputs
+const apiColumns = "*, api_inputs_new(*), api_outputs_new(*)"
+
+// Filter narrows a list. Query matches names, case-insensitively.
+type Filter struct {
+	Category string
+	Query    string
+}
+
+// client is the service client when configured: handlers check access
+// themselves, and catalog writes are admin-only under RLS
+func client() *supabase.Client {
+	if db.ServiceClient != nil {
+		return db.ServiceClient
+	}
+	return db.Client
+}
+
+func LoadLegacyAPIs(f Filter) ([]LegacyAPI, error) {
+	query := client().
+		From("api_documentation").
+		Select("id, name, description, url, category, curl_example, success_response, failure_responses, error_details, created_at, updated_at", "", false)
+	query = f.apply(query).Order("name", &postgrest.OrderOpts{Ascending: true})
+
+	apis := []LegacyAPI{}
+	return apis, execute(query, &apis)
+}
+
+func LoadAPIs(f Filter) ([]API, error) {
+	query := client().
+		From("api_documentation_new").
+		Select(apiColumns, "", false)
+	query = f.apply(query).Order("name", &postgrest.OrderOpts{Ascending: true})
+
+	apis := []API{}
+	if err := execute(query, &apis); err != nil {
+		return nil, err
+	}
+	for i := range apis {
+		apis[i].normalize()
+	}
+	return apis, nil
+}
+
+func LoadAPI(id string) (*API, error) {
+	var apis []API
+	err := execute(client().From("api_documentation_new").Select(apiColumns, "", false).Eq("id", id), &apis)
+	if err != nil {
+		return nil, err
+	}
+	if len(apis) == 0 {
+		return nil, ErrNotFound
+	}
+	apis[0].normalize()
+	return &apis[0], nil
+}
+
+// SaveAPI creates an API when id is empty, else replaces it along with all
+// of its inputs and outputs. Each change is kept as a new revision; saving
+// an API unchanged keeps its revision. The request must have been validated.
+func SaveAPI(id string, req APIReq) (*API, error) {
+	api, _, err := save(id, req, SourceEdit)
+	return api, err
+}
+
+func save(id string, req APIReq, source string) (*API, Changes, error) {
+	// The URL identifies an API for imports and for nodes created from it
+	existing, err := apiIDByURL(req.URL)
+	if err != nil {
+		return nil, Changes{}, err
+	}
+	if existing != "" && existing != id {
+		return nil, Changes{}, fmt.Errorf("an API with url %s %w", req.URL, ErrConflict)
+	}
+
+	var param interface{}
+	changes := Compare(APIReq{}, req)
+	if id != "" {
+		current, err := LoadAPI(id)
+		if err != nil {
+			return nil, Changes{}, err
+		}
+		changes = Compare(current.Request(), req)
+		if changes.Empty() {
+			return current, changes, nil
+		}
+		param = id
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	err = db.CallRPC(client(), "save_api_documentation", map[string]interface{}{
+		"p_id":      param,
+		"p_api":     req,
+		"p_changes": changes,
+		"p_source":  source,
+	}, &result)
+	if err != nil {
+		return nil, Changes{}, rpcError(err)
+	}
+	invalidate()
+	api, err := LoadAPI(result.ID)
+	return api, changes, err
+}
+
+// RemoveAPI removes an API; its inputs and outputs go with it
+func RemoveAPI(id string) error {
+	var deleted []map[string]interface{}
+	if err := execute(client().From("api_documentation_new").Delete("", "").Eq("id", id), &deleted); err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return ErrNotFound
+	}
+	invalidate()
+	return nil
+}
+
+func LoadModules(f Filter) ([]Module, error) {
+	query := client().
+		From("module_documentation_new").
+		Select("*", "", false)
+	query = f.apply(query).Order("name", &postgrest.OrderOpts{Ascending: true})
+
+	var rows []map[string]interface{}
+	if err := execute(query, &rows); err != nil {
+		return nil, err
+	}
+	modules := make([]Module, len(rows))
+	for i, row := range rows {
+		modules[i] = toModule(row)
+	}
+	return modules, nil
+}
+
+func LoadModule(id string) (*Module, error) {
+	var rows []map[string]interface{}
+	if err := execute(client().From("module_documentation_new").Select("*", "", false).Eq("id", id), &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	m := toModule(rows[0])
+	return &m, nil
+}
+
+// ModuleIndex maps module ids (the moduleType of SDK module nodes) to their
+// modules
+type ModuleIndex map[string]Module
+
+// LoadModuleIndex loads every module for looking up the modules of a graph
+func LoadModuleIndex() (ModuleIndex, error) {
+	modules, err := LoadModules(Filter{})
+	if err != nil {
+		return nil, err
+	}
+	index := make(ModuleIndex, len(modules))
+	for _, m := range modules {
+		index[m.ID] = m
+	}
+	return index, nil
+}
+
+// AddModule adds a module. The request must have been validated.
+func AddModule(req ModuleReq) (*Module, error) {
+	if _, err := LoadModule(req.ID); err == nil {
+		return nil, fmt.Errorf("module %s %w", req.ID, ErrConflict)
+	} else if err != ErrNotFound {
+		return nil, err
+	}
+
+	var rows []map[string]interface{}
+	if err := execute(client().From("module_documentation_new").Insert(moduleRow(req), false, "", "", ""), &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no module created")
+	}
+	invalidate()
+	m := toModule(rows[0])
+	return &m, nil
+}
+
+// SaveModule replaces everything but the id of a module
+func SaveModule(id string, req ModuleReq) (*Module, error) {
+	row := moduleRow(req)
+	delete(row, "id")
+
+	var rows []map[string]interface{}
+	if err := execute(client().From("module_documentation_new").Update(row, "", "").Eq("id", id), &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	invalidate()
+	m := toModule(rows[0])
+	return &m, nil
+}
+
+func RemoveModule(id string) error {
+	var deleted []map[string]interface{}
+	if err := execute(client().From("module_documentation_new").Delete("", "").Eq("id", id), &deleted); err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return ErrNotFound
+	}
+	invalidate()
+	return nil
+}
+
+// apply adds the filter to a query. Each value is its own URL parameter, so
+// it can't add filters of its own.
+func (f Filter) apply(query *postgrest.FilterBuilder) *postgrest.FilterBuilder {
+	if f.Category != "" {
+		query = query.Eq("category", f.Category)
+	}
+	if f.Query != "" {
+		query = query.Ilike("name", likePattern(f.Query))
+	}
+	return query
+}
+
+func execute(query *postgrest.FilterBuilder, out interface{}) error {
+	data, _, err := query.Execute()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// rpcError maps the errors save_api_documentation raises
+func rpcError(err error) error {
+	var rpcErr *db.RPCError
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.Code {
+		case "P0002":
+			return ErrNotFound
+		case "23505":
+			return fmt.Errorf("%s: %w", rpcErr.Message, ErrConflict)
+		}
+	}
+	return err
+}
+
+// normalize turns the null JSON columns of older rows into empty values
+func (a *API) normalize() {
+	if a.SuccessResponse == nil {
+		a.SuccessResponse = map[string]interface{}{}
+	}
+	if a.FailureResponses == nil {
+		a.FailureResponses = []interface{}{}
+	}
+	if a.ErrorDetails == nil {
+		a.ErrorDetails = []interface{}{}
+	}
+	if a.Inputs == nil {
+		a.Inputs = []Field{}
+	}
+	if a.Outputs == nil {
+		a.Outputs = []Field{}
+	}
+}
+
+func moduleRow(req ModuleReq) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           req.ID,
+		"name":         req.Name,
+		"description":  req.Description,
+		"category":     req.Category,
+		"color":        req.Color,
+		"icon":         req.Icon,
+		"csp_urls":     req.CspURLs,
+		"ip_addresses": req.IPAddresses,
+	}
+}
+
+func toModule(row map[string]interface{}) Module {
+	return Module{
+		ID:          db.GetString(row, "id"),
+		Name:        db.GetString(row, "name"),
+		Description: db.GetString(row, "description"),
+		Category:    db.GetString(row, "category"),
+		Color:       db.GetString(row, "color"),
+		Icon:        db.GetString(row, "icon"),
+		CspURLs:     list(row["csp_urls"]),
+		IPAddresses: list(row["ip_addresses"]),
+		CreatedAt:   db.GetString(row, "created_at"),
+		UpdatedAt:   db.GetString(row, "updated_at"),
+	}
+}
+
+// list reads a text[] column, which may also hold a comma separated string
+func list(v interface{}) []string {
+	out := []string{}
+	switch val := v.(type) {
+	case []interface{}:
+		for _, item := range val {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+	case string:
+		for _, s := range strings.Split(val, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
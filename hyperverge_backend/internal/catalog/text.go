@@ -0,0 +1,219 @@
+package catalog
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// token is a search term and where it came from in the text
+type token struct {
+	term       string
+	start, end int // byte offsets
+}
+
+// tokenize splits text into lower-case, singular terms. Words are split on
+// anything that isn't a letter or digit, and camelCase words also yield
+// their parts, so "panNumber" matches "pan number" and "pannumber".
+func tokenize(text string) []token {
+	var out []token
+	start := -1
+	for i, r := range text + " " {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			out = append(out, word(text, start, i)...)
+			start = -1
+		}
+	}
+	return out
+}
+
+// word returns the terms of text[start:end]: the whole word, then its
+// camelCase parts when it has several
+func word(text string, start, end int) []token {
+	out := []token{{term: stem(strings.ToLower(text[start:end])), start: start, end: end}}
+
+	var parts []token
+	partStart := start
+	prev := rune(0)
+	for i, r := range text[start:end] {
+		i += start
+		if i > partStart && unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)) {
+			parts = append(parts, token{start: partStart, end: i})
+			partStart = i
+		}
+		prev = r
+	}
+	if len(parts) == 0 {
+		return out
+	}
+	parts = append(parts, token{start: partStart, end: end})
+	for _, p := range parts {
+		p.term = stem(strings.ToLower(text[p.start:p.end]))
+		out = append(out, p)
+	}
+	return out
+}
+
+// stem drops plural endings so "documents" matches "document"
+func stem(term string) string {
+	switch {
+	case len(term) > 4 && strings.HasSuffix(term, "ies"):
+		return term[:len(term)-3] + "y"
+	case len(term) > 3 && strings.HasSuffix(term, "s") &&
+		!strings.HasSuffix(term, "ss") && !strings.HasSuffix(term, "us") && !strings.HasSuffix(term, "is"):
+		return term[:len(term)-1]
+	}
+	return term
+}
+
+// unique returns the distinct terms of tokens in order
+func unique(tokens []token) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, t := range tokens {
+		if !seen[t.term] {
+			seen[t.term] = true
+			out = append(out, t.term)
+		}
+	}
+	return out
+}
+
+// distance is the Damerau-Levenshtein (optimal string alignment) distance
+// between a and b, or max+1 once it is known to be over max
+func distance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > max || -d > max {
+		return max + 1
+	}
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
+
+// Highlighting limits
+const (
+	maxFragments = 3
+	snippetRunes = 160
+)
+
+// highlight returns, for each field of d with a matched term, up to
+// maxFragments of its texts with the matches marked. Long texts are cut to
+// a snippet around the first match.
+func highlight(d doc, matched map[string]bool) map[string][]string {
+	out := make(map[string][]string)
+	for f, values := range d.texts {
+		for _, v := range values {
+			if len(out[fieldNames[f]]) == maxFragments {
+				break
+			}
+			if frag := mark(v, matched); frag != "" {
+				out[fieldNames[f]] = append(out[fieldNames[f]], frag)
+			}
+		}
+	}
+	return out
+}
+
+// mark escapes text and wraps the words with a matched term in <mark>, or
+// returns "" when nothing matched
+func mark(text string, matched map[string]bool) string {
+	type span struct{ start, end int }
+	var spans []span
+	for _, t := range tokenize(text) {
+		if matched[t.term] {
+			spans = append(spans, span{t.start, t.end})
+		}
+	}
+	if len(spans) == 0 {
+		return ""
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	// A camelCase part and its word overlap; keep the outermost
+	merged := spans[:1]
+	for _, s := range spans[1:] {
+		last := &merged[len(merged)-1]
+		if s.start < last.end {
+			last.end = max(last.end, s.end)
+			continue
+		}
+		merged = append(merged, s)
+	}
+
+	from, to := 0, len(text)
+	if utf8.RuneCountInString(text) > snippetRunes {
+		from = back(text, merged[0].start, snippetRunes/3)
+		to = forward(text, from, snippetRunes)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, s := range merged {
+		if s.start < from || s.end > to {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:s.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[s.start:s.end]))
+		b.WriteString("</mark>")
+		pos = s.end
+	}
+	b.WriteString(html.EscapeString(text[pos:to]))
+	if to < len(text) {
+		b.WriteString("…")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// back returns the offset n runes before i
+func back(text string, i, n int) int {
+	for ; n > 0 && i > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(text[:i])
+		i -= size
+	}
+	return i
+}
+
+// forward returns the offset n runes after i
+func forward(text string, i, n int) int {
+	for ; n > 0 && i < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return i
+}
@@ -0,0 +1,131 @@
+package catalog
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	moduleID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	hexColor = regexp.MustCompile(`^#(?:[0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+)
+
+// Validate checks an API and normalises it: names are trimmed and missing
+// JSON columns get their table defaults. Field types are free text in the
+// scraped docs ("float", "list[float]", ...) so they aren't checked.
+func (r *APIReq) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	r.URL = strings.TrimSpace(r.URL)
+	if r.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if r.Category != CategoryIndia && r.Category != CategoryGlobal {
+		return fmt.Errorf("category must be %s or %s", CategoryIndia, CategoryGlobal)
+	}
+	if u, err := url.Parse(r.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http(s) URL")
+	}
+
+	if r.SuccessResponse == nil {
+		r.SuccessResponse = map[string]interface{}{}
+	}
+	if r.FailureResponses == nil {
+		r.FailureResponses = []interface{}{}
+	}
+	if r.ErrorDetails == nil {
+		r.ErrorDetails = []interface{}{}
+	}
+	if r.Inputs == nil {
+		r.Inputs = []FieldReq{}
+	}
+	if r.Outputs == nil {
+		r.Outputs = []FieldReq{}
+	}
+
+	if err := validateFields("inputs", r.Inputs); err != nil {
+		return err
+	}
+	return validateFields("outputs", r.Outputs)
+}
+
+func validateFields(kind string, fields []FieldReq) error {
+	seen := make(map[string]bool)
+	for i := range fields {
+		f := &fields[i]
+		f.Name = strings.TrimSpace(f.Name)
+		f.Type = strings.TrimSpace(f.Type)
+		if f.Name == "" {
+			return fmt.Errorf("%s[%d]: name is required", kind, i)
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("%s: %s is listed twice", kind, f.Name)
+		}
+		seen[f.Name] = true
+	}
+	return nil
+}
+
+// Validate checks a module. create also checks the id, which can't change
+// afterwards since nodes refer to it.
+func (r *ModuleReq) Validate(create bool) error {
+	r.ID = strings.TrimSpace(r.ID)
+	r.Name = strings.TrimSpace(r.Name)
+	if create && !moduleID.MatchString(r.ID) {
+		return fmt.Errorf("id must be lower-case letters, digits, '-' or '_'")
+	}
+	if r.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if r.Color != "" && !hexColor.MatchString(r.Color) {
+		return fmt.Errorf("color must be a hex colour such as #3B82F6")
+	}
+
+	csp, err := cleanList(r.CspURLs, func(s string) bool {
+		// Hosts or URLs, optionally with a {module.baseUrl} template or a
+		// leading wildcard
+		return !strings.ContainsAny(s, " ;,'\"")
+	})
+	if err != nil {
+		return fmt.Errorf("csp_urls: %v", err)
+	}
+	ips, err := cleanList(r.IPAddresses, func(s string) bool {
+		if net.ParseIP(s) != nil {
+			return true
+		}
+		_, _, err := net.ParseCIDR(s)
+		return err == nil
+	})
+	if err != nil {
+		return fmt.Errorf("ip_addresses: %v", err)
+	}
+	r.CspURLs, r.IPAddresses = csp, ips
+	return nil
+}
+
+// cleanList trims a list, drops blanks and duplicates and checks each value
+func cleanList(values []string, valid func(string) bool) ([]string, error) {
+	out := []string{}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || contains(out, v) {
+			continue
+		}
+		if !valid(v) {
+			return nil, fmt.Errorf("%q is not valid", v)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
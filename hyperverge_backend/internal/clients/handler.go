@@ -25,15 +25,6 @@ type ClientResponse struct {
 	UpdatedAt   string `json:"updated_at"`
 }
 
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 func Create(c *gin.Context) {
 	var req CreateClientReq
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -82,12 +73,12 @@ func Create(c *gin.Context) {
 
 	client := dbResult[0]
 	response := ClientResponse{
-		ID:          getString(client, "id"),
-		Name:        getString(client, "name"),
-		Description: getString(client, "description"),
-		OwnerID:     getString(client, "owner_id"),
-		CreatedAt:   getString(client, "created_at"),
-		UpdatedAt:   getString(client, "updated_at"),
+		ID:          db.GetString(client, "id"),
+		Name:        db.GetString(client, "name"),
+		Description: db.GetString(client, "description"),
+		OwnerID:     db.GetString(client, "owner_id"),
+		CreatedAt:   db.GetString(client, "created_at"),
+		UpdatedAt:   db.GetString(client, "updated_at"),
 	}
 
 	fmt.Printf("DEBUG: Success! Created client: %+v\n", response)
@@ -0,0 +1,334 @@
+// Package codegen turns a workflow into runnable sample integrations. The
+// program model is built once from the graph; each language is a directory
+// under templates/ with a language.json and text/template files, so adding a
+// language doesn't need Go changes.
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/slug"
+	"hypervision_backend/internal/variables"
+)
+
+// Program is what the templates render
+type Program struct {
+	Name        string
+	Slug        string
+	Version     string // version number or "draft"
+	Environment string
+	GeneratedAt string
+	Settings    []*Setting
+	Steps       []*Step
+	Start       *Decision
+	Statuses    []string
+}
+
+// Setting is a value the sample reads from an environment variable
+type Setting struct {
+	Name        string // as it appears in the request
+	Env         string // environment variable, e.g. APP_ID
+	Type        string // string, boolean, number, object, file
+	Default     string // only for non-secret environment variables
+	Secret      bool
+	Description string
+}
+
+// Value is either a JSON literal or a setting
+type Value struct {
+	Name    string
+	Literal interface{}
+	Setting string // Env of the setting, when the value comes from one
+	Type    string
+	File    bool
+}
+
+type Step struct {
+	Index       int
+	NodeID      string
+	Title       string
+	Method      string
+	URL         string
+	DocURL      string
+	Headers     []Value
+	JSON        []Value
+	Form        []Value
+	Condition   Cond
+	Outputs     []string
+	OnSuccess   *Decision
+	OnFailure   *Decision
+	Description string
+}
+
+// Cond is a branch condition. Err is set when it couldn't be translated;
+// templates then emit a TODO and treat it as true.
+type Cond struct {
+	Raw  string
+	Expr flowgraph.Expr
+	Err  string
+}
+
+// Decision mirrors flowgraph.Route with steps resolved to their index
+type Decision struct {
+	Kind   string // step, end, if, stop
+	Step   int
+	Title  string
+	Status string
+	Cond   Cond
+	Then   *Decision
+	Else   *Decision
+}
+
+// Build creates the program model of a workflow
+func Build(g *flowgraph.Graph, meta *flowgraph.Meta) *Program {
+	p := &Program{
+		Name:        meta.WorkflowName,
+		Slug:        slug.Make(meta.WorkflowName, "workflow"),
+		Version:     "draft",
+		Environment: meta.EnvironmentName,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if meta.VersionNumber != "" {
+		p.Version = meta.VersionNumber
+	}
+
+	settings := newSettings(meta)
+	index := make(map[string]*Step)
+
+	for i, call := range g.APICalls() {
+		step := &Step{
+			Index:       i + 1,
+			NodeID:      call.NodeID,
+			Title:       call.Title,
+			Method:      call.Method,
+			URL:         call.URL,
+			DocURL:      call.DocURL,
+			Description: oneLine(call.Description),
+			Condition:   parseCond(call.Condition),
+		}
+		for _, f := range call.Outputs {
+			step.Outputs = append(step.Outputs, f.Name)
+		}
+		inputTypes := make(map[string]string)
+		for _, f := range call.Inputs {
+			inputTypes[f.Name] = f.Type
+		}
+
+		for _, h := range call.Headers {
+			v := Value{Name: h[0], Literal: h[1], Type: "string"}
+			if flowgraph.IsCredential(h[0]) || flowgraph.IsPlaceholder(h[1]) {
+				v.Setting = settings.add(h[0], "string", fmt.Sprintf("%s header of step %d", h[0], step.Index)).Env
+			}
+			step.Headers = append(step.Headers, v)
+		}
+
+		switch {
+		case len(call.Curl.Form) > 0:
+			for _, f := range call.Curl.Form {
+				v := Value{Name: f[0], Literal: f[1], Type: "string"}
+				switch {
+				case strings.HasPrefix(f[1], "@"):
+					v.File = true
+					v.Type = "file"
+					v.Setting = settings.add(f[0], "file", fmt.Sprintf("Path of the %s file sent in step %d", f[0], step.Index)).Env
+				case flowgraph.IsPlaceholder(f[1]):
+					v.Setting = settings.add(f[0], "string", fmt.Sprintf("%s of step %d", f[0], step.Index)).Env
+				}
+				step.Form = append(step.Form, v)
+			}
+		case call.Body != nil:
+			for _, k := range sortedKeys(call.Body) {
+				val := call.Body[k]
+				v := Value{Name: k, Literal: val, Type: jsonType(val)}
+				if s, ok := val.(string); ok && flowgraph.IsPlaceholder(s) {
+					v.Type = placeholderType(s, inputTypes[k])
+					v.Setting = settings.add(k, v.Type, fmt.Sprintf("%s of step %d", k, step.Index)).Env
+				}
+				step.JSON = append(step.JSON, v)
+			}
+		}
+
+		index[call.NodeID] = step
+		p.Steps = append(p.Steps, step)
+	}
+
+	for _, step := range p.Steps {
+		success, failure := g.StepRoutes(step.NodeID)
+		step.OnSuccess = decision(success, index)
+		step.OnFailure = decision(failure, index)
+	}
+	p.Start = decision(g.StartRoute(), index)
+
+	seen := make(map[string]bool)
+	for _, n := range g.EndStatuses() {
+		if s := n.Str("status"); s != "" && !seen[s] {
+			seen[s] = true
+			p.Statuses = append(p.Statuses, s)
+		}
+	}
+	sort.Strings(p.Statuses)
+
+	p.Settings = settings.list
+	return p
+}
+
+func decision(r *flowgraph.Route, index map[string]*Step) *Decision {
+	if r == nil {
+		return &Decision{Kind: flowgraph.RouteStop}
+	}
+	d := &Decision{Kind: r.Kind, Status: r.Status}
+	switch r.Kind {
+	case flowgraph.RouteStep:
+		step := index[r.NodeID]
+		if step == nil {
+			return &Decision{Kind: flowgraph.RouteStop}
+		}
+		d.Step = step.Index
+		d.Title = step.Title
+	case flowgraph.RouteIf:
+		d.Cond = parseCond(r.Condition)
+		d.Then = decision(r.Then, index)
+		d.Else = decision(r.Else, index)
+	}
+	return d
+}
+
+func parseCond(raw string) Cond {
+	raw = strings.TrimSpace(raw)
+	c := Cond{Raw: oneLine(raw)}
+	if raw == "" {
+		return c
+	}
+	e, err := flowgraph.ParseExpr(raw)
+	if err != nil {
+		c.Err = err.Error()
+		return c
+	}
+	c.Expr = e
+	return c
+}
+
+// settingSet dedupes settings by environment variable name and fills in
+// defaults from the workflow environment. Secrets never get a default.
+type settingSet struct {
+	vars  map[string]interface{}
+	byEnv map[string]*Setting
+	list  []*Setting
+}
+
+func newSettings(meta *flowgraph.Meta) *settingSet {
+	vars := make(map[string]interface{})
+	for k, v := range meta.Variables {
+		vars[strings.ToLower(k)] = v
+	}
+	return &settingSet{vars: vars, byEnv: make(map[string]*Setting)}
+}
+
+func (s *settingSet) add(name, typ, desc string) *Setting {
+	env := envName(name)
+	if typ == "file" {
+		env += "_FILE"
+	}
+	if existing, ok := s.byEnv[env]; ok {
+		return existing
+	}
+
+	setting := &Setting{
+		Name:        name,
+		Env:         env,
+		Type:        typ,
+		Secret:      flowgraph.IsCredential(name) || variables.IsSecret(name),
+		Description: desc,
+	}
+	if val, ok := s.vars[strings.ToLower(name)]; ok && !setting.Secret && typ != "file" {
+		setting.Default = stringValue(val)
+	}
+	s.byEnv[env] = setting
+	s.list = append(s.list, setting)
+	return setting
+}
+
+func stringValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	}
+	out, _ := json.Marshal(v)
+	return string(out)
+}
+
+// placeholderType guesses the type of <Enter_true_or_false> style values
+func placeholderType(placeholder, declared string) string {
+	switch strings.ToLower(declared) {
+	case "boolean", "bool":
+		return "boolean"
+	case "number", "integer", "int", "float":
+		return "number"
+	}
+	lower := strings.ToLower(placeholder)
+	switch {
+	case strings.Contains(lower, "true") && strings.Contains(lower, "false"):
+		return "boolean"
+	case strings.Contains(lower, "number") || strings.Contains(lower, "integer"):
+		return "number"
+	}
+	return "string"
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case map[string]interface{}, []interface{}:
+		return "object"
+	}
+	return "string"
+}
+
+// envName converts appId, transaction-id or getCertificate to APP_ID,
+// TRANSACTION_ID and GET_CERTIFICATE
+func envName(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+				b.WriteRune('_')
+			}
+			b.WriteRune(r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToUpper(r))
+		default:
+			b.WriteRune('_')
+		}
+	}
+	out := strings.Trim(b.String(), "_")
+	if out == "" || unicode.IsDigit(rune(out[0])) {
+		out = "VALUE_" + out
+	}
+	return out
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,142 @@
+package codegen
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListLanguages returns the languages code can be generated for (GET /codegen/languages)
+func ListLanguages(c *gin.Context) {
+	langs, err := Languages()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, langs)
+}
+
+// Download generates sample clients for a workflow and returns them as a zip
+// (GET /workflows/:id/export/code?language=go,python)
+func Download(c *gin.Context) {
+	langs, err := selectLanguages(c.QueryArray("language"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	if len(g.APICalls()) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "workflow has no API calls to generate code for"})
+		return
+	}
+
+	p := Build(g, meta)
+	var buf bytes.Buffer
+	if err := Archive(&buf, p, langs); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\""+p.Slug+"-integration.zip\"")
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
+
+// Archive writes a zip with a README and one directory per language
+func Archive(w io.Writer, p *Program, langs []*Language) error {
+	zw := zip.NewWriter(w)
+	now := time.Now()
+
+	add := func(name string, content []byte) error {
+		header := &zip.FileHeader{Name: path.Join(p.Slug, name), Method: zip.Deflate, Modified: now}
+		header.SetMode(0o644)
+		if strings.HasSuffix(name, ".sh") {
+			header.SetMode(0o755)
+		}
+		f, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(content)
+		return err
+	}
+
+	readme, err := RenderReadme(p, langs)
+	if err != nil {
+		return err
+	}
+	if err := add("README.md", readme); err != nil {
+		return err
+	}
+
+	for _, lang := range langs {
+		files, err := lang.Render(p)
+		if err != nil {
+			return err
+		}
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := add(path.Join(lang.ID, name), files[name]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return zw.Close()
+}
+
+// selectLanguages accepts ?language=go&language=node or ?language=go,node;
+// no parameter means every language
+func selectLanguages(params []string) ([]*Language, error) {
+	all, err := Languages()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, p := range params {
+		for _, id := range strings.Split(p, ",") {
+			if id = strings.TrimSpace(strings.ToLower(id)); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return all, nil
+	}
+
+	var out []*Language
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		lang, ok := Lookup(id)
+		if !ok {
+			available := make([]string, len(all))
+			for i, l := range all {
+				available[i] = l.ID
+			}
+			return nil, fmt.Errorf("unknown language %q (available: %s)", id, strings.Join(available, ", "))
+		}
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, lang)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,295 @@
+package codegen
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"hypervision_backend/internal/flowgraph"
+)
+
+//go:embed all:templates
+var templateFS embed.FS
+
+// Language is one directory under templates/. Files starting with an
+// underscore hold shared {{define}} blocks and aren't written out.
+type Language struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Run         string   `json:"run"` // command that runs the sample from its directory
+	Files       []string `json:"files"`
+	Dialect     Dialect  `json:"-"`
+
+	tmpl *template.Template
+}
+
+// Dialect tells the generic renderers how to spell conditions and literals.
+// Formats use fmt verbs; see language.json of each language.
+type Dialect struct {
+	Indent  string `json:"indent"`
+	True    string `json:"true"`
+	False   string `json:"false"`
+	Null    string `json:"null"`
+	And     string `json:"and"`     // %[1]s, %[2]s: operands
+	Or      string `json:"or"`      // %[1]s, %[2]s: operands
+	Not     string `json:"not"`     // %s: operand
+	Compare string `json:"compare"` // %[1]s left, %[2]s operator, %[3]s right
+	Truthy  string `json:"truthy"`  // %s: value
+	Path    string `json:"path"`    // %s: the path items joined by PathSep
+	PathSep string `json:"path_sep"`
+	Item    string `json:"item"`  // %s: quoted key
+	Index   string `json:"index"` // %s: numeric index; empty means use Item
+	Object  string `json:"object"`
+	// Object: %[1]s is the JSON quoted as a string, %[2]s the raw JSON
+}
+
+var (
+	loadOnce  sync.Once
+	languages map[string]*Language
+	root      *template.Template
+	loadErr   error
+)
+
+// Languages returns the available languages, sorted by id
+func Languages() ([]*Language, error) {
+	loadOnce.Do(load)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	out := make([]*Language, 0, len(languages))
+	for _, l := range languages {
+		out = append(out, l)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+// Lookup returns a language by id
+func Lookup(id string) (*Language, bool) {
+	loadOnce.Do(load)
+	l, ok := languages[id]
+	return l, ok
+}
+
+func load() {
+	languages = make(map[string]*Language)
+
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		loadErr = err
+		return
+	}
+
+	rootTmpl, err := template.New("README.md.tmpl").Funcs(funcs(nil)).ParseFS(templateFS, "templates/README.md.tmpl")
+	if err != nil {
+		loadErr = err
+		return
+	}
+	root = rootTmpl
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		dir := path.Join("templates", e.Name())
+
+		raw, err := templateFS.ReadFile(path.Join(dir, "language.json"))
+		if err != nil {
+			loadErr = fmt.Errorf("%s: %w", dir, err)
+			return
+		}
+		lang := &Language{ID: e.Name()}
+		if err := json.Unmarshal(raw, lang); err != nil {
+			loadErr = fmt.Errorf("%s/language.json: %w", dir, err)
+			return
+		}
+		var spec struct {
+			Dialect Dialect `json:"dialect"`
+		}
+		if err := json.Unmarshal(raw, &spec); err != nil {
+			loadErr = fmt.Errorf("%s/language.json: %w", dir, err)
+			return
+		}
+		lang.Dialect = spec.Dialect
+
+		files, err := fs.Glob(templateFS, path.Join(dir, "*.tmpl"))
+		if err != nil {
+			loadErr = err
+			return
+		}
+		lang.tmpl = template.New(lang.ID)
+		lang.tmpl.Funcs(funcs(lang))
+		for _, f := range files {
+			body, err := templateFS.ReadFile(f)
+			if err != nil {
+				loadErr = err
+				return
+			}
+			name := path.Base(f)
+			if _, err := lang.tmpl.New(name).Parse(string(body)); err != nil {
+				loadErr = fmt.Errorf("%s: %w", f, err)
+				return
+			}
+			if !strings.HasPrefix(name, "_") {
+				lang.Files = append(lang.Files, strings.TrimSuffix(name, ".tmpl"))
+			}
+		}
+		languages[lang.ID] = lang
+	}
+}
+
+// Render produces the files of one language, keyed by path
+func (l *Language) Render(p *Program) (map[string][]byte, error) {
+	out := make(map[string][]byte)
+	for _, f := range l.Files {
+		var buf bytes.Buffer
+		if err := l.tmpl.ExecuteTemplate(&buf, f+".tmpl", p); err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", l.ID, f, err)
+		}
+		content := buf.Bytes()
+		if strings.HasSuffix(f, ".go") {
+			// Map literals come out unaligned; leave gofmt to the standard library
+			if formatted, err := format.Source(content); err == nil {
+				content = formatted
+			}
+		}
+		out[f] = content
+	}
+	return out, nil
+}
+
+// RenderReadme produces the top-level README of an archive
+func RenderReadme(p *Program, langs []*Language) ([]byte, error) {
+	loadOnce.Do(load)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	var buf bytes.Buffer
+	err := root.Execute(&buf, map[string]interface{}{"Program": p, "Languages": langs})
+	return buf.Bytes(), err
+}
+
+func funcs(l *Language) template.FuncMap {
+	var self *template.Template
+	if l != nil {
+		self = l.tmpl
+	}
+	return template.FuncMap{
+		"cond": func(c Cond) string {
+			if c.Expr == nil {
+				return l.Dialect.True
+			}
+			return l.Dialect.operand(c.Expr)
+		},
+		"literal": func(v interface{}) string { return l.Dialect.literal(v) },
+		"quote":   quote,
+		"shquote": shquote,
+		"indent": func(n int, s string) string {
+			prefix := strings.Repeat(l.Dialect.Indent, n)
+			lines := strings.Split(s, "\n")
+			for i, line := range lines {
+				if line != "" {
+					lines[i] = prefix + line
+				}
+			}
+			return strings.Join(lines, "\n")
+		},
+		"include": func(name string, data interface{}) (string, error) {
+			var buf bytes.Buffer
+			err := self.ExecuteTemplate(&buf, name, data)
+			return strings.TrimRight(buf.String(), "\n"), err
+		},
+		"oneline": oneLine,
+		"join":    strings.Join,
+	}
+}
+
+func (d Dialect) expr(e flowgraph.Expr) string {
+	switch v := e.(type) {
+	case flowgraph.Path:
+		items := make([]string, len(v))
+		for i, item := range v {
+			if _, err := strconv.Atoi(item); err == nil && d.Index != "" {
+				items[i] = fmt.Sprintf(d.Index, item)
+			} else {
+				items[i] = fmt.Sprintf(d.Item, quote(item))
+			}
+		}
+		return fmt.Sprintf(d.Path, strings.Join(items, d.PathSep))
+	case flowgraph.Literal:
+		return d.literal(v.Value)
+	case flowgraph.Unary:
+		return fmt.Sprintf(d.Not, d.operand(v.X))
+	case flowgraph.Binary:
+		switch v.Op {
+		case "&&":
+			return fmt.Sprintf(d.And, d.operand(v.L), d.operand(v.R))
+		case "||":
+			return fmt.Sprintf(d.Or, d.operand(v.L), d.operand(v.R))
+		}
+		return fmt.Sprintf(d.Compare, d.expr(v.L), v.Op, d.expr(v.R))
+	}
+	return d.True
+}
+
+// operand renders a sub-expression in boolean position
+func (d Dialect) operand(e flowgraph.Expr) string {
+	switch v := e.(type) {
+	case flowgraph.Path:
+		return fmt.Sprintf(d.Truthy, d.expr(v))
+	case flowgraph.Literal:
+		if b, ok := v.Value.(bool); ok {
+			if b {
+				return d.True
+			}
+			return d.False
+		}
+		return fmt.Sprintf(d.Truthy, d.expr(v))
+	}
+	return d.expr(e)
+}
+
+func (d Dialect) literal(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return d.Null
+	case bool:
+		if val {
+			return d.True
+		}
+		return d.False
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case string:
+		return quote(val)
+	}
+	raw := jsonText(v)
+	return fmt.Sprintf(d.Object, quote(raw), raw)
+}
+
+// quote returns a double-quoted string that is valid in Go, JavaScript,
+// Python and jq
+func quote(s string) string {
+	out, _ := json.Marshal(s)
+	return string(out)
+}
+
+// shquote quotes for POSIX shells
+func shquote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+func jsonText(v interface{}) string {
+	out, _ := json.Marshal(v)
+	return string(out)
+}
@@ -0,0 +1,436 @@
+// Package coverage lists every decision path of a workflow for risk reviews:
+// the start-to-end paths with the conditions along them, the end statuses
+// they reach, and the gaps where a decision has no branch for one of its
+// outcomes or a loop has no way out. With test cases it also reports which
+// branches and paths the cases go through.
+package coverage
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/testcases"
+)
+
+// MaxPaths bounds the paths listed; flows with more are covered partially
+const MaxPaths = analysis.MaxPaths
+
+// Report is the coverage of a workflow graph
+type Report struct {
+	Workflow        *flowgraph.Meta `json:"workflow,omitempty"`
+	Paths           []Path          `json:"paths"`
+	Truncated       bool            `json:"truncated"` // more than MaxPaths paths
+	Statuses        []string        `json:"statuses"`  // end statuses some path reaches
+	Ends            []End           `json:"ends"`
+	UnreachableEnds []End           `json:"unreachable_ends"`
+	MissingBranches []Gap           `json:"missing_branches"`
+	Traps           []Loop          `json:"traps"` // loops no branch leaves
+	Branches        []Branch        `json:"branches"`
+	Tests           *Tests          `json:"tests,omitempty"` // nil without test cases
+}
+
+// Path is one way from a start node to a node without branches
+type Path struct {
+	Nodes      []string   `json:"nodes"` // node ids in order
+	Steps      []PathStep `json:"steps"`
+	Conditions []string   `json:"conditions"` // what has to happen to take the path
+	Complete   bool       `json:"complete"`   // ends at an end status
+	Status     string     `json:"status,omitempty"`
+	Cases      []string   `json:"cases,omitempty"` // test cases that take the path
+}
+
+// PathStep is a node of a path and the branch the path leaves it by
+type PathStep struct {
+	NodeID    string `json:"node_id"`
+	Title     string `json:"title"`
+	Branch    string `json:"branch,omitempty"`
+	EdgeID    string `json:"edge_id,omitempty"`
+	Condition string `json:"condition,omitempty"`
+}
+
+// End is an end status node
+type End struct {
+	NodeID    string `json:"node_id"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	Reachable bool   `json:"reachable"`
+	Paths     int    `json:"paths"`
+}
+
+// Gap is a decision without a branch for one of its outcomes
+type Gap struct {
+	NodeID  string `json:"node_id"`
+	Title   string `json:"title"`
+	Type    string `json:"type"`
+	Missing string `json:"missing"` // kind of the missing branch
+	Message string `json:"message"`
+}
+
+// Loop is a set of nodes that lead to each other
+type Loop struct {
+	Nodes  []string `json:"nodes"`
+	Titles []string `json:"titles"`
+}
+
+// Branch is an edge out of a reachable node
+type Branch struct {
+	NodeID    string   `json:"node_id"`
+	Title     string   `json:"title"`
+	Kind      string   `json:"kind"`
+	EdgeID    string   `json:"edge_id"`
+	Target    string   `json:"target"`
+	Condition string   `json:"condition,omitempty"`
+	Cases     []string `json:"cases,omitempty"` // test cases that take the branch
+}
+
+// Tests is how much of the graph the test cases go through
+type Tests struct {
+	Cases    int   `json:"cases"`
+	Passed   int   `json:"passed"`
+	Branches Ratio `json:"branches"`
+	Paths    Ratio `json:"paths"`
+}
+
+// Ratio is a covered part of a total
+type Ratio struct {
+	Covered int     `json:"covered"`
+	Total   int     `json:"total"`
+	Percent float64 `json:"percent"`
+}
+
+// Check reports the coverage of a workflow graph, running its test cases
+// when it has any
+func Check(g *flowgraph.Graph, meta *flowgraph.Meta) (*Report, error) {
+	r := Analyze(g)
+	r.Workflow = meta
+
+	suite, err := testcases.Load(meta.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	if len(suite.Cases) == 0 {
+		return r, nil
+	}
+	res, err := testcases.Run(g, suite.Cases, meta.Variables)
+	if err != nil {
+		return nil, err
+	}
+	r.AddTests(res)
+	return r, nil
+}
+
+// Analyze lists the paths and gaps of g
+func Analyze(g *flowgraph.Graph) *Report {
+	r := &Report{
+		Paths:           []Path{},
+		Statuses:        []string{},
+		Ends:            []End{},
+		UnreachableEnds: []End{},
+		MissingBranches: []Gap{},
+		Traps:           []Loop{},
+		Branches:        []Branch{},
+	}
+	for _, s := range g.Starts() {
+		r.walk(g, s.ID, nil)
+	}
+
+	reachable := make(map[string]bool)
+	for _, n := range g.Ordered() {
+		reachable[n.ID] = true
+		for _, b := range g.Branches(n.ID) {
+			r.Branches = append(r.Branches, Branch{
+				NodeID:    n.ID,
+				Title:     n.Label(),
+				Kind:      b.Kind,
+				EdgeID:    b.EdgeID,
+				Target:    b.Target,
+				Condition: b.Condition,
+			})
+		}
+		if gap := missingBranch(g, n); gap != nil {
+			r.MissingBranches = append(r.MissingBranches, *gap)
+		}
+	}
+
+	paths := make(map[string]int)
+	for _, p := range r.Paths {
+		paths[p.Nodes[len(p.Nodes)-1]]++
+	}
+	seen := make(map[string]bool)
+	for _, n := range g.EndStatuses() {
+		end := End{NodeID: n.ID, Title: n.Label(), Status: n.Str("status"), Reachable: reachable[n.ID], Paths: paths[n.ID]}
+		r.Ends = append(r.Ends, end)
+		if !end.Reachable {
+			r.UnreachableEnds = append(r.UnreachableEnds, end)
+		} else if end.Status != "" && !seen[end.Status] {
+			seen[end.Status] = true
+			r.Statuses = append(r.Statuses, end.Status)
+		}
+	}
+
+	r.Traps = traps(g, reachable)
+	return r
+}
+
+// walk follows every branch from id, ending a path at a node without
+// branches. A branch back to a node already on the path is a loop, not a
+// way to an end.
+func (r *Report) walk(g *flowgraph.Graph, id string, path []PathStep) {
+	if r.Truncated {
+		return
+	}
+	n := g.Node(id)
+	if n == nil {
+		return
+	}
+	for _, s := range path {
+		if s.NodeID == id {
+			return
+		}
+	}
+	step := PathStep{NodeID: id, Title: n.Label()}
+
+	branches := g.Branches(id)
+	if n.Type == flowgraph.NodeEndStatus || len(branches) == 0 {
+		// Stop at the first path past the limit
+		if len(r.Paths) == MaxPaths {
+			r.Truncated = true
+			return
+		}
+		steps := append(path[:len(path):len(path)], step)
+		p := Path{Steps: steps, Conditions: []string{}}
+		for _, s := range steps {
+			p.Nodes = append(p.Nodes, s.NodeID)
+			if c := decision(g.Node(s.NodeID), s); c != "" {
+				p.Conditions = append(p.Conditions, c)
+			}
+		}
+		if n.Type == flowgraph.NodeEndStatus {
+			p.Complete = true
+			p.Status = n.Str("status")
+		}
+		r.Paths = append(r.Paths, p)
+		return
+	}
+	for _, b := range branches {
+		s := step
+		s.Branch = b.Kind
+		s.EdgeID = b.EdgeID
+		s.Condition = b.Condition
+		r.walk(g, b.Target, append(path[:len(path):len(path)], s))
+	}
+}
+
+// decision says in words what taking a branch means
+func decision(n *flowgraph.Node, s PathStep) string {
+	cond := strings.TrimSpace(n.Str("condition"))
+	switch s.Branch {
+	case flowgraph.BranchSuccess:
+		if cond != "" && n.Type == flowgraph.NodeAPIModule {
+			return fmt.Sprintf("%s succeeds and %s", s.Title, cond)
+		}
+		return s.Title + " succeeds"
+	case flowgraph.BranchFailure:
+		if cond != "" && n.Type == flowgraph.NodeAPIModule {
+			return fmt.Sprintf("%s fails or %s doesn't hold", s.Title, cond)
+		}
+		return s.Title + " fails"
+	case flowgraph.BranchTrue:
+		if cond == "" {
+			return s.Title + " holds"
+		}
+		return cond
+	case flowgraph.BranchFalse:
+		if cond == "" {
+			return s.Title + " doesn't hold"
+		}
+		return "not " + cond
+	}
+	return ""
+}
+
+// missingBranch finds an API call without a failure or success branch, or
+// a condition without a true or false one
+func missingBranch(g *flowgraph.Graph, n flowgraph.Node) *Gap {
+	var want []string
+	switch n.Type {
+	case flowgraph.NodeAPIModule:
+		want = []string{flowgraph.BranchFailure, flowgraph.BranchSuccess}
+	case flowgraph.NodeCondition:
+		want = []string{flowgraph.BranchFalse, flowgraph.BranchTrue}
+	default:
+		return nil
+	}
+	has := make(map[string]bool)
+	for _, b := range g.Branches(n.ID) {
+		has[b.Kind] = true
+	}
+	for _, kind := range want {
+		if has[kind] {
+			continue
+		}
+		gap := &Gap{NodeID: n.ID, Title: n.Label(), Type: n.Type, Missing: kind}
+		switch kind {
+		case flowgraph.BranchFailure:
+			gap.Message = n.Label() + " has no failure branch; a failed call stops the flow without an end status"
+		case flowgraph.BranchSuccess:
+			gap.Message = n.Label() + " has no success branch; a successful call stops the flow without an end status"
+		default:
+			gap.Message = fmt.Sprintf("%s has no %s branch; the flow stops when the condition is %s", n.Label(), kind, kind)
+		}
+		return gap
+	}
+	return nil
+}
+
+// traps finds the reachable loops that no branch leaves, as the strongly
+// connected components of the graph (Tarjan) without an edge out of them
+func traps(g *flowgraph.Graph, reachable map[string]bool) []Loop {
+	index := make(map[string]int)
+	low := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+
+	var connect func(id string)
+	connect = func(id string) {
+		index[id] = len(index)
+		low[id] = index[id]
+		stack = append(stack, id)
+		onStack[id] = true
+		for _, e := range g.Outgoing(id) {
+			if g.Node(e.Target) == nil {
+				continue
+			}
+			if _, visited := index[e.Target]; !visited {
+				connect(e.Target)
+				low[id] = min(low[id], low[e.Target])
+			} else if onStack[e.Target] {
+				low[id] = min(low[id], index[e.Target])
+			}
+		}
+		if low[id] != index[id] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == id {
+				break
+			}
+		}
+		components = append(components, component)
+	}
+	for _, n := range g.Ordered() {
+		if _, visited := index[n.ID]; !visited {
+			connect(n.ID)
+		}
+	}
+
+	loops := []Loop{}
+	for _, component := range components {
+		members := make(map[string]bool, len(component))
+		for _, id := range component {
+			members[id] = true
+		}
+		cycle, exit := len(component) > 1, false
+		for _, id := range component {
+			for _, e := range g.Outgoing(id) {
+				switch {
+				case e.Target == id:
+					cycle = true
+				case !members[e.Target] && g.Node(e.Target) != nil:
+					exit = true
+				}
+			}
+		}
+		if !cycle || exit || !reachable[component[0]] {
+			continue
+		}
+		// Tarjan pops components deepest node first; list them in flow order
+		loop := Loop{}
+		for _, n := range g.Ordered() {
+			if members[n.ID] {
+				loop.Nodes = append(loop.Nodes, n.ID)
+				loop.Titles = append(loop.Titles, n.Label())
+			}
+		}
+		loops = append(loops, loop)
+	}
+	return loops
+}
+
+// AddTests marks the branches and paths the runs of a suite go through
+func (r *Report) AddTests(res *testcases.SuiteResult) {
+	t := &Tests{Cases: res.Total, Passed: res.Total - res.Failed}
+	byEdge := make(map[string]*Branch, len(r.Branches))
+	for i := range r.Branches {
+		byEdge[r.Branches[i].EdgeID] = &r.Branches[i]
+	}
+
+	for _, cr := range res.Cases {
+		if cr.Run == nil {
+			continue
+		}
+		for _, s := range cr.Run.Steps {
+			if b := byEdge[s.EdgeID]; b != nil && s.EdgeID != "" && !containsString(b.Cases, cr.Name) {
+				b.Cases = append(b.Cases, cr.Name)
+			}
+		}
+		for i := range r.Paths {
+			if equal(r.Paths[i].Nodes, cr.Run.Path) {
+				r.Paths[i].Cases = append(r.Paths[i].Cases, cr.Name)
+			}
+		}
+	}
+
+	t.Branches.Total = len(r.Branches)
+	for _, b := range r.Branches {
+		if len(b.Cases) > 0 {
+			t.Branches.Covered++
+		}
+	}
+	t.Paths.Total = len(r.Paths)
+	for _, p := range r.Paths {
+		if len(p.Cases) > 0 {
+			t.Paths.Covered++
+		}
+	}
+	t.Branches.Percent = percent(t.Branches.Covered, t.Branches.Total)
+	t.Paths.Percent = percent(t.Paths.Covered, t.Paths.Total)
+	r.Tests = t
+}
+
+func percent(covered, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(covered)*1000/float64(total)) / 10
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
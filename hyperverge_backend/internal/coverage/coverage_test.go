@@ -0,0 +1,171 @@
+package coverage
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/simulate"
+	"hypervision_backend/internal/testcases"
+)
+
+var nodes = map[string]string{
+	"read":     `{"id": "read", "type": "apiModuleNode", "data": {"title": "Read ID", "curlExample": "curl 'https://ind.idv.hyperverge.co/v1/readId'", "successResponse": {"age": 30}}}`,
+	"check":    `{"id": "check", "type": "conditionNode", "data": {"title": "Check", "condition": "age >= 18"}}`,
+	"wait":     `{"id": "wait", "type": "conditionNode", "data": {"title": "Wait", "condition": "done"}}`,
+	"approved": `{"id": "approved", "type": "endStatusNode", "data": {"title": "Approved", "status": "auto-approved"}}`,
+	"declined": `{"id": "declined", "type": "endStatusNode", "data": {"title": "Declined", "status": "auto-declined"}}`,
+	"review":   `{"id": "review", "type": "endStatusNode", "data": {"title": "Review", "status": "needs-review"}}`,
+}
+
+// graph builds a flow from the start node, the named nodes and edges
+// written as "source target [handle]"
+func graph(t *testing.T, ids string, edges ...string) *flowgraph.Graph {
+	t.Helper()
+	ns := []string{`{"id": "start", "type": "startNode"}`}
+	for _, id := range strings.Fields(ids) {
+		ns = append(ns, nodes[id])
+	}
+	var es []string
+	for i, e := range edges {
+		parts := strings.Fields(e)
+		handle := ""
+		if len(parts) > 2 {
+			handle = parts[2]
+		}
+		es = append(es, fmt.Sprintf(`{"id": "e%d", "source": %q, "target": %q, "sourceHandle": %q}`, i, parts[0], parts[1], handle))
+	}
+	g, err := flowgraph.Parse(fmt.Sprintf(`{"nodes": [%s], "edges": [%s]}`, strings.Join(ns, ","), strings.Join(es, ",")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name        string
+		g           *flowgraph.Graph
+		paths       int
+		statuses    string
+		unreachable string // end node ids
+		missing     string // node|kind of the gaps
+		traps       string // node ids of each loop, loops separated by ;
+	}{
+		{
+			name:     "every outcome ends",
+			g:        graph(t, "read check approved declined", "start read", "read check success", "read declined failure", "check approved true", "check declined false"),
+			paths:    3,
+			statuses: "auto-approved,auto-declined",
+		},
+		{
+			name:        "missing failure branch and unreachable end",
+			g:           graph(t, "read approved review", "start read", "read approved success"),
+			paths:       1,
+			statuses:    "auto-approved",
+			unreachable: "review",
+			missing:     "read|failure",
+		},
+		{
+			name:     "retry loop with a way out",
+			g:        graph(t, "read check approved declined", "start read", "read check success", "read read failure", "check approved true", "check declined false"),
+			paths:    2,
+			statuses: "auto-approved,auto-declined",
+		},
+		{
+			name:     "loop without a way out",
+			g:        graph(t, "read check wait approved", "start read", "read approved success", "read check failure", "check wait true", "check wait false", "wait check true", "wait check false"),
+			paths:    1,
+			statuses: "auto-approved",
+			traps:    "check,wait",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Analyze(tt.g)
+			if len(r.Paths) != tt.paths {
+				t.Errorf("%d paths, want %d", len(r.Paths), tt.paths)
+			}
+			if got := strings.Join(r.Statuses, ","); got != tt.statuses {
+				t.Errorf("statuses = %s, want %s", got, tt.statuses)
+			}
+			var unreachable, missing, traps []string
+			for _, e := range r.UnreachableEnds {
+				unreachable = append(unreachable, e.NodeID)
+			}
+			for _, gap := range r.MissingBranches {
+				missing = append(missing, gap.NodeID+"|"+gap.Missing)
+			}
+			for _, loop := range r.Traps {
+				traps = append(traps, strings.Join(loop.Nodes, ","))
+			}
+			if got := strings.Join(unreachable, ","); got != tt.unreachable {
+				t.Errorf("unreachable ends = %s, want %s", got, tt.unreachable)
+			}
+			if got := strings.Join(missing, ","); got != tt.missing {
+				t.Errorf("missing branches = %s, want %s", got, tt.missing)
+			}
+			if got := strings.Join(traps, ";"); got != tt.traps {
+				t.Errorf("traps = %s, want %s", got, tt.traps)
+			}
+		})
+	}
+}
+
+func TestPathLimit(t *testing.T) {
+	tests := []struct {
+		paths     int
+		truncated bool
+	}{
+		{MaxPaths, false},
+		{MaxPaths + 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.paths), func(t *testing.T) {
+			edges := make([]string, tt.paths)
+			for i := range edges {
+				edges[i] = "start approved"
+			}
+			r := Analyze(graph(t, "approved", edges...))
+			if r.Truncated != tt.truncated {
+				t.Errorf("truncated = %v, want %v", r.Truncated, tt.truncated)
+			}
+			if want := min(tt.paths, MaxPaths); len(r.Paths) != want {
+				t.Errorf("%d paths listed, want %d", len(r.Paths), want)
+			}
+		})
+	}
+}
+
+func TestAddTests(t *testing.T) {
+	g := graph(t, "read check approved declined", "start read", "read check success", "read declined failure", "check approved true", "check declined false")
+	r := Analyze(g)
+
+	res := &testcases.SuiteResult{}
+	for _, tc := range []struct {
+		name string
+		mock map[string]simulate.Mock
+	}{
+		{"adult", nil},
+		{"minor", map[string]simulate.Mock{"read": {Response: map[string]interface{}{"age": float64(16)}}}},
+	} {
+		run := simulate.Run(g, analysis.CatalogResolver(nil), simulate.Request{Mocks: tc.mock})
+		res.Total++
+		res.Cases = append(res.Cases, testcases.CaseResult{Name: tc.name, Passed: true, Run: run})
+	}
+	r.AddTests(res)
+
+	if got := r.Tests.Paths; got.Covered != 2 || got.Total != 3 {
+		t.Errorf("paths covered = %d of %d, want 2 of 3", got.Covered, got.Total)
+	}
+	if got := r.Tests.Branches; got.Covered != 4 || got.Total != 5 {
+		t.Errorf("branches covered = %d of %d, want 4 of 5", got.Covered, got.Total)
+	}
+	for _, b := range r.Branches {
+		if b.NodeID == "read" && b.Kind == flowgraph.BranchFailure && len(b.Cases) > 0 {
+			t.Errorf("failure branch of Read ID covered by %v", b.Cases)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package coverage
+
+import (
+	"net/http"
+
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler reports the decision paths of a workflow and how much of them its
+// test cases cover (GET /workflows/:id/coverage?version_id=&environment_id=)
+func Handler(c *gin.Context) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	r, err := Check(g, meta)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, r)
+}
@@ -0,0 +1,72 @@
+package csp
+
+// Diff compares a customer's current policy with what a workflow needs
+type Diff struct {
+	Current Policy `json:"current"`
+	// Missing sources are needed but blocked by the current policy
+	Missing []Need `json:"missing"`
+	// Unneeded sources of the managed directives that no step needs
+	Unneeded []Directive `json:"unneeded"`
+	// Unmanaged directives are kept as they are
+	Unmanaged []string `json:"unmanaged"`
+	// Merged is the current policy with the missing sources added
+	Merged Policy `json:"merged"`
+
+	Header  string `json:"header"`
+	MetaTag string `json:"meta_tag"`
+	Nginx   string `json:"nginx"`
+	Apache  string `json:"apache"`
+}
+
+// Compare diffs the current policy against the result's needs
+func Compare(r *Result, current string) *Diff {
+	cur := Parse(current)
+	d := &Diff{Current: cur, Missing: []Need{}, Unneeded: []Directive{}, Unmanaged: []string{}}
+
+	merged := make(Policy, len(cur))
+	for i, dir := range cur {
+		merged[i] = Directive{Name: dir.Name, Sources: append([]string(nil), dir.Sources...)}
+	}
+
+	for _, need := range r.Sources {
+		sources, from, restricted := cur.effective(need.Directive)
+		if !restricted || Allows(sources, need.Source) {
+			continue
+		}
+		d.Missing = append(d.Missing, need)
+
+		// Adding a directive that was falling back replaces the fallback,
+		// so it starts from the fallback's sources
+		if from != need.Directive && merged.Get(need.Directive) == nil {
+			merged.Add(need.Directive, sources...)
+		}
+		merged.Add(need.Directive, need.Source)
+	}
+
+	for _, dir := range cur {
+		if !contains(Managed, dir.Name) {
+			d.Unmanaged = append(d.Unmanaged, dir.Name)
+			continue
+		}
+		var unneeded []string
+		for _, s := range dir.Sources {
+			used := false
+			for _, need := range r.Sources {
+				if need.Directive == dir.Name && Allows([]string{s}, need.Source) {
+					used = true
+					break
+				}
+			}
+			if !used {
+				unneeded = append(unneeded, s)
+			}
+		}
+		if len(unneeded) > 0 {
+			d.Unneeded = append(d.Unneeded, Directive{Name: dir.Name, Sources: unneeded})
+		}
+	}
+
+	d.Merged = merged
+	d.Header, d.MetaTag, d.Nginx, d.Apache = Snippets(merged)
+	return d
+}
@@ -0,0 +1,212 @@
+package csp
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Need is a source the workflow needs in a directive, and why
+type Need struct {
+	Directive string   `json:"directive"`
+	Source    string   `json:"source"`
+	Reason    string   `json:"reason"`
+	Nodes     []string `json:"nodes,omitempty"`
+}
+
+// Egress is an IP address the customer's network has to reach
+type Egress struct {
+	IP    string   `json:"ip"`
+	Nodes []string `json:"nodes"`
+}
+
+type Result struct {
+	Workflow   *flowgraph.Meta `json:"workflow"`
+	SDK        bool            `json:"sdk"` // false for server-to-server flows, which need no CSP
+	Countries  []string        `json:"countries,omitempty"`
+	Policy     Policy          `json:"policy"`
+	Header     string          `json:"header"`
+	MetaTag    string          `json:"meta_tag"`
+	Nginx      string          `json:"nginx"`
+	Apache     string          `json:"apache"`
+	Sources    []Need          `json:"sources"`
+	EgressIPs  []Egress        `json:"egress_ips"`
+	Unresolved []string        `json:"unresolved,omitempty"` // URL templates no country resolved
+	Diff       *Diff           `json:"diff,omitempty"`
+}
+
+// template matches the {<module>.baseUrl} placeholder of regional URLs
+var template = regexp.MustCompile(`\{[^{}]+\.baseUrl\}`)
+
+// Generate works out the minimal policy of a graph. Countries (ISO alpha-3)
+// pick the regional hosts; countries listed by country picker modules are
+// added to them.
+func Generate(g *flowgraph.Graph, meta *flowgraph.Meta, modules catalog.ModuleIndex, countries []string) *Result {
+	r := &Result{Workflow: meta, Sources: []Need{}, EgressIPs: []Egress{}}
+	needs := &needs{index: make(map[string]int)}
+	ips := make(map[string][]string)
+	var ipOrder []string
+	addIP := func(ip, node string) {
+		ip = strings.TrimSpace(ip)
+		if ip == "" {
+			return
+		}
+		if _, ok := ips[ip]; !ok {
+			ipOrder = append(ipOrder, ip)
+		}
+		if !contains(ips[ip], node) {
+			ips[ip] = append(ips[ip], node)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range countries {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c != "" && !seen[c] {
+			seen[c] = true
+			r.Countries = append(r.Countries, c)
+		}
+	}
+	for _, n := range g.Nodes {
+		for _, c := range n.StringList("countriesSupported") {
+			if c = strings.ToLower(c); !seen[c] {
+				seen[c] = true
+				r.Countries = append(r.Countries, c)
+			}
+		}
+	}
+
+	r.SDK = g.FlowType != "api"
+	for _, n := range g.Nodes {
+		if n.Type == flowgraph.NodeModule || n.Type == flowgraph.NodeSdkInputs {
+			r.SDK = true
+		}
+	}
+	if r.SDK {
+		for _, d := range Parse(SDKPolicy) {
+			if contains(Managed, d.Name) {
+				for _, s := range d.Sources {
+					needs.add(d.Name, s, "Web SDK", "")
+				}
+			}
+		}
+		for _, c := range r.Countries {
+			if base, ok := countryBaseURL[c]; ok {
+				if s, ok := hostSource(base); ok {
+					needs.add("connect-src", s, "Region for "+strings.ToUpper(c), "")
+				}
+			}
+		}
+	}
+
+	unresolved := make(map[string]bool)
+	for _, n := range g.Nodes {
+		var urls, addrs []string
+		switch n.Type {
+		case flowgraph.NodeModule, flowgraph.NodeSdkInputs:
+			m := modules[n.Str("moduleType")]
+			urls = append(n.StringList("cspUrls"), m.CspURLs...)
+			addrs = append(n.StringList("ipAddresses"), m.IPAddresses...)
+		case flowgraph.NodeAPIModule:
+			urls = n.APICall().CspURLs
+			addrs = n.StringList("ipAddresses")
+		default:
+			continue
+		}
+
+		label := n.Label()
+		for _, raw := range urls {
+			resolved := r.resolve(raw)
+			if len(resolved) == 0 {
+				unresolved[raw] = true
+			}
+			for _, u := range resolved {
+				if s, ok := hostSource(u); ok {
+					needs.add("connect-src", s, "Module", label)
+				}
+			}
+		}
+		for _, ip := range addrs {
+			addIP(ip, label)
+		}
+	}
+
+	for _, name := range Managed {
+		var sources []string
+		for _, n := range needs.list {
+			if n.Directive == name {
+				sources = append(sources, n.Source)
+			}
+		}
+		if len(sources) > 0 {
+			r.Policy = append(r.Policy, Directive{Name: name, Sources: sources})
+		}
+	}
+	r.Sources = append(r.Sources, needs.list...)
+	for _, ip := range ipOrder {
+		r.EgressIPs = append(r.EgressIPs, Egress{IP: ip, Nodes: ips[ip]})
+	}
+	for raw := range unresolved {
+		r.Unresolved = append(r.Unresolved, raw)
+	}
+	sort.Strings(r.Unresolved)
+
+	r.Header, r.MetaTag, r.Nginx, r.Apache = Snippets(r.Policy)
+	return r
+}
+
+// resolve expands a regional URL template for every selected country. It
+// returns nothing when the URL is a template and no country resolves it.
+func (r *Result) resolve(raw string) []string {
+	if !template.MatchString(raw) {
+		return []string{raw}
+	}
+	var out []string
+	for _, c := range r.Countries {
+		if base, ok := countryBaseURL[c]; ok {
+			u := template.ReplaceAllString(raw, base)
+			if !contains(out, u) {
+				out = append(out, u)
+			}
+		}
+	}
+	return out
+}
+
+// Snippets renders a policy as a header line, an HTML meta tag and nginx
+// and Apache configuration. An empty policy gives empty snippets.
+func Snippets(p Policy) (header, meta, nginx, apache string) {
+	value := p.String()
+	if value == "" {
+		return "", "", "", ""
+	}
+	header = "Content-Security-Policy: " + value
+	meta = `<meta http-equiv="Content-Security-Policy" content="` + html.EscapeString(value) + `">`
+	nginx = `add_header Content-Security-Policy "` + value + `" always;`
+	apache = `Header always set Content-Security-Policy "` + value + `"`
+	return
+}
+
+// needs collects sources once per directive, remembering every node that
+// asked for them
+type needs struct {
+	list  []Need
+	index map[string]int
+}
+
+func (n *needs) add(directive, source, reason, node string) {
+	key := directive + " " + source
+	i, ok := n.index[key]
+	if !ok {
+		n.index[key] = len(n.list)
+		n.list = append(n.list, Need{Directive: directive, Source: source, Reason: reason})
+		i = len(n.list) - 1
+	}
+	if node != "" && !contains(n.list[i].Nodes, node) {
+		n.list[i].Nodes = append(n.list[i].Nodes, node)
+	}
+}
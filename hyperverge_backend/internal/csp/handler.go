@@ -0,0 +1,68 @@
+package csp
+
+import (
+	"net/http"
+	"strings"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DiffReq struct {
+	Current string `json:"current" binding:"required"`
+}
+
+// Handler computes the CSP and egress allowlist of a workflow
+// (GET /workflows/:id/csp?country=ind,sgp)
+func Handler(c *gin.Context) {
+	r, ok := generate(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, r)
+}
+
+// DiffHandler compares a customer's current CSP with what the workflow
+// needs (POST /workflows/:id/csp/diff)
+func DiffHandler(c *gin.Context) {
+	var req DiffReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, ok := generate(c)
+	if !ok {
+		return
+	}
+	r.Diff = Compare(r, req.Current)
+	c.JSON(http.StatusOK, r)
+}
+
+func generate(c *gin.Context) (*Result, bool) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return nil, false
+	}
+	modules, err := catalog.LoadModuleIndex()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return Generate(g, meta, modules, Countries(c)), true
+}
+
+// Countries reads ?country=, repeated or comma separated
+func Countries(c *gin.Context) []string {
+	var out []string
+	for _, v := range c.QueryArray("country") {
+		for _, code := range strings.Split(v, ",") {
+			if code = strings.TrimSpace(code); code != "" {
+				out = append(out, code)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,208 @@
+// Package csp works out the Content-Security-Policy and egress allowlist a
+// workflow needs: the Web SDK's own sources plus the hosts of the modules
+// it uses. It is the Go port of frontend/scripts/csp.js.
+package csp
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Managed are the directives generated from a workflow, in output order
+var Managed = []string{"script-src", "connect-src", "img-src", "frame-src", "worker-src"}
+
+// fallbacks is where browsers look when a directive is missing
+var fallbacks = map[string][]string{
+	"script-src":  {"default-src"},
+	"connect-src": {"default-src"},
+	"img-src":     {"default-src"},
+	"style-src":   {"default-src"},
+	"frame-src":   {"child-src", "default-src"},
+	"worker-src":  {"child-src", "script-src", "default-src"},
+}
+
+type Directive struct {
+	Name    string   `json:"name"`
+	Sources []string `json:"sources"`
+}
+
+// Policy is a parsed CSP in the order its directives were written
+type Policy []Directive
+
+// Parse reads a policy. Directive names are lower-cased; the first of
+// repeated directives wins, as in browsers.
+func Parse(s string) Policy {
+	var p Policy
+	for _, part := range strings.Split(s, ";") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		name := strings.ToLower(fields[0])
+		if p.Get(name) != nil {
+			continue
+		}
+		p = append(p, Directive{Name: name, Sources: fields[1:]})
+	}
+	return p
+}
+
+// Get returns a directive, or nil
+func (p Policy) Get(name string) *Directive {
+	for i := range p {
+		if p[i].Name == name {
+			return &p[i]
+		}
+	}
+	return nil
+}
+
+// Add appends sources to a directive that doesn't list them yet, creating
+// the directive when needed
+func (p *Policy) Add(name string, sources ...string) {
+	d := p.Get(name)
+	if d == nil {
+		*p = append(*p, Directive{Name: name})
+		d = &(*p)[len(*p)-1]
+	}
+	for _, s := range sources {
+		if !contains(d.Sources, s) {
+			d.Sources = append(d.Sources, s)
+		}
+	}
+}
+
+// String writes the policy as a header value
+func (p Policy) String() string {
+	parts := make([]string, 0, len(p))
+	for _, d := range p {
+		parts = append(parts, strings.TrimSpace(d.Name+" "+strings.Join(d.Sources, " ")))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return strings.Join(parts, "; ") + ";"
+}
+
+// effective returns the sources that govern a directive, following the
+// fallback chain; ok is false when nothing restricts it
+func (p Policy) effective(name string) (sources []string, from string, ok bool) {
+	if d := p.Get(name); d != nil {
+		return d.Sources, name, true
+	}
+	for _, f := range fallbacks[name] {
+		if d := p.Get(f); d != nil {
+			return d.Sources, f, true
+		}
+	}
+	return nil, "", false
+}
+
+// Allows reports whether a source list permits a source expression we
+// generate: a keyword such as 'self', a scheme such as blob:, or a
+// scheme://host URL that may itself be a wildcard
+func Allows(list []string, source string) bool {
+	if contains(list, source) {
+		return true
+	}
+	if isKeyword(source) || strings.HasSuffix(source, ":") {
+		return false
+	}
+
+	scheme, host := splitSource(source)
+	for _, allowed := range list {
+		if allowed == "'none'" || isKeyword(allowed) {
+			continue
+		}
+		if allowed == "*" {
+			return true
+		}
+		if strings.HasSuffix(allowed, ":") {
+			if strings.TrimSuffix(allowed, ":") == scheme {
+				return true
+			}
+			continue
+		}
+		aScheme, aHost := splitSource(allowed)
+		if aScheme != "" && !schemeMatches(aScheme, scheme) {
+			continue
+		}
+		if hostMatches(aHost, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitSource splits a host source into scheme and host, dropping any port
+// and path
+func splitSource(s string) (scheme, host string) {
+	if i := strings.Index(s, "://"); i >= 0 {
+		scheme, s = strings.ToLower(s[:i]), s[i+3:]
+	}
+	if i := strings.IndexAny(s, "/?#"); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		s = s[:i]
+	}
+	return scheme, strings.ToLower(s)
+}
+
+// schemeMatches follows CSP: an http source also allows https, ws allows wss
+func schemeMatches(allowed, scheme string) bool {
+	switch {
+	case scheme == "":
+		return true
+	case allowed == scheme:
+		return true
+	case allowed == "http" && scheme == "https", allowed == "ws" && scheme == "wss":
+		return true
+	}
+	return false
+}
+
+func hostMatches(pattern, host string) bool {
+	if pattern == host {
+		return true
+	}
+	// *.example.com matches sub.example.com and the wildcard *.sub.example.com
+	if rest, ok := strings.CutPrefix(pattern, "*."); ok {
+		return strings.HasSuffix(host, "."+rest)
+	}
+	return false
+}
+
+func isKeyword(s string) bool {
+	return strings.HasPrefix(s, "'") && strings.HasSuffix(s, "'")
+}
+
+// hostSource turns a URL or bare host into an https source expression;
+// ok is false for values that aren't hosts (unresolved templates, paths)
+func hostSource(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.ContainsAny(raw, "{} ") {
+		return "", false
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return "", false
+	}
+	scheme := u.Scheme
+	if scheme == "http" {
+		scheme = "https"
+	}
+	return scheme + "://" + strings.ToLower(u.Host), true
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,322 @@
+package csp
+
+// SDKPolicy is what the HyperVerge Web SDK itself needs, whatever modules a
+// workflow uses. Kept in sync with EXISTING_CSP_POLICY in
+// frontend/scripts/csp.js.
+const SDKPolicy = `
+script-src
+  'self'
+  'unsafe-eval'
+  'unsafe-inline'
+  https://websdk-c9a29-default-rtdb.firebaseio.com
+  https://cdn.jsdelivr.net
+  https://cdnjs.cloudflare.com
+  https://hv-web-sdk-cdn.hyperverge.co
+  https://config-cdn.hyperverge.co
+  https://www.gstatic.com
+  https://hv-camera-web-sg.s3.ap-southeast-1.amazonaws.com
+  https://hv-camera-web-sg.s3-ap-southeast-1.amazonaws.com
+  https://*.firebaseio.com;
+connect-src
+  data:
+  blob:
+  wss://*.firebaseio.com
+  https://www.gstatic.com
+  https://o435277.ingest.us.sentry.io
+  https://identitytoolkit.googleapis.com
+  https://securetoken.googleapis.com
+  'self'
+  https://hv-camera-web-sg.s3-ap-southeast-1.amazonaws.com
+  https://hv-camera-web-sg.s3.ap-southeast-1.amazonaws.com
+  https://cdn.jsdelivr.net
+  https://cdnjs.cloudflare.com
+  https://hv-websdk.s3.ap-south-1.amazonaws.com
+  https://hv-web-sdk-cdn.hyperverge.co
+  https://config-cdn.hyperverge.co
+  https://hypersnapweb.hyperverge.co
+  https://ind.idv.hyperverge.co
+  https://ind-thomas.hyperverge.co
+  https://ind-engine.thomas.hyperverge.co
+  https://api.ipify.org
+  https://www.cloudflare.com
+  https://o435277.ingest.sentry.io
+  https://websdk-c9a29-default-rtdb.firebaseio.com
+  https://*.firebaseapp.com
+  https://dq4nytsa795t1.cloudfront.net
+  https://*.edge.hyperverge.co;
+img-src
+  'self'
+  blob:
+  data:
+  https://hv-web-sdk-cdn.hyperverge.co
+  https://config-cdn.hyperverge.co
+  https://hv-camera-web-sg.s3.ap-southeast-1.amazonaws.com
+  https://hv-camera-web-sg.s3-ap-southeast-1.amazonaws.com
+  https://*.edge.hyperverge.co;
+style-src
+  'self'
+  'unsafe-inline'
+  https://fonts.googleapis.com;
+frame-src
+  'self'
+  https://*.firebaseio.com;
+worker-src
+  'self'
+  blob:;
+`
+
+// countryBaseURL maps ISO 3166 alpha-3 country codes to the regional base
+// URL that SDK modules with a {<module>.baseUrl} template call. Same as
+// COUNTRY_BASE_URL_MAP in frontend/scripts/csp.js.
+var countryBaseURL = map[string]string{
+	"abw": "https://sgp.idv.hyperverge.co",
+	"afg": "https://sgp.idv.hyperverge.co",
+	"ago": "https://sgp.idv.hyperverge.co",
+	"aia": "https://sgp.idv.hyperverge.co",
+	"ala": "https://sgp.idv.hyperverge.co",
+	"alb": "https://sgp.idv.hyperverge.co",
+	"and": "https://sgp.idv.hyperverge.co",
+	"are": "https://ind.idv.hyperverge.co",
+	"arg": "https://sgp.idv.hyperverge.co",
+	"arm": "https://sgp.idv.hyperverge.co",
+	"asm": "https://sgp.idv.hyperverge.co",
+	"ata": "https://sgp.idv.hyperverge.co",
+	"atf": "https://sgp.idv.hyperverge.co",
+	"atg": "https://sgp.idv.hyperverge.co",
+	"aus": "https://sgp.idv.hyperverge.co",
+	"aut": "https://irl.idv.hyperverge.co",
+	"aze": "https://sgp.idv.hyperverge.co",
+	"bdi": "https://sgp.idv.hyperverge.co",
+	"bel": "https://irl.idv.hyperverge.co",
+	"ben": "https://sgp.idv.hyperverge.co",
+	"bes": "https://sgp.idv.hyperverge.co",
+	"bfa": "https://zaf.idv.hyperverge.co",
+	"bgd": "https://sgp.idv.hyperverge.co",
+	"bgr": "https://irl.idv.hyperverge.co",
+	"bhr": "https://sgp.idv.hyperverge.co",
+	"bhs": "https://sgp.idv.hyperverge.co",
+	"bih": "https://sgp.idv.hyperverge.co",
+	"blm": "https://sgp.idv.hyperverge.co",
+	"blr": "https://sgp.idv.hyperverge.co",
+	"blz": "https://sgp.idv.hyperverge.co",
+	"bmu": "https://sgp.idv.hyperverge.co",
+	"bol": "https://sgp.idv.hyperverge.co",
+	"bra": "https://sgp.idv.hyperverge.co",
+	"brb": "https://sgp.idv.hyperverge.co",
+	"brn": "https://sgp.idv.hyperverge.co",
+	"btn": "https://sgp.idv.hyperverge.co",
+	"bvt": "https://sgp.idv.hyperverge.co",
+	"bwa": "https://zaf.idv.hyperverge.co",
+	"caf": "https://sgp.idv.hyperverge.co",
+	"can": "https://sgp.idv.hyperverge.co",
+	"cck": "https://sgp.idv.hyperverge.co",
+	"che": "https://irl.idv.hyperverge.co",
+	"chl": "https://sgp.idv.hyperverge.co",
+	"chn": "https://sgp.idv.hyperverge.co",
+	"civ": "https://zaf.idv.hyperverge.co",
+	"cmr": "https://zaf.idv.hyperverge.co",
+	"cod": "https://zaf.idv.hyperverge.co",
+	"cog": "https://sgp.idv.hyperverge.co",
+	"cok": "https://sgp.idv.hyperverge.co",
+	"col": "https://sgp.idv.hyperverge.co",
+	"com": "https://sgp.idv.hyperverge.co",
+	"cpv": "https://sgp.idv.hyperverge.co",
+	"cri": "https://sgp.idv.hyperverge.co",
+	"cub": "https://sgp.idv.hyperverge.co",
+	"cuw": "https://sgp.idv.hyperverge.co",
+	"cxr": "https://sgp.idv.hyperverge.co",
+	"cym": "https://sgp.idv.hyperverge.co",
+	"cyp": "https://irl.idv.hyperverge.co",
+	"cze": "https://irl.idv.hyperverge.co",
+	"deu": "https://irl.idv.hyperverge.co",
+	"dji": "https://sgp.idv.hyperverge.co",
+	"dma": "https://sgp.idv.hyperverge.co",
+	"dnk": "https://irl.idv.hyperverge.co",
+	"dom": "https://sgp.idv.hyperverge.co",
+	"dza": "https://zaf.idv.hyperverge.co",
+	"ecu": "https://sgp.idv.hyperverge.co",
+	"egy": "https://zaf.idv.hyperverge.co",
+	"eri": "https://sgp.idv.hyperverge.co",
+	"esh": "https://sgp.idv.hyperverge.co",
+	"esp": "https://irl.idv.hyperverge.co",
+	"est": "https://irl.idv.hyperverge.co",
+	"eth": "https://sgp.idv.hyperverge.co",
+	"fin": "https://irl.idv.hyperverge.co",
+	"fji": "https://sgp.idv.hyperverge.co",
+	"flk": "https://sgp.idv.hyperverge.co",
+	"fra": "https://irl.idv.hyperverge.co",
+	"fro": "https://sgp.idv.hyperverge.co",
+	"fsm": "https://sgp.idv.hyperverge.co",
+	"gab": "https://sgp.idv.hyperverge.co",
+	"gbr": "https://irl.idv.hyperverge.co",
+	"geo": "https://sgp.idv.hyperverge.co",
+	"ggy": "https://sgp.idv.hyperverge.co",
+	"gha": "https://zaf.idv.hyperverge.co",
+	"gib": "https://sgp.idv.hyperverge.co",
+	"gin": "https://sgp.idv.hyperverge.co",
+	"glp": "https://sgp.idv.hyperverge.co",
+	"gmb": "https://sgp.idv.hyperverge.co",
+	"gnb": "https://sgp.idv.hyperverge.co",
+	"gnq": "https://sgp.idv.hyperverge.co",
+	"grc": "https://irl.idv.hyperverge.co",
+	"grd": "https://sgp.idv.hyperverge.co",
+	"grl": "https://sgp.idv.hyperverge.co",
+	"gtm": "https://sgp.idv.hyperverge.co",
+	"guf": "https://sgp.idv.hyperverge.co",
+	"gum": "https://sgp.idv.hyperverge.co",
+	"guy": "https://sgp.idv.hyperverge.co",
+	"hkg": "https://sgp.idv.hyperverge.co",
+	"hmd": "https://sgp.idv.hyperverge.co",
+	"hnd": "https://sgp.idv.hyperverge.co",
+	"hrv": "https://irl.idv.hyperverge.co",
+	"hti": "https://sgp.idv.hyperverge.co",
+	"hun": "https://irl.idv.hyperverge.co",
+	"idn": "https://idn.idv.hyperverge.co",
+	"imn": "https://sgp.idv.hyperverge.co",
+	"ind": "https://ind.idv.hyperverge.co",
+	"iot": "https://sgp.idv.hyperverge.co",
+	"irl": "https://irl.idv.hyperverge.co",
+	"irn": "https://sgp.idv.hyperverge.co",
+	"irq": "https://sgp.idv.hyperverge.co",
+	"isl": "https://irl.idv.hyperverge.co",
+	"isr": "https://sgp.idv.hyperverge.co",
+	"ita": "https://irl.idv.hyperverge.co",
+	"jam": "https://sgp.idv.hyperverge.co",
+	"jey": "https://sgp.idv.hyperverge.co",
+	"jor": "https://sgp.idv.hyperverge.co",
+	"jpn": "https://sgp.idv.hyperverge.co",
+	"kaz": "https://sgp.idv.hyperverge.co",
+	"ken": "https://zaf.idv.hyperverge.co",
+	"kgz": "https://sgp.idv.hyperverge.co",
+	"khm": "https://sgp.idv.hyperverge.co",
+	"kir": "https://sgp.idv.hyperverge.co",
+	"kna": "https://sgp.idv.hyperverge.co",
+	"kor": "https://sgp.idv.hyperverge.co",
+	"kos": "https://sgp.idv.hyperverge.co",
+	"kwt": "https://sgp.idv.hyperverge.co",
+	"lao": "https://sgp.idv.hyperverge.co",
+	"lbn": "https://sgp.idv.hyperverge.co",
+	"lbr": "https://sgp.idv.hyperverge.co",
+	"lby": "https://zaf.idv.hyperverge.co",
+	"lca": "https://sgp.idv.hyperverge.co",
+	"lie": "https://irl.idv.hyperverge.co",
+	"lka": "https://sgp.idv.hyperverge.co",
+	"lso": "https://sgp.idv.hyperverge.co",
+	"ltu": "https://irl.idv.hyperverge.co",
+	"lux": "https://irl.idv.hyperverge.co",
+	"lva": "https://irl.idv.hyperverge.co",
+	"mac": "https://sgp.idv.hyperverge.co",
+	"maf": "https://sgp.idv.hyperverge.co",
+	"mar": "https://zaf.idv.hyperverge.co",
+	"mco": "https://sgp.idv.hyperverge.co",
+	"mda": "https://sgp.idv.hyperverge.co",
+	"mdg": "https://sgp.idv.hyperverge.co",
+	"mdv": "https://sgp.idv.hyperverge.co",
+	"mex": "https://sgp.idv.hyperverge.co",
+	"mhl": "https://sgp.idv.hyperverge.co",
+	"mkd": "https://sgp.idv.hyperverge.co",
+	"mli": "https://sgp.idv.hyperverge.co",
+	"mlt": "https://irl.idv.hyperverge.co",
+	"mmr": "https://sgp.idv.hyperverge.co",
+	"mne": "https://sgp.idv.hyperverge.co",
+	"mng": "https://sgp.idv.hyperverge.co",
+	"mnp": "https://sgp.idv.hyperverge.co",
+	"moz": "https://zaf.idv.hyperverge.co",
+	"mrt": "https://sgp.idv.hyperverge.co",
+	"msr": "https://sgp.idv.hyperverge.co",
+	"mtq": "https://sgp.idv.hyperverge.co",
+	"mus": "https://zaf.idv.hyperverge.co",
+	"mwi": "https://sgp.idv.hyperverge.co",
+	"mys": "https://sgp.idv.hyperverge.co",
+	"myt": "https://sgp.idv.hyperverge.co",
+	"nam": "https://sgp.idv.hyperverge.co",
+	"ncl": "https://sgp.idv.hyperverge.co",
+	"ner": "https://sgp.idv.hyperverge.co",
+	"nfk": "https://sgp.idv.hyperverge.co",
+	"nga": "https://zaf.idv.hyperverge.co",
+	"nic": "https://sgp.idv.hyperverge.co",
+	"niu": "https://sgp.idv.hyperverge.co",
+	"nld": "https://irl.idv.hyperverge.co",
+	"nor": "https://irl.idv.hyperverge.co",
+	"npl": "https://sgp.idv.hyperverge.co",
+	"nru": "https://sgp.idv.hyperverge.co",
+	"nzl": "https://sgp.idv.hyperverge.co",
+	"omn": "https://sgp.idv.hyperverge.co",
+	"pak": "https://sgp.idv.hyperverge.co",
+	"pan": "https://sgp.idv.hyperverge.co",
+	"pcn": "https://sgp.idv.hyperverge.co",
+	"per": "https://sgp.idv.hyperverge.co",
+	"phl": "https://sgp.idv.hyperverge.co",
+	"plw": "https://sgp.idv.hyperverge.co",
+	"png": "https://sgp.idv.hyperverge.co",
+	"pol": "https://irl.idv.hyperverge.co",
+	"pri": "https://sgp.idv.hyperverge.co",
+	"prk": "https://sgp.idv.hyperverge.co",
+	"prt": "https://irl.idv.hyperverge.co",
+	"pry": "https://sgp.idv.hyperverge.co",
+	"pse": "https://sgp.idv.hyperverge.co",
+	"pyf": "https://sgp.idv.hyperverge.co",
+	"qat": "https://sgp.idv.hyperverge.co",
+	"reu": "https://sgp.idv.hyperverge.co",
+	"rou": "https://irl.idv.hyperverge.co",
+	"rus": "https://sgp.idv.hyperverge.co",
+	"rwa": "https://zaf.idv.hyperverge.co",
+	"sau": "https://sgp.idv.hyperverge.co",
+	"sdn": "https://zaf.idv.hyperverge.co",
+	"sen": "https://zaf.idv.hyperverge.co",
+	"sgp": "https://sgp.idv.hyperverge.co",
+	"sgs": "https://sgp.idv.hyperverge.co",
+	"shn": "https://sgp.idv.hyperverge.co",
+	"sjm": "https://sgp.idv.hyperverge.co",
+	"slb": "https://sgp.idv.hyperverge.co",
+	"sle": "https://sgp.idv.hyperverge.co",
+	"slv": "https://sgp.idv.hyperverge.co",
+	"smr": "https://sgp.idv.hyperverge.co",
+	"som": "https://sgp.idv.hyperverge.co",
+	"spm": "https://sgp.idv.hyperverge.co",
+	"srb": "https://sgp.idv.hyperverge.co",
+	"ssd": "https://sgp.idv.hyperverge.co",
+	"stp": "https://sgp.idv.hyperverge.co",
+	"sur": "https://sgp.idv.hyperverge.co",
+	"svk": "https://irl.idv.hyperverge.co",
+	"svn": "https://irl.idv.hyperverge.co",
+	"swe": "https://irl.idv.hyperverge.co",
+	"swz": "https://zaf.idv.hyperverge.co",
+	"sxm": "https://sgp.idv.hyperverge.co",
+	"syc": "https://sgp.idv.hyperverge.co",
+	"syr": "https://sgp.idv.hyperverge.co",
+	"tca": "https://sgp.idv.hyperverge.co",
+	"tcd": "https://sgp.idv.hyperverge.co",
+	"tgo": "https://sgp.idv.hyperverge.co",
+	"tha": "https://sgp.idv.hyperverge.co",
+	"tjk": "https://sgp.idv.hyperverge.co",
+	"tkl": "https://sgp.idv.hyperverge.co",
+	"tkm": "https://sgp.idv.hyperverge.co",
+	"tls": "https://sgp.idv.hyperverge.co",
+	"ton": "https://sgp.idv.hyperverge.co",
+	"tto": "https://sgp.idv.hyperverge.co",
+	"tun": "https://zaf.idv.hyperverge.co",
+	"tur": "https://sgp.idv.hyperverge.co",
+	"tuv": "https://sgp.idv.hyperverge.co",
+	"twn": "https://sgp.idv.hyperverge.co",
+	"tza": "https://zaf.idv.hyperverge.co",
+	"uga": "https://zaf.idv.hyperverge.co",
+	"ukr": "https://sgp.idv.hyperverge.co",
+	"umi": "https://sgp.idv.hyperverge.co",
+	"ury": "https://sgp.idv.hyperverge.co",
+	"usa": "https://usa.idv.hyperverge.co",
+	"uzb": "https://sgp.idv.hyperverge.co",
+	"vat": "https://sgp.idv.hyperverge.co",
+	"vct": "https://sgp.idv.hyperverge.co",
+	"ven": "https://sgp.idv.hyperverge.co",
+	"vgb": "https://sgp.idv.hyperverge.co",
+	"vir": "https://sgp.idv.hyperverge.co",
+	"vnm": "https://sgp.idv.hyperverge.co",
+	"vut": "https://sgp.idv.hyperverge.co",
+	"wlf": "https://sgp.idv.hyperverge.co",
+	"wsm": "https://irl.idv.hyperverge.co",
+	"yem": "https://sgp.idv.hyperverge.co",
+	"zaf": "https://zaf.idv.hyperverge.co",
+	"zmb": "https://sgp.idv.hyperverge.co",
+	"zwe": "https://sgp.idv.hyperverge.co",
+}
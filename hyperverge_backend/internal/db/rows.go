@@ -0,0 +1,12 @@
+package db
+
+// GetString reads a text column of a row decoded from PostgREST. It returns
+// "" when the column is missing, null or not a string.
+func GetString(row map[string]interface{}, key string) string {
+	if val, ok := row[key]; ok {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return ""
+}
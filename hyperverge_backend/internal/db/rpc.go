@@ -0,0 +1,46 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+
+	supabase "github.com/supabase-community/supabase-go"
+)
+
+// RPCError is the body PostgREST returns when a database function fails
+type RPCError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Details string `json:"details"`
+	Hint    string `json:"hint"`
+}
+
+func (e *RPCError) Error() string {
+	if e.Details != "" {
+		return fmt.Sprintf("%s (%s): %s", e.Message, e.Code, e.Details)
+	}
+	return fmt.Sprintf("%s (%s)", e.Message, e.Code)
+}
+
+// CallRPC calls a Postgres function through PostgREST and decodes its result
+// into out. The function runs in its own transaction, so a failure rolls back
+// everything it did.
+func CallRPC(client *supabase.Client, name string, params interface{}, out interface{}) error {
+	body := client.Rpc(name, "", params)
+	if body == "" {
+		return fmt.Errorf("rpc %s: empty response", name)
+	}
+
+	var rpcErr RPCError
+	if json.Unmarshal([]byte(body), &rpcErr) == nil && rpcErr.Code != "" && rpcErr.Message != "" {
+		return &rpcErr
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(body), out); err != nil {
+		return fmt.Errorf("rpc %s: failed to parse response: %v", name, err)
+	}
+	return nil
+}
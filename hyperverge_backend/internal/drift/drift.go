@@ -0,0 +1,126 @@
+// Package drift finds API nodes whose copy of the catalog no longer matches
+// it. Nodes dropped from the catalog copy the endpoint, inputs, outputs and
+// examples of their API; when the catalog changes, the copies drift.
+package drift
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Issue kinds
+const (
+	IssueMissing          = "missing"            // the API was removed from the catalog
+	IssueRenamed          = "renamed"            // the API's name or URL changed
+	IssueNewRequiredInput = "new_required_input" // the API requires an input the node doesn't
+	IssueRemovedOutput    = "removed_output"     // the node uses an output the API no longer has
+)
+
+var documentationPage = regexp.MustCompile(`^https?://documentation\.hyperverge\.co/`)
+
+// Issue is one way a node drifted from its catalog API
+type Issue struct {
+	Kind  string `json:"kind"`
+	Field string `json:"field,omitempty"` // input or output name, or name/url when renamed
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// NodeDrift is an API node and how it drifted
+type NodeDrift struct {
+	NodeID  string  `json:"node_id"`
+	Title   string  `json:"title"`
+	APIID   string  `json:"api_id,omitempty"`
+	APIName string  `json:"api_name,omitempty"`
+	Issues  []Issue `json:"issues"`
+}
+
+// WorkflowDrift lists the drifted nodes of a workflow graph
+type WorkflowDrift struct {
+	Workflow *flowgraph.Meta `json:"workflow"`
+	Nodes    []NodeDrift     `json:"nodes"`
+}
+
+// Check lists the API nodes of g that drifted from the catalog
+func Check(ch *catalog.RevisionChecker, g *flowgraph.Graph, meta *flowgraph.Meta) *WorkflowDrift {
+	wd := &WorkflowDrift{Workflow: meta, Nodes: []NodeDrift{}}
+	for _, n := range g.Nodes {
+		if n.Type != flowgraph.NodeAPIModule {
+			continue
+		}
+		api, fromCatalog := ch.Match(n)
+		if !fromCatalog {
+			continue
+		}
+
+		nd := NodeDrift{NodeID: n.ID, Title: n.Label()}
+		if api == nil {
+			nd.Issues = []Issue{{Kind: IssueMissing, From: firstOf(n.Str("docUrl"), n.Str("endpoint"))}}
+		} else {
+			nd.APIID = api.ID
+			nd.APIName = api.Name
+			nd.Issues = compare(n, api, ch.Revisions(api.ID))
+		}
+		if len(nd.Issues) > 0 {
+			wd.Nodes = append(wd.Nodes, nd)
+		}
+	}
+	sort.SliceStable(wd.Nodes, func(i, j int) bool { return wd.Nodes[i].Title < wd.Nodes[j].Title })
+	return wd
+}
+
+// compare lists how n drifted from api, whose earlier states are revisions
+func compare(n flowgraph.Node, api *catalog.API, revisions []catalog.Revision) []Issue {
+	issues := []Issue{}
+
+	// The node keeps the title it was dropped with unless someone renamed
+	// it, so a title that was once the API's name means the API was renamed
+	title := strings.TrimSpace(n.Str("title"))
+	if title != api.Name {
+		for _, r := range revisions {
+			if r.Snapshot.Name == title {
+				issues = append(issues, Issue{Kind: IssueRenamed, Field: "name", From: title, To: api.Name})
+				break
+			}
+		}
+	}
+	// A documentation page the catalog no longer has means the API moved
+	if docURL := n.Str("docUrl"); docURL != api.URL && documentationPage.MatchString(docURL) {
+		issues = append(issues, Issue{Kind: IssueRenamed, Field: "url", From: docURL, To: api.URL})
+	}
+
+	call := n.APICall()
+	inputs := make(map[string]flowgraph.Field)
+	for _, f := range call.Inputs {
+		inputs[f.Name] = f
+	}
+	for _, f := range api.Inputs {
+		if f.Required && !inputs[f.Name].Required {
+			issues = append(issues, Issue{Kind: IssueNewRequiredInput, Field: f.Name})
+		}
+	}
+
+	outputs := make(map[string]bool)
+	for _, f := range api.Outputs {
+		outputs[f.Name] = true
+	}
+	for _, f := range call.Outputs {
+		if !outputs[f.Name] {
+			issues = append(issues, Issue{Kind: IssueRemovedOutput, Field: f.Name})
+		}
+	}
+	return issues
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
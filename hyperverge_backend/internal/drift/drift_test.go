@@ -0,0 +1,180 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+)
+
+const panURL = "https://documentation.hyperverge.co/api-reference/india/verify-pan"
+
+func testAPI() *catalog.API {
+	return &catalog.API{
+		ID: "a1", Name: "Verify PAN", Revision: 3, URL: panURL,
+		Description: "Checks a PAN number",
+		CurlExample: "curl -X POST https://ind.idv.hyperverge.co/v1/verifyPAN",
+		Inputs:      []catalog.Field{{Name: "pan", Type: "string", Required: true}, {Name: "dob", Type: "string", Required: true}},
+		Outputs:     []catalog.Field{{Name: "name", Type: "string"}},
+	}
+}
+
+func testRevisions() []catalog.Revision {
+	return []catalog.Revision{
+		{APIID: "a1", Revision: 3, Snapshot: catalog.APIReq{Name: "Verify PAN"}},
+		{APIID: "a1", Revision: 2, Snapshot: catalog.APIReq{Name: "PAN check"}},
+	}
+}
+
+func node(t *testing.T, data string) flowgraph.Node {
+	t.Helper()
+	g, err := flowgraph.Parse(fmt.Sprintf(`{"nodes": [{"id": "n1", "type": "apiModuleNode", "data": {%s}}], "edges": []}`, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g.Nodes[0]
+}
+
+func TestCompare(t *testing.T) {
+	current := `"docUrl": "` + panURL + `", "inputs": [{"name": "pan", "required": true}, {"name": "dob", "required": true}], "outputs": [{"name": "name"}]`
+	tests := []struct {
+		name string
+		data string
+		want []Issue
+	}{
+		{"no drift", `"title": "Verify PAN", ` + current, []Issue{}},
+		{"title of its own", `"title": "Check the PAN", ` + current, []Issue{}},
+		{
+			"renamed, found through an old revision name",
+			`"title": "PAN check", ` + current,
+			[]Issue{{Kind: IssueRenamed, Field: "name", From: "PAN check", To: "Verify PAN"}},
+		},
+		{
+			"moved documentation page",
+			`"title": "Verify PAN", "docUrl": "https://documentation.hyperverge.co/api-reference/india/pan", "inputs": [{"name": "pan", "required": true}, {"name": "dob", "required": true}]`,
+			[]Issue{{Kind: IssueRenamed, Field: "url", From: "https://documentation.hyperverge.co/api-reference/india/pan", To: panURL}},
+		},
+		{
+			"URL outside the documentation",
+			`"title": "Verify PAN", "docUrl": "https://example.com/pan", "inputs": [{"name": "pan", "required": true}, {"name": "dob", "required": true}]`,
+			[]Issue{},
+		},
+		{
+			"new required input",
+			`"title": "Verify PAN", "docUrl": "` + panURL + `", "inputs": [{"name": "pan", "required": true}, {"name": "dob"}]`,
+			[]Issue{{Kind: IssueNewRequiredInput, Field: "dob"}},
+		},
+		{
+			"absent required input",
+			`"title": "Verify PAN", "docUrl": "` + panURL + `", "inputs": [{"name": "pan", "required": true}]`,
+			[]Issue{{Kind: IssueNewRequiredInput, Field: "dob"}},
+		},
+		{
+			"removed output",
+			`"title": "Verify PAN", "docUrl": "` + panURL + `", "inputs": [{"name": "pan", "required": true}, {"name": "dob", "required": true}], "outputs": [{"name": "name"}, {"name": "fatherName"}]`,
+			[]Issue{{Kind: IssueRemovedOutput, Field: "fatherName"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compare(node(t, tt.data), testAPI(), testRevisions())
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("compare() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeFields(t *testing.T) {
+	current := []interface{}{
+		map[string]interface{}{"name": "pan", "type": "number", "required": false, "description": "From the form", "mapping": "{{form.pan}}"},
+		map[string]interface{}{"name": "gone", "type": "string"},
+		"not a field",
+	}
+	got := mergeFields(current, []catalog.Field{{Name: "pan", Type: "string", Required: true}, {Name: "dob", Type: "string"}})
+	want := []interface{}{
+		map[string]interface{}{"name": "pan", "type": "string", "required": true, "description": "From the form", "mapping": "{{form.pan}}"},
+		map[string]interface{}{"name": "dob", "type": "string", "required": false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeFields() = %v, want %v", got, want)
+	}
+	if current[0].(map[string]interface{})["type"] != "number" {
+		t.Error("mergeFields() changed the node's fields in place")
+	}
+	if got := mergeFields(nil, nil); got == nil || len(got) != 0 {
+		t.Errorf("mergeFields(nil, nil) = %#v, want an empty list", got)
+	}
+}
+
+func TestNodeData(t *testing.T) {
+	var flowData map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"nodes": [{"id": "n1", "data": {"title": "A"}}, {"id": "n2"}]}`), &flowData); err != nil {
+		t.Fatal(err)
+	}
+	if data := nodeData(flowData, "n1"); data["title"] != "A" {
+		t.Errorf("nodeData(n1) = %v", data)
+	}
+	data := nodeData(flowData, "n2")
+	if data == nil {
+		t.Fatal("nodeData(n2) = nil, want data created for the node")
+	}
+	data["title"] = "B"
+	if nodeData(flowData, "n2")["title"] != "B" {
+		t.Error("data created for n2 isn't stored on the node")
+	}
+	if data := nodeData(flowData, "n3"); data != nil {
+		t.Errorf("nodeData(n3) = %v, want nil", data)
+	}
+}
+
+func TestApply(t *testing.T) {
+	fields := `"inputs": [{"name": "pan", "type": "number", "mapping": "{{form.pan}}"}], "outputs": [{"name": "name", "description": "As on the card"}, {"name": "fatherName"}]`
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{"title follows a rename", "PAN check", "Verify PAN"},
+		{"title of the user's kept", "Check the PAN", "Check the PAN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := `{"title": "` + tt.title + `", "condition": "{{form.country}} == 'ind'", "color": "#f00", "docUrl": "` + panURL + `", ` + fields + `}`
+			var data map[string]interface{}
+			if err := json.Unmarshal([]byte(raw), &data); err != nil {
+				t.Fatal(err)
+			}
+			api := testAPI()
+			n := node(t, raw[1:len(raw)-1])
+			apply(data, api, compare(n, api, testRevisions()))
+
+			if data["title"] != tt.want {
+				t.Errorf("title = %v, want %q", data["title"], tt.want)
+			}
+			if data["condition"] != "{{form.country}} == 'ind'" || data["color"] != "#f00" {
+				t.Errorf("user settings lost: condition %v, color %v", data["condition"], data["color"])
+			}
+			if data["catalogApiId"] != "a1" || data["catalogRevision"] != 3 || data["method"] != "POST" || data["endpoint"] != panURL {
+				t.Errorf("catalog copy = %v %v %v %v", data["catalogApiId"], data["catalogRevision"], data["method"], data["endpoint"])
+			}
+
+			wantInputs := []interface{}{
+				map[string]interface{}{"name": "pan", "type": "string", "required": true, "mapping": "{{form.pan}}"},
+				map[string]interface{}{"name": "dob", "type": "string", "required": true},
+			}
+			if !reflect.DeepEqual(data["inputs"], wantInputs) {
+				t.Errorf("inputs = %v, want %v", data["inputs"], wantInputs)
+			}
+			wantOutputs := []interface{}{
+				map[string]interface{}{"name": "name", "type": "string", "required": false, "description": "As on the card"},
+			}
+			if !reflect.DeepEqual(data["outputs"], wantOutputs) {
+				t.Errorf("outputs = %v, want %v", data["outputs"], wantOutputs)
+			}
+		})
+	}
+}
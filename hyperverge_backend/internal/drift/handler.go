@@ -0,0 +1,89 @@
+package drift
+
+import (
+	"errors"
+	"net/http"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler returns the latest scan of every workflow, scanning now
+// when there is none yet or when asked to (GET /catalog/drift?refresh=true)
+func ReportHandler(c *gin.Context) {
+	r := Latest()
+	if r == nil || c.Query("refresh") == "true" {
+		var err error
+		if r, err = Scan(); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		store(r)
+	}
+	c.JSON(http.StatusOK, r)
+}
+
+// WorkflowReport is a scan of one workflow's draft and published versions
+type WorkflowReport struct {
+	Workflow *flowgraph.Meta  `json:"workflow"`
+	Drifted  []*WorkflowDrift `json:"drifted"`
+}
+
+// WorkflowHandler checks the draft and the published versions of a workflow
+// (GET /workflows/:id/drift)
+func WorkflowHandler(c *gin.Context) {
+	meta, ok := authorize(c, false)
+	if !ok {
+		return
+	}
+	drifted, err := ScanWorkflow(meta.WorkflowID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, WorkflowReport{Workflow: meta, Drifted: drifted})
+}
+
+// RefreshHandler copies the catalog's current version of an API into a node
+// of the workflow's draft (POST /workflows/:id/nodes/:nodeId/refresh)
+func RefreshHandler(c *gin.Context) {
+	meta, ok := authorize(c, true)
+	if !ok {
+		return
+	}
+	refreshed, err := Refresh(meta.WorkflowID, c.Param("nodeId"))
+	switch {
+	case errors.Is(err, ErrNodeNotFound), errors.Is(err, errWorkflowMissing):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrNotCatalogNode):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrAPIRemoved):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusOK, refreshed)
+	}
+}
+
+// authorize loads the workflow's draft and checks the user may read it, or
+// edit it when write is set
+func authorize(c *gin.Context, write bool) (*flowgraph.Meta, bool) {
+	_, meta, err := flowgraph.Load(flowgraph.Source{WorkflowID: c.Param("id")})
+	if err == flowgraph.ErrNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+
+	if !auth.CanAccessBU(meta.BusinessUnitID, c.GetString("userId"), write) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return nil, false
+	}
+	return meta, true
+}
@@ -0,0 +1,183 @@
+package drift
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/supabase-community/postgrest-go"
+)
+
+var (
+	ErrNodeNotFound    = errors.New("node not found in the draft")
+	ErrNotCatalogNode  = errors.New("node wasn't created from the catalog")
+	ErrAPIRemoved      = errors.New("the node's API was removed from the catalog")
+	errWorkflowMissing = errors.New("workflow not found")
+)
+
+// Refreshed is a node after its copy of the catalog was refreshed
+type Refreshed struct {
+	NodeID   string                 `json:"node_id"`
+	APIID    string                 `json:"api_id"`
+	APIName  string                 `json:"api_name"`
+	Revision int                    `json:"revision"`
+	Fixed    []Issue                `json:"fixed"`
+	Data     map[string]interface{} `json:"data"`
+}
+
+// Refresh copies the catalog's current endpoint, examples, inputs and
+// outputs into an API node of a workflow's draft. What the user set on the
+// node (its condition, CSP URLs, colour, a title of their own) is kept, as
+// is anything else set on inputs and outputs the API still has.
+func Refresh(workflowID, nodeID string) (*Refreshed, error) {
+	var rows []map[string]interface{}
+	if err := execute(client().From("test_workflows").Select("flow_data", "", false).Eq("id", workflowID), &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errWorkflowMissing
+	}
+	flowData, err := decode(rows[0]["flow_data"])
+	if err != nil {
+		return nil, err
+	}
+	g, err := flowgraph.Parse(flowData)
+	if err != nil {
+		return nil, err
+	}
+	n := g.Node(nodeID)
+	if n == nil || n.Type != flowgraph.NodeAPIModule {
+		return nil, ErrNodeNotFound
+	}
+
+	ch, err := catalog.NewRevisionChecker()
+	if err != nil {
+		return nil, err
+	}
+	api, fromCatalog := ch.Match(*n)
+	if !fromCatalog {
+		return nil, ErrNotCatalogNode
+	}
+	if api == nil {
+		return nil, ErrAPIRemoved
+	}
+	fixed := compare(*n, api, ch.Revisions(api.ID))
+
+	data := nodeData(flowData, nodeID)
+	if data == nil {
+		return nil, ErrNodeNotFound
+	}
+	apply(data, api, fixed)
+
+	encoded, err := json.Marshal(flowData)
+	if err != nil {
+		return nil, err
+	}
+	update := map[string]interface{}{
+		"flow_data":  string(encoded),
+		"updated_at": time.Now().UTC().Format(time.RFC3339),
+	}
+	var updated []map[string]interface{}
+	if err := execute(client().From("test_workflows").Update(update, "", "").Eq("id", workflowID), &updated); err != nil {
+		return nil, err
+	}
+	forget(workflowID)
+
+	return &Refreshed{NodeID: nodeID, APIID: api.ID, APIName: api.Name, Revision: api.Revision, Fixed: fixed, Data: data}, nil
+}
+
+// apply copies api into the data of a node that drifted with the fixed
+// issues. The title only follows the API's name when it was the old one.
+func apply(data map[string]interface{}, api *catalog.API, fixed []Issue) {
+	for _, issue := range fixed {
+		if issue.Kind == IssueRenamed && issue.Field == "name" {
+			data["title"] = api.Name
+		}
+	}
+	data["endpoint"] = api.URL
+	data["docUrl"] = api.URL
+	data["description"] = api.Description
+	data["curlExample"] = api.CurlExample
+	data["successResponse"] = api.SuccessResponse
+	data["failureResponses"] = api.FailureResponses
+	data["errorDetails"] = api.ErrorDetails
+	data["inputs"] = mergeFields(data["inputs"], api.Inputs)
+	data["outputs"] = mergeFields(data["outputs"], api.Outputs)
+	if method := flowgraph.ParseCurl(api.CurlExample).Method; method != "" {
+		data["method"] = method
+	}
+	data["catalogApiId"] = api.ID
+	data["catalogRevision"] = api.Revision
+}
+
+// decode returns flow_data as a JSON object; the draft stores it as a string
+func decode(v interface{}) (map[string]interface{}, error) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return val, nil
+	case string:
+		var out map[string]interface{}
+		if err := json.Unmarshal([]byte(val), &out); err != nil {
+			return nil, fmt.Errorf("invalid flow_data: %v", err)
+		}
+		return out, nil
+	}
+	return nil, ErrNodeNotFound
+}
+
+// nodeData returns the data object of a node of flow_data, to edit in place
+func nodeData(flowData map[string]interface{}, nodeID string) map[string]interface{} {
+	nodes, _ := flowData["nodes"].([]interface{})
+	for _, item := range nodes {
+		node, ok := item.(map[string]interface{})
+		if !ok || node["id"] != nodeID {
+			continue
+		}
+		data, ok := node["data"].(map[string]interface{})
+		if !ok {
+			data = map[string]interface{}{}
+			node["data"] = data
+		}
+		return data
+	}
+	return nil
+}
+
+// mergeFields returns the catalog's fields, keeping what the node set on
+// fields it already had
+func mergeFields(current interface{}, fields []catalog.Field) []interface{} {
+	existing := make(map[string]map[string]interface{})
+	list, _ := current.([]interface{})
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			if name, _ := m["name"].(string); name != "" {
+				existing[name] = m
+			}
+		}
+	}
+
+	out := []interface{}{}
+	for _, f := range fields {
+		field := map[string]interface{}{}
+		for k, v := range existing[f.Name] {
+			field[k] = v
+		}
+		field["name"] = f.Name
+		field["type"] = f.Type
+		field["required"] = f.Required
+		out = append(out, field)
+	}
+	return out
+}
+
+func execute(query *postgrest.FilterBuilder, out interface{}) error {
+	data, _, err := query.Execute()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,210 @@
+package drift
+
+import (
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/supabase-community/postgrest-go"
+	"github.com/supabase-community/supabase-go"
+)
+
+// DefaultInterval is how often the background job scans when
+// CATALOG_DRIFT_INTERVAL isn't set
+const DefaultInterval = 6 * time.Hour
+
+// Report is a scan of every workflow's draft and published versions
+type Report struct {
+	ScannedAt time.Time        `json:"scanned_at"`
+	Graphs    int              `json:"graphs"` // drafts and versions scanned
+	Drifted   []*WorkflowDrift `json:"drifted"`
+	Errors    []string         `json:"errors"` // graphs that couldn't be loaded
+}
+
+// Scan checks the draft and every published version of every workflow
+func Scan() (*Report, error) {
+	ch, err := catalog.NewRevisionChecker()
+	if err != nil {
+		return nil, err
+	}
+
+	var workflows []map[string]interface{}
+	query := client().
+		From("test_workflows").
+		Select("id", "", false).
+		Order("created_at", &postgrest.OrderOpts{Ascending: true})
+	if err := execute(query, &workflows); err != nil {
+		return nil, err
+	}
+	versions, err := versionsByWorkflow("")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Report{ScannedAt: time.Now().UTC(), Drifted: []*WorkflowDrift{}, Errors: []string{}}
+	for _, wf := range workflows {
+		id := db.GetString(wf, "id")
+		for _, src := range sources(id, versions[id]) {
+			wd, err := checkSource(ch, src)
+			if err != nil {
+				r.Errors = append(r.Errors, describe(src)+": "+err.Error())
+				continue
+			}
+			r.Graphs++
+			if len(wd.Nodes) > 0 {
+				r.Drifted = append(r.Drifted, wd)
+			}
+		}
+	}
+	return r, nil
+}
+
+// ScanWorkflow checks the draft and every published version of a workflow
+func ScanWorkflow(workflowID string) ([]*WorkflowDrift, error) {
+	ch, err := catalog.NewRevisionChecker()
+	if err != nil {
+		return nil, err
+	}
+	versions, err := versionsByWorkflow(workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []*WorkflowDrift{}
+	for _, src := range sources(workflowID, versions[workflowID]) {
+		wd, err := checkSource(ch, src)
+		if err != nil {
+			return nil, err
+		}
+		if len(wd.Nodes) > 0 {
+			out = append(out, wd)
+		}
+	}
+	return out, nil
+}
+
+func checkSource(ch *catalog.RevisionChecker, src flowgraph.Source) (*WorkflowDrift, error) {
+	g, meta, err := flowgraph.Load(src)
+	if err != nil {
+		return nil, err
+	}
+	return Check(ch, g, meta), nil
+}
+
+// sources are the draft and the published versions of a workflow
+func sources(workflowID string, versionIDs []string) []flowgraph.Source {
+	out := []flowgraph.Source{{WorkflowID: workflowID}}
+	for _, v := range versionIDs {
+		out = append(out, flowgraph.Source{WorkflowID: workflowID, VersionID: v})
+	}
+	return out
+}
+
+// versionsByWorkflow lists version ids by workflow, for one workflow or all
+func versionsByWorkflow(workflowID string) (map[string][]string, error) {
+	query := client().
+		From("workflow_versions").
+		Select("id, workflow_id", "", false)
+	if workflowID != "" {
+		query = query.Eq("workflow_id", workflowID)
+	}
+	query = query.Order("created_at", &postgrest.OrderOpts{Ascending: true})
+
+	var rows []map[string]interface{}
+	if err := execute(query, &rows); err != nil {
+		return nil, err
+	}
+	out := make(map[string][]string)
+	for _, row := range rows {
+		wf := db.GetString(row, "workflow_id")
+		out[wf] = append(out[wf], db.GetString(row, "id"))
+	}
+	return out, nil
+}
+
+func describe(src flowgraph.Source) string {
+	if src.VersionID != "" {
+		return "workflow " + src.WorkflowID + " version " + src.VersionID
+	}
+	return "workflow " + src.WorkflowID
+}
+
+// The latest scan of the background job, or of an admin asking for one
+var (
+	latestMu sync.Mutex
+	latest   *Report
+)
+
+// Latest returns the latest scan, or nil before the first one
+func Latest() *Report {
+	latestMu.Lock()
+	defer latestMu.Unlock()
+	return latest
+}
+
+func store(r *Report) {
+	latestMu.Lock()
+	latest = r
+	latestMu.Unlock()
+}
+
+// forget drops a workflow from the latest scan after one of its nodes was
+// refreshed; the next scan adds it back if it still drifts
+func forget(workflowID string) {
+	latestMu.Lock()
+	defer latestMu.Unlock()
+	if latest == nil {
+		return
+	}
+	kept := []*WorkflowDrift{}
+	for _, wd := range latest.Drifted {
+		if wd.Workflow.WorkflowID != workflowID || wd.Workflow.VersionID != "" {
+			kept = append(kept, wd)
+		}
+	}
+	latest.Drifted = kept
+}
+
+// Interval is how often the background job scans, from
+// CATALOG_DRIFT_INTERVAL (a Go duration such as 30m; 0 turns it off)
+func Interval() time.Duration {
+	raw := os.Getenv("CATALOG_DRIFT_INTERVAL")
+	if raw == "" {
+		return DefaultInterval
+	}
+	interval, err := time.ParseDuration(raw)
+	if err != nil {
+		log.Printf("Invalid CATALOG_DRIFT_INTERVAL %q, using %s", raw, DefaultInterval)
+		return DefaultInterval
+	}
+	return interval
+}
+
+// Run scans now and then every interval, keeping the latest report. It is
+// meant for a long-running server; on Lambda, reports are made on request.
+func Run(interval time.Duration) {
+	for {
+		r, err := Scan()
+		if err != nil {
+			log.Printf("Catalog drift scan failed: %v", err)
+		} else {
+			store(r)
+			log.Printf("Catalog drift scan: %d graphs, %d drifted, %d errors", r.Graphs, len(r.Drifted), len(r.Errors))
+		}
+		time.Sleep(interval)
+	}
+}
+
+// client is the service client when configured: the background job has no
+// user, and handlers check access themselves
+func client() *supabase.Client {
+	if db.ServiceClient != nil {
+		return db.ServiceClient
+	}
+	return db.Client
+}
@@ -30,15 +30,6 @@ type EnvironmentResponse struct {
 	UpdatedAt       string                 `json:"updated_at"`
 }
 
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 func getMap(m map[string]interface{}, key string) map[string]interface{} {
 	if val, ok := m[key]; ok {
 		// Handle JSON string stored in DB
@@ -58,15 +49,15 @@ func getMap(m map[string]interface{}, key string) map[string]interface{} {
 
 func toResponse(env map[string]interface{}) EnvironmentResponse {
 	return EnvironmentResponse{
-		ID:              getString(env, "id"),
-		Name:            getString(env, "name"),
-		Description:     getString(env, "description"),
-		IntegrationType: getString(env, "integration_type"),
+		ID:              db.GetString(env, "id"),
+		Name:            db.GetString(env, "name"),
+		Description:     db.GetString(env, "description"),
+		IntegrationType: db.GetString(env, "integration_type"),
 		Variables:       getMap(env, "variables"),
-		BusinessUnitID:  getString(env, "business_unit_id"),
-		OwnerID:         getString(env, "owner_id"),
-		CreatedAt:       getString(env, "created_at"),
-		UpdatedAt:       getString(env, "updated_at"),
+		BusinessUnitID:  db.GetString(env, "business_unit_id"),
+		OwnerID:         db.GetString(env, "owner_id"),
+		CreatedAt:       db.GetString(env, "created_at"),
+		UpdatedAt:       db.GetString(env, "updated_at"),
 	}
 }
 
@@ -103,7 +94,7 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	clientId := getString(buResults[0], "client_id")
+	clientId := db.GetString(buResults[0], "client_id")
 
 	// Check if user owns the client
 	clientData, _, clientErr := db.Client.
@@ -215,7 +206,7 @@ func List(c *gin.Context) {
 		return
 	}
 
-	clientId := getString(buResults[0], "client_id")
+	clientId := db.GetString(buResults[0], "client_id")
 
 	// Check ownership or permissions
 	clientData, _, clientErr := db.Client.
@@ -346,7 +337,7 @@ func Update(c *gin.Context) {
 	}
 
 	env := envResults[0]
-	buId := getString(env, "business_unit_id")
+	buId := db.GetString(env, "business_unit_id")
 
 	// Check permissions (reusing logic: checks owner or editor)
 	buData, _, err := db.Client.
@@ -359,7 +350,7 @@ func Update(c *gin.Context) {
 		var buResults []map[string]interface{}
 		if err := json.Unmarshal(buData, &buResults); err == nil && len(buResults) > 0 {
 			buResult := buResults[0]
-			clientId := getString(buResult, "client_id")
+			clientId := db.GetString(buResult, "client_id")
 
 			clientData, _, clientErr := db.Client.
 				From("test_clients").
@@ -440,7 +431,7 @@ func Delete(c *gin.Context) {
 	}
 
 	env := envResults[0]
-	buId := getString(env, "business_unit_id")
+	buId := db.GetString(env, "business_unit_id")
 
 	// Check permissions
 	buData, _, err := db.Client.
@@ -453,7 +444,7 @@ func Delete(c *gin.Context) {
 		var buResults []map[string]interface{}
 		if err := json.Unmarshal(buData, &buResults); err == nil && len(buResults) > 0 {
 			buResult := buResults[0]
-			clientId := getString(buResult, "client_id")
+			clientId := db.GetString(buResult, "client_id")
 
 			clientData, _, clientErr := db.Client.
 				From("test_clients").
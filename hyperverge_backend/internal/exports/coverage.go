@@ -0,0 +1,84 @@
+package exports
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// coverageOf reports the decision coverage of an exported graph. Test cases
+// that can't be loaded or run shouldn't hold up the export, which then only
+// lists the paths and gaps.
+func coverageOf(g *flowgraph.Graph, meta *flowgraph.Meta) *coverage.Report {
+	r, err := coverage.Check(g, meta)
+	if err != nil {
+		log.Printf("exports: coverage of workflow %s: %v", meta.WorkflowID, err)
+		r = coverage.Analyze(g)
+		r.Workflow = meta
+	}
+	return r
+}
+
+// coverageLines sums up a coverage report in lines of text, for the
+// formats that only carry a description or comments
+func coverageLines(r *coverage.Report) []string {
+	paths := fmt.Sprint(len(r.Paths))
+	if r.Truncated {
+		paths = fmt.Sprintf("more than %d", coverage.MaxPaths)
+	}
+	lines := []string{"Decision paths: " + paths}
+	if len(r.Statuses) > 0 {
+		lines = append(lines, "Reachable statuses: "+strings.Join(r.Statuses, ", "))
+	}
+	if len(r.UnreachableEnds) > 0 {
+		var titles []string
+		for _, e := range r.UnreachableEnds {
+			titles = append(titles, e.Title)
+		}
+		lines = append(lines, "Unreachable ends: "+strings.Join(titles, ", "))
+	}
+	for _, gap := range r.MissingBranches {
+		lines = append(lines, "Missing branch: "+gap.Message)
+	}
+	for _, loop := range r.Traps {
+		lines = append(lines, "Loop without exit: "+strings.Join(loop.Titles, " -> "))
+	}
+	if t := r.Tests; t != nil {
+		lines = append(lines, fmt.Sprintf("Test cases: %d, %d passing; branches covered %d of %d (%.1f%%), paths %d of %d (%.1f%%)",
+			t.Cases, t.Passed, t.Branches.Covered, t.Branches.Total, t.Branches.Percent, t.Paths.Covered, t.Paths.Total, t.Paths.Percent))
+	} else {
+		lines = append(lines, "Test cases: none")
+	}
+	return lines
+}
+
+// coverageExtension is the x-coverage object of an OpenAPI document
+func coverageExtension(r *coverage.Report) map[string]interface{} {
+	unreachable := []string{}
+	for _, e := range r.UnreachableEnds {
+		unreachable = append(unreachable, e.NodeID)
+	}
+	missing := []string{}
+	for _, gap := range r.MissingBranches {
+		missing = append(missing, gap.Message)
+	}
+	traps := [][]string{}
+	for _, loop := range r.Traps {
+		traps = append(traps, loop.Nodes)
+	}
+	ext := map[string]interface{}{
+		"paths":            len(r.Paths),
+		"truncated":        r.Truncated,
+		"statuses":         r.Statuses,
+		"unreachable_ends": unreachable,
+		"missing_branches": missing,
+		"traps":            traps,
+	}
+	if r.Tests != nil {
+		ext["tests"] = r.Tests
+	}
+	return ext
+}
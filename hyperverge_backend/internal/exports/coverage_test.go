@@ -0,0 +1,71 @@
+package exports
+
+import (
+	"strings"
+	"testing"
+
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/flowgraph"
+)
+
+func TestCoverageInExports(t *testing.T) {
+	g, err := flowgraph.Parse(`{
+		"nodes": [
+			{"id": "start", "type": "startNode"},
+			{"id": "read", "type": "apiModuleNode", "data": {"title": "Read ID", "curlExample": "curl -X POST 'https://ind.idv.hyperverge.co/v1/readId'"}},
+			{"id": "approved", "type": "endStatusNode", "data": {"title": "Approved", "status": "auto-approved"}},
+			{"id": "review", "type": "endStatusNode", "data": {"title": "Review", "status": "needs-review"}}
+		],
+		"edges": [
+			{"id": "e1", "source": "start", "target": "read"},
+			{"id": "e2", "source": "read", "target": "approved", "sourceHandle": "success"}
+		]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := &flowgraph.Meta{WorkflowID: "w1", WorkflowName: "KYC", FlowType: "api"}
+	cov := coverage.Analyze(g)
+
+	tests := []struct {
+		name   string
+		export func(*coverage.Report) string
+		want   string
+	}{
+		{"postman description", func(r *coverage.Report) string { return BuildPostman(g, meta, false, r).Info.Description }, "- Decision paths: 1\n"},
+		{"mermaid comments", func(r *coverage.Report) string { return Mermaid(g, r) }, "    %% Unreachable ends: Review\n"},
+		{"dot comments", func(r *coverage.Report) string { return DOT(g, "KYC", r) }, "    // Test cases: none\n"},
+		{"bpmn documentation", func(r *coverage.Report) string {
+			out, err := BPMN(g, meta, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return string(out)
+		}, "<bpmn:documentation>Decision paths: 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.export(cov); !strings.Contains(got, tt.want) {
+				t.Errorf("export doesn't contain %q:\n%s", tt.want, got)
+			}
+			if got := tt.export(nil); strings.Contains(got, tt.want) {
+				t.Errorf("export without coverage contains %q", tt.want)
+			}
+		})
+	}
+
+	doc := BuildOpenAPI(g, meta, cov)
+	ext, ok := doc["x-coverage"].(map[string]interface{})
+	if !ok {
+		t.Fatal("OpenAPI document has no x-coverage")
+	}
+	if ext["paths"] != 1 || ext["truncated"] != false || strings.Join(ext["unreachable_ends"].([]string), ",") != "review" {
+		t.Errorf("x-coverage = %v", ext)
+	}
+	if _, ok := ext["tests"]; ok {
+		t.Error("x-coverage has tests without test cases")
+	}
+	if _, ok := BuildOpenAPI(g, meta, nil)["x-coverage"]; ok {
+		t.Error("x-coverage set without a coverage report")
+	}
+}
@@ -0,0 +1,530 @@
+package exports
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/slug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Diagram formats
+const (
+	FormatMermaid = "mermaid"
+	FormatDOT     = "dot"
+	FormatBPMN    = "bpmn"
+)
+
+// Diagram renders a workflow graph as Mermaid, Graphviz or BPMN
+// (GET /workflows/:id/export?format=mermaid|dot|bpmn)
+func Diagram(c *gin.Context) {
+	format := strings.ToLower(c.DefaultQuery("format", FormatMermaid))
+	if format != FormatMermaid && format != FormatDOT && format != FormatBPMN {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be mermaid, dot or bpmn"})
+		return
+	}
+
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+
+	name := slug.Make(meta.WorkflowName, "workflow")
+	cov := coverageOf(g, meta)
+	switch format {
+	case FormatMermaid:
+		c.Header("Content-Disposition", "inline; filename=\""+name+".mmd\"")
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(Mermaid(g, cov)))
+	case FormatDOT:
+		c.Header("Content-Disposition", "inline; filename=\""+name+".dot\"")
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(DOT(g, meta.WorkflowName, cov)))
+	case FormatBPMN:
+		out, err := BPMN(g, meta, cov)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Header("Content-Disposition", "inline; filename=\""+name+".bpmn\"")
+		c.Data(http.StatusOK, "application/xml; charset=utf-8", out)
+	}
+}
+
+// diagramNodes returns the nodes to draw: reachable ones in flow order, then
+// the rest. Notes and groups aren't drawn as nodes.
+func diagramNodes(g *flowgraph.Graph) []flowgraph.Node {
+	seen := make(map[string]bool)
+	var out []flowgraph.Node
+	add := func(n flowgraph.Node) {
+		if seen[n.ID] || n.Type == flowgraph.NodeNote || n.Type == flowgraph.NodeAPIGroup {
+			return
+		}
+		seen[n.ID] = true
+		out = append(out, n)
+	}
+	for _, n := range g.Ordered() {
+		add(n)
+	}
+	for _, n := range g.Nodes {
+		add(n)
+	}
+	return out
+}
+
+// diagramIDs assigns short identifiers that every format accepts
+func diagramIDs(g *flowgraph.Graph) map[string]string {
+	ids := make(map[string]string)
+	for i, n := range g.Nodes {
+		ids[n.ID] = fmt.Sprintf("n%d", i+1)
+	}
+	return ids
+}
+
+// nodeText is the label drawn for a node
+func nodeText(n flowgraph.Node) string {
+	switch n.Type {
+	case flowgraph.NodeEndStatus:
+		text := n.Str("status")
+		if text == "" {
+			text = "End"
+		}
+		if reason := strings.TrimSpace(n.Str("reason")); reason != "" {
+			text += "\n" + reason
+		}
+		return text
+	case flowgraph.NodeAPIModule:
+		return "API: " + n.Label()
+	}
+	return n.Label()
+}
+
+// groups returns the API group nodes with their drawn children
+func groups(g *flowgraph.Graph, drawn map[string]bool) ([]flowgraph.Node, map[string][]string) {
+	var list []flowgraph.Node
+	children := make(map[string][]string)
+	for _, n := range g.Nodes {
+		if n.Type != flowgraph.NodeAPIGroup {
+			continue
+		}
+		for _, child := range g.Children(n.ID) {
+			if drawn[child.ID] {
+				children[n.ID] = append(children[n.ID], child.ID)
+			}
+		}
+		if len(children[n.ID]) > 0 {
+			list = append(list, n)
+		}
+	}
+	return list, children
+}
+
+// Mermaid renders a flowchart, with a coverage report, when given, as
+// comments at the top
+func Mermaid(g *flowgraph.Graph, cov *coverage.Report) string {
+	ids := diagramIDs(g)
+	nodes := diagramNodes(g)
+	drawn := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		drawn[n.ID] = true
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	if cov != nil {
+		for _, line := range coverageLines(cov) {
+			b.WriteString("    %% " + line + "\n")
+		}
+	}
+
+	grouped := make(map[string]bool)
+	groupList, children := groups(g, drawn)
+	for _, grp := range groupList {
+		fmt.Fprintf(&b, "    subgraph %s[%s]\n", ids[grp.ID], mermaidText(grp.Label()))
+		for _, id := range children[grp.ID] {
+			b.WriteString("        " + mermaidNode(*g.Node(id), ids[id]) + "\n")
+			grouped[id] = true
+		}
+		b.WriteString("    end\n")
+	}
+
+	for _, n := range nodes {
+		if !grouped[n.ID] {
+			b.WriteString("    " + mermaidNode(n, ids[n.ID]) + "\n")
+		}
+	}
+
+	for _, n := range nodes {
+		for _, br := range g.Branches(n.ID) {
+			if !drawn[br.Target] {
+				continue
+			}
+			if label := br.Label(); label != "" {
+				fmt.Fprintf(&b, "    %s -->|%s| %s\n", ids[n.ID], mermaidText(label), ids[br.Target])
+			} else {
+				fmt.Fprintf(&b, "    %s --> %s\n", ids[n.ID], ids[br.Target])
+			}
+		}
+	}
+
+	b.WriteString("    classDef approved fill:#dcfce7,stroke:#16a34a\n")
+	b.WriteString("    classDef declined fill:#fee2e2,stroke:#dc2626\n")
+	b.WriteString("    classDef review fill:#fef9c3,stroke:#ca8a04\n")
+	b.WriteString("    classDef api fill:#e0e7ff,stroke:#4f46e5\n")
+	for _, n := range nodes {
+		if class := statusClass(n); class != "" {
+			fmt.Fprintf(&b, "    class %s %s\n", ids[n.ID], class)
+		}
+	}
+	return b.String()
+}
+
+func mermaidNode(n flowgraph.Node, id string) string {
+	text := mermaidText(nodeText(n))
+	switch n.Type {
+	case flowgraph.NodeStart:
+		return id + "((" + text + "))"
+	case flowgraph.NodeCondition:
+		return id + "{" + text + "}"
+	case flowgraph.NodeEndStatus:
+		return id + "([" + text + "])"
+	case flowgraph.NodeAPIModule:
+		return id + "[[" + text + "]]"
+	}
+	return id + "[" + text + "]"
+}
+
+// mermaidText quotes a label; Mermaid uses HTML entities for quotes
+func mermaidText(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "\n", "<br/>")
+	return `"` + s + `"`
+}
+
+func statusClass(n flowgraph.Node) string {
+	switch n.Type {
+	case flowgraph.NodeAPIModule:
+		return "api"
+	case flowgraph.NodeEndStatus:
+		switch n.Str("status") {
+		case flowgraph.StatusApproved:
+			return "approved"
+		case flowgraph.StatusDeclined:
+			return "declined"
+		case flowgraph.StatusNeedsReview:
+			return "review"
+		}
+	}
+	return ""
+}
+
+// DOT renders a Graphviz digraph, with a coverage report, when given, as
+// comments at the top
+func DOT(g *flowgraph.Graph, title string, cov *coverage.Report) string {
+	ids := diagramIDs(g)
+	nodes := diagramNodes(g)
+	drawn := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		drawn[n.ID] = true
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotString(title))
+	b.WriteString("    rankdir=TB;\n")
+	fmt.Fprintf(&b, "    label=%s;\n    labelloc=t;\n", dotString(title))
+	if cov != nil {
+		for _, line := range coverageLines(cov) {
+			b.WriteString("    // " + line + "\n")
+		}
+	}
+	b.WriteString("    node [fontname=\"Helvetica\", fontsize=11];\n")
+	b.WriteString("    edge [fontname=\"Helvetica\", fontsize=9];\n")
+
+	grouped := make(map[string]bool)
+	groupList, children := groups(g, drawn)
+	for _, grp := range groupList {
+		fmt.Fprintf(&b, "    subgraph cluster_%s {\n", ids[grp.ID])
+		fmt.Fprintf(&b, "        label=%s;\n        style=dashed;\n", dotString(grp.Label()))
+		for _, id := range children[grp.ID] {
+			b.WriteString("        " + dotNode(*g.Node(id), ids[id]) + "\n")
+			grouped[id] = true
+		}
+		b.WriteString("    }\n")
+	}
+
+	for _, n := range nodes {
+		if !grouped[n.ID] {
+			b.WriteString("    " + dotNode(n, ids[n.ID]) + "\n")
+		}
+	}
+
+	for _, n := range nodes {
+		for _, br := range g.Branches(n.ID) {
+			if !drawn[br.Target] {
+				continue
+			}
+			attrs := ""
+			if label := br.Label(); label != "" {
+				attrs = " [label=" + dotString(label)
+				if br.Kind == flowgraph.BranchFailure || br.Kind == flowgraph.BranchFalse {
+					attrs += ", style=dashed"
+				}
+				attrs += "]"
+			}
+			fmt.Fprintf(&b, "    %s -> %s%s;\n", ids[n.ID], ids[br.Target], attrs)
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotNode(n flowgraph.Node, id string) string {
+	attrs := []string{"label=" + dotString(nodeText(n))}
+	switch n.Type {
+	case flowgraph.NodeStart:
+		attrs = append(attrs, "shape=circle")
+	case flowgraph.NodeCondition:
+		attrs = append(attrs, "shape=diamond")
+	case flowgraph.NodeAPIModule:
+		attrs = append(attrs, "shape=box", "style=\"rounded,filled\"", "fillcolor=\"#e0e7ff\"")
+	case flowgraph.NodeEndStatus:
+		fill := "#f3f4f6"
+		switch n.Str("status") {
+		case flowgraph.StatusApproved:
+			fill = "#dcfce7"
+		case flowgraph.StatusDeclined:
+			fill = "#fee2e2"
+		case flowgraph.StatusNeedsReview:
+			fill = "#fef9c3"
+		}
+		attrs = append(attrs, "shape=box", "style=\"rounded,filled,bold\"", "fillcolor=\""+fill+"\"")
+	default:
+		attrs = append(attrs, "shape=box")
+	}
+	return id + " [" + strings.Join(attrs, ", ") + "];"
+}
+
+func dotString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// BPMN 2.0 document. The diagram interchange section places shapes where
+// they are on the canvas so modelers open it without relayout.
+type bpmnDefinitions struct {
+	XMLName         xml.Name      `xml:"bpmn:definitions"`
+	XmlnsBPMN       string        `xml:"xmlns:bpmn,attr"`
+	XmlnsBPMNDI     string        `xml:"xmlns:bpmndi,attr"`
+	XmlnsDC         string        `xml:"xmlns:dc,attr"`
+	XmlnsDI         string        `xml:"xmlns:di,attr"`
+	XmlnsXSI        string        `xml:"xmlns:xsi,attr"`
+	ID              string        `xml:"id,attr"`
+	TargetNamespace string        `xml:"targetNamespace,attr"`
+	Exporter        string        `xml:"exporter,attr"`
+	Process         bpmnProcess   `xml:"bpmn:process"`
+	Diagram         bpmnDiagramDI `xml:"bpmndi:BPMNDiagram"`
+}
+
+type bpmnProcess struct {
+	ID            string        `xml:"id,attr"`
+	Name          string        `xml:"name,attr"`
+	IsExecutable  bool          `xml:"isExecutable,attr"`
+	Documentation string        `xml:"bpmn:documentation,omitempty"`
+	Elements      []bpmnElement `xml:",any"`
+	Flows         []bpmnSeqFlow `xml:"bpmn:sequenceFlow"`
+}
+
+type bpmnElement struct {
+	XMLName       xml.Name
+	ID            string   `xml:"id,attr"`
+	Name          string   `xml:"name,attr,omitempty"`
+	Default       string   `xml:"default,attr,omitempty"`
+	Documentation string   `xml:"bpmn:documentation,omitempty"`
+	Incoming      []string `xml:"bpmn:incoming"`
+	Outgoing      []string `xml:"bpmn:outgoing"`
+}
+
+type bpmnSeqFlow struct {
+	ID        string         `xml:"id,attr"`
+	Name      string         `xml:"name,attr,omitempty"`
+	SourceRef string         `xml:"sourceRef,attr"`
+	TargetRef string         `xml:"targetRef,attr"`
+	Condition *bpmnCondition `xml:"bpmn:conditionExpression,omitempty"`
+}
+
+type bpmnCondition struct {
+	Type string `xml:"xsi:type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type bpmnDiagramDI struct {
+	ID    string      `xml:"id,attr"`
+	Plane bpmnPlaneDI `xml:"bpmndi:BPMNPlane"`
+}
+
+type bpmnPlaneDI struct {
+	ID      string        `xml:"id,attr"`
+	Element string        `xml:"bpmnElement,attr"`
+	Shapes  []bpmnShapeDI `xml:"bpmndi:BPMNShape"`
+	Edges   []bpmnEdgeDI  `xml:"bpmndi:BPMNEdge"`
+}
+
+type bpmnShapeDI struct {
+	ID      string     `xml:"id,attr"`
+	Element string     `xml:"bpmnElement,attr"`
+	Bounds  bpmnBounds `xml:"dc:Bounds"`
+}
+
+type bpmnBounds struct {
+	X      float64 `xml:"x,attr"`
+	Y      float64 `xml:"y,attr"`
+	Width  float64 `xml:"width,attr"`
+	Height float64 `xml:"height,attr"`
+}
+
+type bpmnEdgeDI struct {
+	ID        string         `xml:"id,attr"`
+	Element   string         `xml:"bpmnElement,attr"`
+	Waypoints []bpmnWaypoint `xml:"di:waypoint"`
+}
+
+type bpmnWaypoint struct {
+	X float64 `xml:"x,attr"`
+	Y float64 `xml:"y,attr"`
+}
+
+// BPMN renders the graph as a BPMN 2.0 process. API calls are service
+// tasks, SDK modules user tasks, condition nodes exclusive gateways and end
+// statuses end events; branch conditions become condition expressions. A
+// coverage report, when given, documents the process.
+func BPMN(g *flowgraph.Graph, meta *flowgraph.Meta, cov *coverage.Report) ([]byte, error) {
+	ids := diagramIDs(g)
+	nodes := diagramNodes(g)
+	drawn := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		drawn[n.ID] = true
+	}
+
+	process := bpmnProcess{ID: "Process_1", Name: meta.WorkflowName}
+	if cov != nil {
+		process.Documentation = strings.Join(coverageLines(cov), "\n")
+	}
+	plane := bpmnPlaneDI{ID: "BPMNPlane_1", Element: process.ID}
+	elements := make(map[string]*bpmnElement)
+	bounds := make(map[string]bpmnBounds)
+
+	for _, n := range nodes {
+		el := &bpmnElement{ID: ids[n.ID], Name: nodeText(n)}
+		w, h := 120.0, 80.0
+		switch n.Type {
+		case flowgraph.NodeStart:
+			el.XMLName.Local = "bpmn:startEvent"
+			w, h = 36, 36
+		case flowgraph.NodeEndStatus:
+			el.XMLName.Local = "bpmn:endEvent"
+			w, h = 36, 36
+		case flowgraph.NodeCondition:
+			el.XMLName.Local = "bpmn:exclusiveGateway"
+			w, h = 50, 50
+		case flowgraph.NodeAPIModule:
+			el.XMLName.Local = "bpmn:serviceTask"
+			el.Name = n.Label()
+			call := n.APICall()
+			el.Documentation = strings.TrimSpace(call.Method + " " + call.URL)
+			if call.DocURL != "" {
+				el.Documentation += "\n" + call.DocURL
+			}
+		case flowgraph.NodeModule:
+			el.XMLName.Local = "bpmn:userTask"
+		default:
+			el.XMLName.Local = "bpmn:task"
+		}
+		elements[n.ID] = el
+
+		pos := absolutePosition(g, n)
+		bounds[n.ID] = bpmnBounds{X: pos.X, Y: pos.Y, Width: w, Height: h}
+		plane.Shapes = append(plane.Shapes, bpmnShapeDI{
+			ID:      ids[n.ID] + "_di",
+			Element: ids[n.ID],
+			Bounds:  bounds[n.ID],
+		})
+	}
+
+	for _, n := range nodes {
+		for i, br := range g.Branches(n.ID) {
+			if !drawn[br.Target] {
+				continue
+			}
+			flowID := fmt.Sprintf("Flow_%s_%d", ids[n.ID], i+1)
+			flow := bpmnSeqFlow{ID: flowID, Name: br.Label(), SourceRef: ids[n.ID], TargetRef: ids[br.Target]}
+
+			switch {
+			case br.Kind == flowgraph.BranchFailure && elements[n.ID].Default == "":
+				// The failure branch is taken whenever success doesn't hold
+				elements[n.ID].Default = flowID
+			case br.Condition != "":
+				flow.Condition = &bpmnCondition{Type: "bpmn:tFormalExpression", Body: br.Condition}
+			}
+			process.Flows = append(process.Flows, flow)
+			elements[n.ID].Outgoing = append(elements[n.ID].Outgoing, flowID)
+			elements[br.Target].Incoming = append(elements[br.Target].Incoming, flowID)
+
+			from, to := bounds[n.ID], bounds[br.Target]
+			plane.Edges = append(plane.Edges, bpmnEdgeDI{
+				ID:      flowID + "_di",
+				Element: flowID,
+				Waypoints: []bpmnWaypoint{
+					{X: from.X + from.Width/2, Y: from.Y + from.Height},
+					{X: to.X + to.Width/2, Y: to.Y},
+				},
+			})
+		}
+	}
+
+	for _, n := range nodes {
+		process.Elements = append(process.Elements, *elements[n.ID])
+	}
+
+	doc := bpmnDefinitions{
+		XmlnsBPMN:       "http://www.omg.org/spec/BPMN/20100524/MODEL",
+		XmlnsBPMNDI:     "http://www.omg.org/spec/BPMN/20100524/DI",
+		XmlnsDC:         "http://www.omg.org/spec/DD/20100524/DC",
+		XmlnsDI:         "http://www.omg.org/spec/DD/20100524/DI",
+		XmlnsXSI:        "http://www.w3.org/2001/XMLSchema-instance",
+		ID:              "Definitions_1",
+		TargetNamespace: "https://hyperflow/workflows/" + meta.WorkflowID,
+		Exporter:        "HyperFlow",
+		Process:         process,
+		Diagram:         bpmnDiagramDI{ID: "BPMNDiagram_1", Plane: plane},
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// absolutePosition resolves positions of grouped nodes, which React Flow
+// stores relative to their parent
+func absolutePosition(g *flowgraph.Graph, n flowgraph.Node) flowgraph.Position {
+	pos := n.Position
+	seen := map[string]bool{n.ID: true}
+	for parent := n.ParentNode; parent != "" && !seen[parent]; {
+		seen[parent] = true
+		p := g.Node(parent)
+		if p == nil {
+			break
+		}
+		pos.X += p.Position.X
+		pos.Y += p.Position.Y
+		parent = p.ParentNode
+	}
+	return pos
+}
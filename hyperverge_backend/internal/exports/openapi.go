@@ -0,0 +1,533 @@
+// Package exports turns a workflow graph into documents customers can import
+// into their own tooling.
+package exports
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/slug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenAPI exports an API flow as an OpenAPI 3.1 document (GET /workflows/:id/export/openapi)
+func OpenAPI(c *gin.Context) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+
+	if meta.FlowType != "api" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "only api workflows can be exported as OpenAPI"})
+		return
+	}
+
+	c.Header("Content-Disposition", "inline; filename=\""+slug.Make(meta.WorkflowName, "workflow")+".openapi.json\"")
+	c.JSON(http.StatusOK, BuildOpenAPI(g, meta, coverageOf(g, meta)))
+}
+
+// BuildOpenAPI describes the API calls of a flow, in execution order. A
+// coverage report, when given, is added as the x-coverage extension.
+func BuildOpenAPI(g *flowgraph.Graph, meta *flowgraph.Meta, cov *coverage.Report) map[string]interface{} {
+	calls := g.APICalls()
+	schemes := securitySchemes(calls, meta)
+	steps := stepIndex(g)
+
+	paths := make(map[string]map[string]interface{})
+	servers := []string{}
+	seenServers := make(map[string]bool)
+
+	for i, call := range calls {
+		server := serverOf(call.URL)
+		if server != "" && !seenServers[server] {
+			seenServers[server] = true
+			servers = append(servers, server)
+		}
+
+		path := call.Path()
+		method := strings.ToLower(call.Method)
+		if paths[path] == nil {
+			paths[path] = make(map[string]interface{})
+		}
+
+		// The same endpoint can be called more than once in a flow
+		if existing, ok := paths[path][method].(map[string]interface{}); ok {
+			existing["description"] = existing["description"].(string) +
+				fmt.Sprintf("\n\nAlso called as step %d (%s).", i+1, call.Title)
+			existing["x-hyperflow-steps"] = append(existing["x-hyperflow-steps"].([]int), i+1)
+			continue
+		}
+
+		op := openAPIOperation(g, call, i+1, schemes)
+		if server != "" && len(seenServers) > 1 && server != servers[0] {
+			op["servers"] = []map[string]interface{}{{"url": server}}
+		}
+		paths[path][method] = op
+	}
+
+	serverList := []map[string]interface{}{}
+	if len(servers) > 0 {
+		serverList = append(serverList, map[string]interface{}{"url": servers[0]})
+	}
+
+	securitySchemes := make(map[string]interface{})
+	for name, s := range schemes {
+		securitySchemes[name] = s.definition()
+	}
+
+	version := "draft"
+	if meta.VersionNumber != "" {
+		version = meta.VersionNumber
+	}
+
+	info := map[string]interface{}{
+		"title":       meta.WorkflowName,
+		"version":     version,
+		"description": flowDescription(g, meta, calls, steps),
+	}
+
+	doc := map[string]interface{}{
+		"openapi": "3.1.0",
+		"info":    info,
+		"servers": serverList,
+		"paths":   paths,
+		"components": map[string]interface{}{
+			"securitySchemes": securitySchemes,
+		},
+		"x-hyperflow": map[string]interface{}{
+			"workflow_id":    meta.WorkflowID,
+			"version_id":     meta.VersionID,
+			"environment_id": meta.EnvironmentID,
+			"origin":         meta.Origin,
+			"flow_inputs":    nonNil(g.FlowInputs),
+			"flow_outputs":   nonNil(g.FlowOutputs),
+			"steps":          flowSteps(g, steps),
+		},
+	}
+	if cov != nil {
+		doc["x-coverage"] = coverageExtension(cov)
+	}
+	return doc
+}
+
+func openAPIOperation(g *flowgraph.Graph, call flowgraph.APICall, step int, schemes map[string]securityScheme) map[string]interface{} {
+	var desc strings.Builder
+	if call.Description != "" {
+		desc.WriteString(call.Description + "\n\n")
+	}
+	if call.DocURL != "" {
+		desc.WriteString("Documentation: " + call.DocURL + "\n\n")
+	}
+	branches := g.Branches(call.NodeID)
+	if len(branches) > 0 {
+		desc.WriteString("**Branching**\n\n")
+		for _, b := range branches {
+			desc.WriteString("- " + b.Describe(g) + "\n")
+		}
+	}
+
+	op := map[string]interface{}{
+		"operationId":          fmt.Sprintf("step%d_%s", step, identifier(call.Title)),
+		"summary":              fmt.Sprintf("Step %d: %s", step, call.Title),
+		"description":          strings.TrimSpace(desc.String()),
+		"x-hyperflow-steps":    []int{step},
+		"x-hyperflow-node":     call.NodeID,
+		"x-hyperflow-branches": branchList(g, branches),
+	}
+
+	// Headers that aren't credentials or content negotiation are parameters
+	var params []map[string]interface{}
+	var security []string
+	for _, h := range call.Headers {
+		if strings.EqualFold(h[0], "content-type") {
+			continue
+		}
+		if name := schemeFor(h[0], schemes); name != "" {
+			security = append(security, name)
+			continue
+		}
+		params = append(params, map[string]interface{}{
+			"name":     h[0],
+			"in":       "header",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+			"example":  h[1],
+		})
+	}
+	for _, f := range call.Inputs {
+		if name := schemeFor(f.Name, schemes); name != "" && !contains(security, name) {
+			security = append(security, name)
+		}
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+
+	if len(security) > 0 {
+		sort.Strings(security)
+		req := make(map[string][]string)
+		for _, name := range security {
+			req[name] = []string{}
+		}
+		op["security"] = []map[string][]string{req}
+	}
+
+	if body := requestBody(call); body != nil {
+		op["requestBody"] = body
+	}
+
+	responses := map[string]interface{}{}
+	success := map[string]interface{}{"description": "Success"}
+	successContent := map[string]interface{}{"schema": outputSchema(call.Outputs)}
+	if call.SuccessResponse != nil {
+		successContent["example"] = call.SuccessResponse
+	}
+	success["content"] = map[string]interface{}{"application/json": successContent}
+	responses["200"] = success
+
+	for _, e := range call.Errors {
+		code := e.StatusCode
+		if _, exists := responses[code]; exists || !isStatusCode(code) {
+			continue
+		}
+		resp := map[string]interface{}{"description": nonEmpty(e.Description, http.StatusText(atoi(code)))}
+		if e.Example != nil {
+			resp["content"] = map[string]interface{}{
+				"application/json": map[string]interface{}{"example": e.Example},
+			}
+		}
+		responses[code] = resp
+	}
+	op["responses"] = responses
+
+	return op
+}
+
+func requestBody(call flowgraph.APICall) map[string]interface{} {
+	if len(call.Curl.Form) > 0 {
+		props := map[string]interface{}{}
+		var required []string
+		for _, f := range call.Curl.Form {
+			schema := map[string]interface{}{"type": "string"}
+			if strings.HasPrefix(f[1], "@") {
+				schema["format"] = "binary"
+			}
+			props[f[0]] = schema
+			required = append(required, f[0])
+		}
+		sort.Strings(required)
+		return map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"multipart/form-data": map[string]interface{}{
+					"schema": map[string]interface{}{"type": "object", "properties": props, "required": required},
+				},
+			},
+		}
+	}
+
+	inputs := call.BodyInputs()
+	if len(inputs) == 0 && call.Body == nil {
+		return nil
+	}
+
+	content := map[string]interface{}{"schema": inputSchema(inputs)}
+	if call.Body != nil {
+		content["example"] = call.Body
+	}
+	return map[string]interface{}{
+		"required": true,
+		"content":  map[string]interface{}{"application/json": content},
+	}
+}
+
+func inputSchema(fields []flowgraph.Field) map[string]interface{} {
+	props := map[string]interface{}{}
+	var required []string
+	for _, f := range fields {
+		props[f.Name] = map[string]interface{}{"type": schemaType(f.Type)}
+		if f.Required {
+			required = append(required, f.Name)
+		}
+	}
+	schema := map[string]interface{}{"type": "object", "properties": props}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+	return schema
+}
+
+// outputSchema has one property per documented output. Outputs are not
+// marked required: the API leaves them out on some paths.
+func outputSchema(fields []flowgraph.Field) map[string]interface{} {
+	props := map[string]interface{}{}
+	for _, f := range fields {
+		props[f.Name] = map[string]interface{}{"type": schemaType(f.Type)}
+	}
+	return map[string]interface{}{"type": "object", "properties": props}
+}
+
+// securityScheme is a header credential taken from the environment
+type securityScheme struct {
+	Header   string
+	Variable string // environment variable holding the value, if any
+}
+
+func (s securityScheme) definition() map[string]interface{} {
+	desc := "HyperVerge credential sent in the " + s.Header + " header."
+	if s.Variable != "" {
+		desc += " Use the value of the environment variable `" + s.Variable + "`."
+	}
+	return map[string]interface{}{
+		"type":        "apiKey",
+		"in":          "header",
+		"name":        s.Header,
+		"description": desc,
+	}
+}
+
+// securitySchemes collects appId/appKey plus any header whose name matches an
+// environment variable
+func securitySchemes(calls []flowgraph.APICall, meta *flowgraph.Meta) map[string]securityScheme {
+	vars := make(map[string]string)
+	for k := range meta.Variables {
+		vars[strings.ToLower(k)] = k
+	}
+
+	schemes := make(map[string]securityScheme)
+	add := func(header string) {
+		lower := strings.ToLower(header)
+		variable, isVar := vars[lower]
+		if !flowgraph.IsCredential(header) && !isVar {
+			return
+		}
+		if _, ok := schemes[identifier(header)]; !ok {
+			schemes[identifier(header)] = securityScheme{Header: header, Variable: variable}
+		}
+	}
+
+	for _, call := range calls {
+		for _, h := range call.Headers {
+			add(h[0])
+		}
+		for _, f := range call.Inputs {
+			if flowgraph.IsCredential(f.Name) {
+				add(f.Name)
+			}
+		}
+	}
+	return schemes
+}
+
+func schemeFor(header string, schemes map[string]securityScheme) string {
+	name := identifier(header)
+	if _, ok := schemes[name]; ok {
+		return name
+	}
+	return ""
+}
+
+// stepIndex numbers the API calls in execution order
+func stepIndex(g *flowgraph.Graph) map[string]int {
+	index := make(map[string]int)
+	for i, call := range g.APICalls() {
+		index[call.NodeID] = i + 1
+	}
+	return index
+}
+
+type stepBranch struct {
+	Kind       string `json:"kind"`
+	Condition  string `json:"condition,omitempty"`
+	Target     string `json:"target"`
+	TargetType string `json:"target_type"`
+	TargetStep int    `json:"target_step,omitempty"`
+	EndStatus  string `json:"end_status,omitempty"`
+}
+
+func branchList(g *flowgraph.Graph, branches []flowgraph.Branch) []stepBranch {
+	out := []stepBranch{}
+	for _, b := range branches {
+		sb := stepBranch{Kind: b.Kind, Condition: b.Condition, Target: b.Target}
+		if t := g.Node(b.Target); t != nil {
+			sb.TargetType = t.Type
+			if t.Type == flowgraph.NodeEndStatus {
+				sb.EndStatus = t.Str("status")
+			}
+		}
+		out = append(out, sb)
+	}
+	return out
+}
+
+// flowSteps lists every node of the flow with where it leads
+func flowSteps(g *flowgraph.Graph, steps map[string]int) []map[string]interface{} {
+	out := []map[string]interface{}{}
+	for _, n := range g.Ordered() {
+		if n.Type == flowgraph.NodeAPIGroup || n.Type == flowgraph.NodeNote {
+			continue
+		}
+		branches := branchList(g, g.Branches(n.ID))
+		for i := range branches {
+			branches[i].TargetStep = steps[branches[i].Target]
+		}
+		entry := map[string]interface{}{
+			"node_id":  n.ID,
+			"type":     n.Type,
+			"label":    n.Label(),
+			"branches": branches,
+		}
+		if step, ok := steps[n.ID]; ok {
+			entry["step"] = step
+		}
+		if n.Type == flowgraph.NodeEndStatus {
+			entry["status"] = n.Str("status")
+			if r := n.Str("reason"); r != "" {
+				entry["reason"] = r
+			}
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+func flowDescription(g *flowgraph.Graph, meta *flowgraph.Meta, calls []flowgraph.APICall, steps map[string]int) string {
+	var b strings.Builder
+	if meta.Description != "" {
+		b.WriteString(meta.Description + "\n\n")
+	}
+
+	switch {
+	case meta.EnvironmentName != "":
+		b.WriteString(fmt.Sprintf("Exported for environment **%s** (%s).\n\n", meta.EnvironmentName, meta.Origin))
+	case meta.VersionNumber != "":
+		b.WriteString(fmt.Sprintf("Exported from published version **%s**.\n\n", meta.VersionNumber))
+	default:
+		b.WriteString("Exported from the current draft.\n\n")
+	}
+
+	if len(g.FlowInputs) > 0 {
+		b.WriteString("**Flow inputs:** " + strings.Join(g.FlowInputs, ", ") + "\n\n")
+	}
+	if len(g.FlowOutputs) > 0 {
+		b.WriteString("**Flow outputs:** " + strings.Join(g.FlowOutputs, ", ") + "\n\n")
+	}
+
+	b.WriteString("**Call sequence**\n\n")
+	for i, call := range calls {
+		b.WriteString(fmt.Sprintf("%d. %s `%s %s`\n", i+1, call.Title, call.Method, call.Path()))
+		for _, br := range g.Branches(call.NodeID) {
+			b.WriteString("   - " + br.Describe(g) + "\n")
+		}
+	}
+
+	// Condition nodes sit between calls
+	var conditions []string
+	for _, n := range g.Ordered() {
+		if n.Type != flowgraph.NodeCondition {
+			continue
+		}
+		for _, br := range g.Branches(n.ID) {
+			conditions = append(conditions, "- "+br.Describe(g))
+		}
+	}
+	if len(conditions) > 0 {
+		b.WriteString("\n**Decisions**\n\n" + strings.Join(conditions, "\n") + "\n")
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func serverOf(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+var nonIdent = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+func identifier(s string) string {
+	parts := nonIdent.Split(strings.TrimSpace(s), -1)
+	var b strings.Builder
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		if i == 0 || b.Len() == 0 {
+			b.WriteString(strings.ToLower(p[:1]) + p[1:])
+		} else {
+			b.WriteString(strings.ToUpper(p[:1]) + p[1:])
+		}
+	}
+	if b.Len() == 0 {
+		return "step"
+	}
+	return b.String()
+}
+
+func schemaType(t string) string {
+	switch strings.ToLower(t) {
+	case "number", "float", "double":
+		return "number"
+	case "integer", "int":
+		return "integer"
+	case "boolean", "bool":
+		return "boolean"
+	case "object":
+		return "object"
+	case "array":
+		return "array"
+	}
+	return "string"
+}
+
+func isStatusCode(s string) bool {
+	n := atoi(s)
+	return n >= 100 && n <= 599
+}
+
+func atoi(s string) int {
+	n := 0
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0
+		}
+		n = n*10 + int(r-'0')
+	}
+	return n
+}
+
+func nonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
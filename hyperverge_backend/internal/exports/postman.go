@@ -0,0 +1,552 @@
+package exports
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/buaccesslinks"
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/slug"
+	"hypervision_backend/internal/variables"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const postmanSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// Collection variable the runner compares the final status against
+const expectedStatusVar = "expected_status"
+
+type PostmanCollection struct {
+	Info     PostmanInfo       `json:"info"`
+	Item     []PostmanItem     `json:"item"`
+	Variable []PostmanVariable `json:"variable"`
+}
+
+type PostmanInfo struct {
+	PostmanID   string `json:"_postman_id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Schema      string `json:"schema"`
+}
+
+type PostmanVariable struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+type PostmanItem struct {
+	Name     string            `json:"name"`
+	Request  PostmanRequest    `json:"request"`
+	Response []PostmanResponse `json:"response"`
+	Event    []PostmanEvent    `json:"event,omitempty"`
+}
+
+type PostmanRequest struct {
+	Method      string          `json:"method"`
+	Header      []PostmanHeader `json:"header"`
+	Body        *PostmanBody    `json:"body,omitempty"`
+	URL         PostmanURL      `json:"url"`
+	Description string          `json:"description,omitempty"`
+}
+
+type PostmanHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Type  string `json:"type"`
+}
+
+type PostmanBody struct {
+	Mode     string                 `json:"mode"`
+	Raw      string                 `json:"raw,omitempty"`
+	FormData []PostmanFormParam     `json:"formdata,omitempty"`
+	Options  map[string]interface{} `json:"options,omitempty"`
+}
+
+type PostmanFormParam struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+	Src   string `json:"src,omitempty"`
+	Type  string `json:"type"`
+}
+
+type PostmanURL struct {
+	Raw      string   `json:"raw"`
+	Protocol string   `json:"protocol,omitempty"`
+	Host     []string `json:"host,omitempty"`
+	Path     []string `json:"path,omitempty"`
+}
+
+type PostmanResponse struct {
+	Name            string          `json:"name"`
+	OriginalRequest PostmanRequest  `json:"originalRequest"`
+	Status          string          `json:"status"`
+	Code            int             `json:"code"`
+	Header          []PostmanHeader `json:"header"`
+	Body            string          `json:"body"`
+}
+
+type PostmanEvent struct {
+	Listen string        `json:"listen"`
+	Script PostmanScript `json:"script"`
+}
+
+type PostmanScript struct {
+	Type string   `json:"type"`
+	Exec []string `json:"exec"`
+}
+
+// Postman exports the API calls of a workflow as a Postman v2.1 collection
+// (GET /workflows/:id/export/postman). Credentials and environment headers
+// are blank unless ?include_secrets=true is passed by an editor of the BU.
+func Postman(c *gin.Context) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	includeSecrets := c.Query("include_secrets") == "true" &&
+		auth.CanAccessBU(meta.BusinessUnitID, c.GetString("userId"), true)
+	writePostman(c, g, meta, includeSecrets)
+}
+
+// PublicPostman is Postman for BU access links. Secret variables are left
+// blank. (GET /public/bu-links/:linkId/workflows/:id/export/postman)
+func PublicPostman(c *gin.Context) {
+	g, meta, ok := publicGraph(c)
+	if !ok {
+		return
+	}
+	writePostman(c, g, meta, false)
+}
+
+func writePostman(c *gin.Context, g *flowgraph.Graph, meta *flowgraph.Meta, includeSecrets bool) {
+	if len(g.APICalls()) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "workflow has no API calls to export"})
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\""+slug.Make(meta.WorkflowName, "workflow")+".postman_collection.json\"")
+	c.JSON(http.StatusOK, BuildPostman(g, meta, includeSecrets, coverageOf(g, meta)))
+}
+
+// publicGraph loads the graph requested through a BU access link
+func publicGraph(c *gin.Context) (*flowgraph.Graph, *flowgraph.Meta, bool) {
+	buId, ok := buaccesslinks.AuthorizedBusinessUnit(c)
+	if !ok {
+		return nil, nil, false
+	}
+	return flowgraph.FromPublicRequest(c, buId)
+}
+
+// BuildPostman builds one request per API call in flow order. Test scripts
+// evaluate the branch conditions against the response and point the
+// collection runner at the next request, so running the collection walks the
+// flow until it reaches an end status. A coverage report, when given, is
+// summed up in the collection's description.
+func BuildPostman(g *flowgraph.Graph, meta *flowgraph.Meta, includeSecrets bool, cov *coverage.Report) PostmanCollection {
+	calls := g.APICalls()
+	vars := newPostmanVars(meta, includeSecrets)
+
+	names := make(map[string]string, len(calls))
+	for i, call := range calls {
+		names[call.NodeID] = fmt.Sprintf("%d. %s", i+1, call.Title)
+	}
+
+	items := make([]PostmanItem, 0, len(calls))
+	for i, call := range calls {
+		req := postmanRequest(call, i+1, vars)
+		item := PostmanItem{
+			Name:     names[call.NodeID],
+			Request:  req,
+			Response: postmanExamples(call, req),
+			Event: []PostmanEvent{{
+				Listen: "test",
+				Script: PostmanScript{Type: "text/javascript", Exec: branchScript(g, call, names)},
+			}},
+		}
+		items = append(items, item)
+	}
+
+	version := "draft"
+	if meta.VersionNumber != "" {
+		version = "version " + meta.VersionNumber
+	}
+	desc := fmt.Sprintf("Generated by HyperFlow from %s of %q.", version, meta.WorkflowName)
+	if meta.EnvironmentName != "" {
+		desc += fmt.Sprintf(" Variables come from the %q environment.", meta.EnvironmentName)
+	}
+	desc += fmt.Sprintf(" Run the collection to follow the flow; set %q to assert the final status.", expectedStatusVar)
+	if cov != nil {
+		desc += "\n\n**Decision coverage**\n\n- " + strings.Join(coverageLines(cov), "\n- ")
+	}
+
+	vars.add(expectedStatusVar, "", "End status the flow is expected to reach (auto-approved, auto-declined, needs-review)")
+
+	return PostmanCollection{
+		Info: PostmanInfo{
+			PostmanID:   uuid.NewSHA1(uuid.NameSpaceURL, []byte("hyperflow:"+meta.WorkflowID+":"+meta.VersionID+":"+meta.EnvironmentID)).String(),
+			Name:        meta.WorkflowName,
+			Description: desc,
+			Schema:      postmanSchema,
+		},
+		Item:     items,
+		Variable: vars.list(),
+	}
+}
+
+// postmanVars collects the collection variables. Environment variables come
+// first; inputs that no variable covers get an empty placeholder.
+type postmanVars struct {
+	byLower map[string]string // lower-case key -> key as defined
+	values  map[string]PostmanVariable
+	order   []string
+}
+
+func newPostmanVars(meta *flowgraph.Meta, includeSecrets bool) *postmanVars {
+	v := &postmanVars{byLower: map[string]string{}, values: map[string]PostmanVariable{}}
+
+	for _, k := range sortedKeys(meta.Variables) {
+		v.set(k, variableValue(k, meta.Variables[k], includeSecrets), "From environment "+meta.EnvironmentName)
+	}
+
+	// Credentials configured as environment headers. Headers carry api keys
+	// under any name, so their values are never exported without secrets.
+	headers := objectOf(meta.Environment["headers"])
+	for _, k := range sortedKeys(headers) {
+		if _, exists := v.lookup(k); !exists {
+			value := ""
+			if includeSecrets {
+				value = variableValue(k, headers[k], true)
+			}
+			v.set(k, value, "Header of environment "+meta.EnvironmentName)
+		}
+	}
+	return v
+}
+
+func variableValue(key string, value interface{}, includeSecrets bool) string {
+	if !includeSecrets && isSecretName(key) {
+		return ""
+	}
+	switch val := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	}
+	out, _ := json.Marshal(value)
+	return string(out)
+}
+
+// isSecretName also matches names spelled with dashes or without
+// separators, e.g. api-key and apikey
+func isSecretName(key string) bool {
+	if variables.IsSecret(key) || flowgraph.IsCredential(key) {
+		return true
+	}
+	normalized := flowgraph.FieldKey(key)
+	for _, word := range []string{"secret", "password", "token", "apikey", "appkey", "credential"} {
+		if strings.Contains(normalized, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *postmanVars) lookup(key string) (string, bool) {
+	k, ok := v.byLower[strings.ToLower(key)]
+	return k, ok
+}
+
+func (v *postmanVars) set(key, value, desc string) {
+	if _, ok := v.values[key]; !ok {
+		v.order = append(v.order, key)
+	}
+	v.byLower[strings.ToLower(key)] = key
+	v.values[key] = PostmanVariable{Key: key, Value: value, Type: "string", Description: desc}
+}
+
+// add defines a variable unless one with the same name exists, and returns
+// the reference to use in the request
+func (v *postmanVars) add(key, value, desc string) string {
+	if existing, ok := v.lookup(key); ok {
+		return "{{" + existing + "}}"
+	}
+	v.set(key, value, desc)
+	return "{{" + key + "}}"
+}
+
+func (v *postmanVars) list() []PostmanVariable {
+	out := make([]PostmanVariable, 0, len(v.order))
+	for _, k := range v.order {
+		out = append(out, v.values[k])
+	}
+	return out
+}
+
+func postmanRequest(call flowgraph.APICall, step int, vars *postmanVars) PostmanRequest {
+	req := PostmanRequest{
+		Method:      call.Method,
+		Header:      []PostmanHeader{},
+		URL:         postmanURL(call.URL),
+		Description: call.Description,
+	}
+	if call.DocURL != "" {
+		req.Description = strings.TrimSpace(req.Description + "\n\nDocumentation: " + call.DocURL)
+	}
+
+	for _, h := range call.Headers {
+		value := h[1]
+		if flowgraph.IsCredential(h[0]) || isPlaceholder(value) {
+			value = vars.add(h[0], "", fmt.Sprintf("Header of step %d", step))
+		}
+		req.Header = append(req.Header, PostmanHeader{Key: h[0], Value: value, Type: "text"})
+	}
+
+	switch {
+	case len(call.Curl.Form) > 0:
+		body := &PostmanBody{Mode: "formdata"}
+		for _, f := range call.Curl.Form {
+			if strings.HasPrefix(f[1], "@") {
+				body.FormData = append(body.FormData, PostmanFormParam{Key: f[0], Src: strings.TrimPrefix(f[1], "@"), Type: "file"})
+				continue
+			}
+			value := f[1]
+			if value == "" || isPlaceholder(value) {
+				value = vars.add(f[0], "", fmt.Sprintf("Input of step %d", step))
+			}
+			body.FormData = append(body.FormData, PostmanFormParam{Key: f[0], Value: value, Type: "text"})
+		}
+		req.Body = body
+
+	case call.Body != nil:
+		raw := make(map[string]interface{}, len(call.Body))
+		for k, val := range call.Body {
+			if s, ok := val.(string); ok && isPlaceholder(s) {
+				val = vars.add(k, "", fmt.Sprintf("Input of step %d", step))
+			}
+			raw[k] = val
+		}
+		out, _ := json.MarshalIndent(raw, "", "    ")
+		req.Body = &PostmanBody{
+			Mode:    "raw",
+			Raw:     string(out),
+			Options: map[string]interface{}{"raw": map[string]interface{}{"language": "json"}},
+		}
+
+	case call.Curl.Body != "":
+		req.Body = &PostmanBody{Mode: "raw", Raw: call.Curl.Body}
+	}
+
+	return req
+}
+
+func postmanURL(raw string) PostmanURL {
+	out := PostmanURL{Raw: raw}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return out
+	}
+	out.Protocol = u.Scheme
+	out.Host = strings.Split(u.Host, ".")
+	for _, p := range strings.Split(strings.Trim(u.Path, "/"), "/") {
+		if p != "" {
+			out.Path = append(out.Path, p)
+		}
+	}
+	return out
+}
+
+// postmanExamples turns the success response and documented errors into
+// saved examples
+func postmanExamples(call flowgraph.APICall, req PostmanRequest) []PostmanResponse {
+	jsonHeader := []PostmanHeader{{Key: "Content-Type", Value: "application/json", Type: "text"}}
+	out := []PostmanResponse{}
+
+	if call.SuccessResponse != nil {
+		out = append(out, PostmanResponse{
+			Name:            "Success",
+			OriginalRequest: req,
+			Status:          "OK",
+			Code:            http.StatusOK,
+			Header:          jsonHeader,
+			Body:            exampleBody(call.SuccessResponse),
+		})
+	}
+
+	for _, e := range call.Errors {
+		code, err := strconv.Atoi(e.StatusCode)
+		if err != nil || code < 100 || code > 599 {
+			continue
+		}
+		out = append(out, PostmanResponse{
+			Name:            nonEmpty(e.Description, fmt.Sprintf("Error %d", code)),
+			OriginalRequest: req,
+			Status:          http.StatusText(code),
+			Code:            code,
+			Header:          jsonHeader,
+			Body:            exampleBody(e.Example),
+		})
+	}
+	return out
+}
+
+func exampleBody(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	out, _ := json.MarshalIndent(v, "", "    ")
+	return string(out)
+}
+
+// branchScript is the test script of a request. It evaluates the branches of
+// the API node (following condition nodes) and sets the next request.
+func branchScript(g *flowgraph.Graph, call flowgraph.APICall, names map[string]string) []string {
+	lines := []string{
+		"// Generated by HyperFlow: follows the branches of this step",
+		"const response = (function () { try { return pm.response.json(); } catch (e) { return {}; } })();",
+		"function holds(expr) {",
+		"    try { return Boolean(new Function('response', 'with (response) { return (' + expr + '); }')(response)); }",
+		"    catch (e) { return false; }",
+		"}",
+		"function next(name, branch) {",
+		"    pm.test('Branch: ' + branch, function () {});",
+		"    postman.setNextRequest(name);",
+		"}",
+		"function end(status, branch) {",
+		"    pm.test('Branch: ' + branch, function () {});",
+		"    pm.test('Flow ends with status ' + status, function () {",
+		"        const expected = pm.collectionVariables.get('" + expectedStatusVar + "');",
+		"        if (expected) { pm.expect(status).to.eql(expected); }",
+		"    });",
+		"    postman.setNextRequest(null);",
+		"}",
+		"const succeeded = pm.response.code >= 200 && pm.response.code < 300;",
+		"",
+	}
+
+	var success, failure []flowgraph.Branch
+	for _, b := range g.Branches(call.NodeID) {
+		if b.Kind == flowgraph.BranchFailure {
+			failure = append(failure, b)
+		} else {
+			success = append(success, b)
+		}
+	}
+
+	cond := "succeeded"
+	if call.Condition != "" {
+		cond = "succeeded && holds(" + jsString(call.Condition) + ")"
+	}
+
+	lines = append(lines, "if ("+cond+") {")
+	lines = append(lines, branchTargets(g, success, names, 1, map[string]bool{call.NodeID: true},
+		"pm.test('Step has a success branch', function () { pm.expect.fail('no success branch in the flow'); });")...)
+	lines = append(lines, "} else {")
+	lines = append(lines, branchTargets(g, failure, names, 1, map[string]bool{call.NodeID: true},
+		"pm.test('Step has a failure branch', function () { pm.expect.fail('the flow does not handle this response'); });")...)
+	lines = append(lines, "}")
+	return lines
+}
+
+// branchTargets writes the statements that follow the first of the branches
+func branchTargets(g *flowgraph.Graph, branches []flowgraph.Branch, names map[string]string, depth int, seen map[string]bool, missing string) []string {
+	indent := strings.Repeat("    ", depth)
+	if len(branches) == 0 {
+		return []string{indent + missing, indent + "postman.setNextRequest(null);"}
+	}
+	b := branches[0]
+	label := jsString(b.Describe(g))
+
+	target := g.Node(b.Target)
+	if target == nil || seen[b.Target] {
+		return []string{indent + "postman.setNextRequest(null);"}
+	}
+
+	switch target.Type {
+	case flowgraph.NodeAPIModule:
+		return []string{indent + "next(" + jsString(names[target.ID]) + ", " + label + ");"}
+
+	case flowgraph.NodeEndStatus:
+		return []string{indent + "end(" + jsString(target.Str("status")) + ", " + label + ");"}
+
+	case flowgraph.NodeCondition:
+		seen = copySeen(seen, target.ID)
+		var whenTrue, whenFalse []flowgraph.Branch
+		for _, cb := range g.Branches(target.ID) {
+			if cb.Kind == flowgraph.BranchFalse {
+				whenFalse = append(whenFalse, cb)
+			} else {
+				whenTrue = append(whenTrue, cb)
+			}
+		}
+		cond := strings.TrimSpace(target.Str("condition"))
+		if cond == "" {
+			cond = "true"
+		}
+		lines := []string{indent + "if (holds(" + jsString(cond) + ")) {"}
+		lines = append(lines, branchTargets(g, whenTrue, names, depth+1, seen, "// no true branch")...)
+		lines = append(lines, indent+"} else {")
+		lines = append(lines, branchTargets(g, whenFalse, names, depth+1, seen, "// no false branch")...)
+		lines = append(lines, indent+"}")
+		return lines
+	}
+
+	// SDK modules and other nodes pass straight through
+	return branchTargets(g, g.Branches(target.ID), names, depth, copySeen(seen, target.ID), "// end of flow")
+}
+
+func copySeen(seen map[string]bool, id string) map[string]bool {
+	out := make(map[string]bool, len(seen)+1)
+	for k, v := range seen {
+		out[k] = v
+	}
+	out[id] = true
+	return out
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func jsString(s string) string {
+	out, _ := json.Marshal(s)
+	return string(out)
+}
+
+func isPlaceholder(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">")
+}
+
+// objectOf reads a jsonb column that may be stored as a JSON string
+func objectOf(v interface{}) map[string]interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return val
+	case string:
+		var out map[string]interface{}
+		if json.Unmarshal([]byte(val), &out) == nil {
+			return out
+		}
+	}
+	return nil
+}
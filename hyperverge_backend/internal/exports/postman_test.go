@@ -0,0 +1,78 @@
+package exports
+
+import (
+	"testing"
+
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Collection variables must not carry credentials or environment headers
+// unless secrets were asked for.
+func TestPostmanVarsRedaction(t *testing.T) {
+	meta := &flowgraph.Meta{
+		EnvironmentName: "prod",
+		Variables: map[string]interface{}{
+			"baseUrl":       "https://ind.idv.hyperverge.co",
+			"appKey":        "variable-app-key",
+			"client-secret": "variable-secret",
+		},
+		Environment: map[string]interface{}{
+			"headers": map[string]interface{}{
+				"api-key":  "header-api-key",
+				"x-tenant": "header-tenant",
+				"appId":    "header-app-id",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		key            string
+		includeSecrets bool
+		want           string
+	}{
+		{"plain variable", "baseUrl", false, "https://ind.idv.hyperverge.co"},
+		{"credential variable", "appKey", false, ""},
+		{"dashed secret variable", "client-secret", false, ""},
+		{"api-key header", "api-key", false, ""},
+		{"other header", "x-tenant", false, ""},
+		{"credential header", "appId", false, ""},
+		{"credential variable with secrets", "appKey", true, "variable-app-key"},
+		{"api-key header with secrets", "api-key", true, "header-api-key"},
+		{"other header with secrets", "x-tenant", true, "header-tenant"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newPostmanVars(meta, tt.includeSecrets)
+			got, ok := v.values[tt.key]
+			if !ok {
+				t.Fatalf("variable %q is missing", tt.key)
+			}
+			if got.Value != tt.want {
+				t.Errorf("value of %q = %q, want %q", tt.key, got.Value, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSecretName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"api-key", true},
+		{"api_key", true},
+		{"apikey", true},
+		{"X-API-KEY", true},
+		{"appKey", true},
+		{"appid", true},
+		{"auth-token", true},
+		{"baseUrl", false},
+		{"countryId", false},
+	}
+	for _, tt := range tests {
+		if got := isSecretName(tt.name); got != tt.want {
+			t.Errorf("isSecretName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+package flowgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Field is an input or output of an API call
+type Field struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+// ErrorResponse is one documented error of an API call
+type ErrorResponse struct {
+	StatusCode  string      `json:"status_code"`
+	Description string      `json:"description,omitempty"`
+	Example     interface{} `json:"example,omitempty"`
+}
+
+// APICall is the typed view of an apiModuleNode
+type APICall struct {
+	NodeID          string
+	Title           string
+	Description     string
+	Method          string
+	URL             string // the real endpoint, taken from the curl example when present
+	DocURL          string
+	Curl            Curl
+	Headers         [][2]string
+	Body            map[string]interface{}
+	Inputs          []Field
+	Outputs         []Field
+	SuccessResponse interface{}
+	Errors          []ErrorResponse
+	Condition       string
+	CspURLs         []string
+}
+
+// credentialHeaders are sent as headers and come from the environment
+var credentialHeaders = map[string]bool{"appid": true, "appkey": true}
+
+// IsCredential reports whether an input or header is an HyperVerge credential
+func IsCredential(name string) bool {
+	return credentialHeaders[strings.ToLower(name)]
+}
+
+// FieldKey matches field names across steps: "transaction_id",
+// "transactionId" and "TransactionID" are the same field
+func FieldKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// APICall returns the API call described by an apiModuleNode
+func (n Node) APICall() APICall {
+	call := APICall{
+		NodeID:      n.ID,
+		Title:       n.Label(),
+		Description: n.Str("description"),
+		DocURL:      n.Str("docUrl"),
+		Condition:   strings.TrimSpace(n.Str("condition")),
+		CspURLs:     n.StringList("cspUrls"),
+	}
+
+	call.Curl = ParseCurl(n.Str("curlExample"))
+	call.Headers = call.Curl.Headers
+	call.Body, _ = call.Curl.JSONBody()
+
+	call.Method = strings.ToUpper(n.Str("method"))
+	if call.Method == "" {
+		call.Method = call.Curl.Method
+	}
+
+	endpoint := n.Str("endpoint")
+	call.URL = endpoint
+	if call.Curl.URL != "" {
+		call.URL = call.Curl.URL
+	}
+	if call.DocURL == "" && isDocumentationURL(endpoint) {
+		call.DocURL = endpoint
+	}
+
+	call.Inputs = fields(n.Data["inputs"])
+	call.Outputs = fields(n.Data["outputs"])
+	call.SuccessResponse = decodeResponse(n.Data["successResponse"])
+	call.Errors = errorResponses(n.Data["errorDetails"], n.Data["failureResponses"])
+
+	return call
+}
+
+// APICalls returns the API calls of the flow in execution order
+func (g *Graph) APICalls() []APICall {
+	var calls []APICall
+	for _, n := range g.Ordered() {
+		if n.Type == NodeAPIModule {
+			calls = append(calls, n.APICall())
+		}
+	}
+	// API nodes that aren't wired up yet still belong to the flow
+	seen := make(map[string]bool)
+	for _, c := range calls {
+		seen[c.NodeID] = true
+	}
+	for _, n := range sortByPosition(append([]Node(nil), g.Nodes...)) {
+		if n.Type == NodeAPIModule && !seen[n.ID] {
+			calls = append(calls, n.APICall())
+		}
+	}
+	return calls
+}
+
+// Host returns the host of the call URL
+func (c APICall) Host() string {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// Path returns the path of the call URL
+func (c APICall) Path() string {
+	u, err := url.Parse(c.URL)
+	if err != nil || u.Path == "" {
+		return "/"
+	}
+	return u.Path
+}
+
+// Literals are the values the curl example fixes, by FieldKey: form fields,
+// top-level body keys and query parameters that aren't placeholders, e.g.
+// countryId from --form countryId=ind. Files are always supplied by the
+// caller, so they are left out.
+func (c APICall) Literals() map[string]interface{} {
+	out := make(map[string]interface{})
+	for _, f := range c.Curl.Form {
+		if !strings.HasPrefix(f[1], "@") && !IsPlaceholder(f[1]) {
+			out[FieldKey(f[0])] = f[1]
+		}
+	}
+	for k, v := range c.Body {
+		if s, ok := v.(string); ok && IsPlaceholder(s) {
+			continue
+		}
+		if v != nil {
+			out[FieldKey(k)] = v
+		}
+	}
+	if u, err := url.Parse(c.URL); err == nil {
+		for k, values := range u.Query() {
+			if len(values) > 0 && !IsPlaceholder(values[0]) {
+				out[FieldKey(k)] = values[0]
+			}
+		}
+	}
+	return out
+}
+
+// IsPlaceholder reports whether a documented value stands in for one the
+// caller supplies: empty, <Enter_value>, {{variable}}, {variable} or $VARIABLE
+func IsPlaceholder(s string) bool {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "":
+		return true
+	case strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">"):
+		return true
+	case strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}"):
+		return true
+	case strings.HasPrefix(s, "$"):
+		return true
+	}
+	return false
+}
+
+// BodyInputs are the inputs sent in the body, i.e. all inputs except headers
+// and response-side fields that the catalog sometimes lists as inputs.
+func (c APICall) BodyInputs() []Field {
+	headers := make(map[string]bool)
+	for _, h := range c.Headers {
+		headers[strings.ToLower(h[0])] = true
+	}
+	bodyKeys := make(map[string]bool)
+	for k := range c.Body {
+		bodyKeys[k] = true
+	}
+
+	var out []Field
+	for _, f := range c.Inputs {
+		lower := strings.ToLower(f.Name)
+		if headers[lower] || IsCredential(f.Name) || lower == "content-type" || lower == "transactionid" {
+			continue
+		}
+		// When the example body is known, it is the source of truth
+		if len(bodyKeys) > 0 && !bodyKeys[f.Name] {
+			continue
+		}
+		out = append(out, f)
+	}
+	// Keys of the example body the catalog didn't list as inputs
+	listed := make(map[string]bool)
+	for _, f := range out {
+		listed[f.Name] = true
+	}
+	for _, k := range sortedKeys(c.Body) {
+		if !listed[k] {
+			out = append(out, Field{Name: k, Type: jsonType(c.Body[k])})
+		}
+	}
+	return out
+}
+
+var docHosts = regexp.MustCompile(`(?i)documentation\.|docs\.`)
+
+func isDocumentationURL(u string) bool {
+	return docHosts.MatchString(u)
+}
+
+func fields(v interface{}) []Field {
+	var out []Field
+	list, _ := v.([]interface{})
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := m["name"].(string)
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		typ, _ := m["type"].(string)
+		req, _ := m["required"].(bool)
+		out = append(out, Field{Name: name, Type: typ, Required: req})
+	}
+	return out
+}
+
+// decodeResponse unwraps {"raw": "<json>"} as stored by the importer
+func decodeResponse(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if raw, ok := val["raw"].(string); ok && len(val) == 1 {
+			return decodeResponse(raw)
+		}
+		return val
+	case string:
+		var out interface{}
+		if json.Unmarshal([]byte(val), &out) == nil {
+			return out
+		}
+		if strings.TrimSpace(val) == "" {
+			return nil
+		}
+		return val
+	}
+	return v
+}
+
+func errorResponses(details, failures interface{}) []ErrorResponse {
+	var out []ErrorResponse
+	seen := make(map[string]bool)
+
+	for _, src := range []interface{}{details, failures} {
+		list, _ := src.([]interface{})
+		for _, item := range list {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			code := fmt.Sprint(firstOf(m, "status_code", "statusCode", "code"))
+			if code == "<nil>" || code == "" {
+				continue
+			}
+			e := ErrorResponse{StatusCode: code}
+			e.Description, _ = firstOf(m, "description", "message").(string)
+			e.Example = decodeResponse(firstOf(m, "example_response", "response", "example"))
+			if s, ok := e.Example.(string); ok && s == "" {
+				e.Example = nil
+			}
+			key := code + "|" + e.Description
+			if !seen[key] {
+				seen[key] = true
+				out = append(out, e)
+			}
+		}
+	}
+	return out
+}
+
+func firstOf(m map[string]interface{}, keys ...string) interface{} {
+	for _, k := range keys {
+		if v, ok := m[k]; ok && v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	return "string"
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,126 @@
+package flowgraph
+
+import "strings"
+
+// Branch kinds
+const (
+	BranchNext    = "next"    // unconditional
+	BranchSuccess = "success" // API call succeeded (and its condition held)
+	BranchFailure = "failure" // API call failed or its condition did not hold
+	BranchTrue    = "true"    // condition node evaluated to true
+	BranchFalse   = "false"   // condition node evaluated to false
+)
+
+// Branch is one outgoing edge of a node with its meaning spelled out
+type Branch struct {
+	Edge      Edge   `json:"-"`
+	EdgeID    string `json:"edge_id"`
+	Kind      string `json:"kind"`
+	Condition string `json:"condition,omitempty"` // expression that must hold to take this branch
+	Target    string `json:"target"`
+}
+
+// Branches classifies the outgoing edges of a node
+func (g *Graph) Branches(id string) []Branch {
+	n := g.Node(id)
+	if n == nil {
+		return nil
+	}
+
+	var out []Branch
+	for _, e := range g.Outgoing(id) {
+		b := Branch{Edge: e, EdgeID: e.ID, Kind: BranchNext, Target: e.Target}
+		handle := strings.ToLower(e.SourceHandle)
+		label := strings.ToLower(strings.TrimSpace(e.LabelText()))
+
+		switch n.Type {
+		case NodeAPIModule:
+			cond := strings.TrimSpace(n.Str("condition"))
+			switch {
+			case strings.HasPrefix(handle, "failure") || label == "false":
+				b.Kind = BranchFailure
+				if cond != "" {
+					b.Condition = "!(" + cond + ")"
+				}
+			default:
+				b.Kind = BranchSuccess
+				b.Condition = cond
+			}
+		case NodeCondition:
+			cond := strings.TrimSpace(n.Str("condition"))
+			if handle == "false" || label == "false" || label == "no" {
+				b.Kind = BranchFalse
+				if cond != "" {
+					b.Condition = "!(" + cond + ")"
+				}
+			} else {
+				b.Kind = BranchTrue
+				b.Condition = cond
+			}
+		default:
+			switch {
+			case strings.HasPrefix(handle, "failure"):
+				b.Kind = BranchFailure
+			case strings.HasPrefix(handle, "success"):
+				b.Kind = BranchSuccess
+			}
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// Label is the short label drawn on a branch in diagrams; the full condition
+// of an API call is only shown on its success edge
+func (b Branch) Label() string {
+	switch b.Kind {
+	case BranchSuccess:
+		if b.Condition != "" {
+			return "success if " + b.Condition
+		}
+		if b.Edge.LabelText() != "" {
+			return b.Edge.LabelText()
+		}
+		if b.Edge.SourceHandle != "" {
+			return "success"
+		}
+		return ""
+	case BranchFailure:
+		return "failure"
+	case BranchTrue:
+		return "true"
+	case BranchFalse:
+		return "false"
+	}
+	return b.Edge.LabelText()
+}
+
+// Describe returns a one-line, human readable description of a branch
+func (b Branch) Describe(g *Graph) string {
+	target := b.Target
+	if t := g.Node(b.Target); t != nil {
+		target = t.Label()
+		if t.Type == NodeEndStatus {
+			target = "end with status " + t.Str("status")
+		}
+	}
+
+	switch b.Kind {
+	case BranchSuccess:
+		if b.Condition != "" {
+			return "On success when " + b.Condition + " → " + target
+		}
+		return "On success → " + target
+	case BranchFailure:
+		if b.Condition != "" {
+			return "On failure or when " + b.Condition + " → " + target
+		}
+		return "On failure → " + target
+	case BranchTrue, BranchFalse:
+		if b.Condition != "" {
+			return "When " + b.Condition + " → " + target
+		}
+		return "When " + b.Kind + " → " + target
+	}
+	return "Then → " + target
+}
@@ -0,0 +1,144 @@
+package flowgraph
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// Curl is a parsed curl_example from the API catalog
+type Curl struct {
+	Method  string
+	URL     string
+	Headers [][2]string // in the order they appear
+	Body    string
+	Form    [][2]string
+}
+
+// ParseCurl understands the subset of curl used by the documentation:
+// --location, --request/-X, --header/-H, --data*/-d and --form/-F.
+func ParseCurl(cmd string) Curl {
+	var c Curl
+	tokens := shellSplit(cmd)
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		next := func() string {
+			if i+1 < len(tokens) {
+				i++
+				return tokens[i]
+			}
+			return ""
+		}
+
+		switch {
+		case tok == "curl", tok == "--location", tok == "-L", tok == "--compressed", tok == "-s", tok == "--silent":
+		case tok == "-X" || tok == "--request":
+			c.Method = strings.ToUpper(next())
+		case tok == "-H" || tok == "--header":
+			if name, value, ok := strings.Cut(next(), ":"); ok {
+				c.Headers = append(c.Headers, [2]string{strings.TrimSpace(name), strings.TrimSpace(value)})
+			}
+		case tok == "-d" || tok == "--data" || tok == "--data-raw" || tok == "--data-binary" || tok == "--data-urlencode":
+			c.Body = next()
+		case tok == "-F" || tok == "--form":
+			if name, value, ok := strings.Cut(next(), "="); ok {
+				c.Form = append(c.Form, [2]string{strings.TrimSpace(name), strings.TrimSpace(value)})
+			}
+		case strings.HasPrefix(tok, "--url"):
+			c.URL = next()
+		case strings.HasPrefix(tok, "http://") || strings.HasPrefix(tok, "https://"):
+			c.URL = tok
+		}
+	}
+
+	if c.Method == "" {
+		if c.Body != "" || len(c.Form) > 0 {
+			c.Method = "POST"
+		} else {
+			c.Method = "GET"
+		}
+	}
+	return c
+}
+
+// Header returns a header value, case-insensitively
+func (c Curl) Header(name string) string {
+	for _, h := range c.Headers {
+		if strings.EqualFold(h[0], name) {
+			return h[1]
+		}
+	}
+	return ""
+}
+
+var unquotedPlaceholder = regexp.MustCompile(`([:\[,]\s*)(<[^<>"]+>)`)
+
+// JSONBody decodes the request body. Documentation examples leave some
+// placeholders unquoted (e.g. "getCertificate": <Enter_true_or_false>), those
+// are quoted first.
+func (c Curl) JSONBody() (map[string]interface{}, bool) {
+	body := strings.TrimSpace(c.Body)
+	if body == "" {
+		return nil, false
+	}
+	var out map[string]interface{}
+	if json.Unmarshal([]byte(body), &out) == nil {
+		return out, true
+	}
+	fixed := unquotedPlaceholder.ReplaceAllString(body, `$1"$2"`)
+	if json.Unmarshal([]byte(fixed), &out) == nil {
+		return out, true
+	}
+	return nil, false
+}
+
+// shellSplit tokenizes a command line with single and double quotes. The
+// catalog has line continuations glued to the next flag ("\--header"), so
+// stray backslashes are dropped.
+func shellSplit(s string) []string {
+	var tokens []string
+	var cur strings.Builder
+	inToken := false
+	var quote rune
+
+	flush := func() {
+		if inToken {
+			tok := cur.String()
+			tok = strings.TrimLeft(tok, "\\")
+			if tok != "" {
+				tokens = append(tokens, tok)
+			}
+		}
+		cur.Reset()
+		inToken = false
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else if r == '\\' && quote == '"' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+				i++
+				cur.WriteRune(runes[i])
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inToken = true
+		case r == '\\' && i+1 < len(runes) && (runes[i+1] == '\n' || runes[i+1] == '\r'):
+			i++
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			flush()
+		default:
+			cur.WriteRune(r)
+			inToken = true
+		}
+	}
+	flush()
+	return tokens
+}
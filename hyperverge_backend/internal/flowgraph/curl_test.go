@@ -0,0 +1,86 @@
+package flowgraph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCurl(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Curl
+	}{
+		{
+			name: "get",
+			in:   `curl https://ind.idv.hyperverge.co/v1/status`,
+			want: Curl{Method: "GET", URL: "https://ind.idv.hyperverge.co/v1/status"},
+		},
+		{
+			name: "quoted headers and json body",
+			in:   `curl --location --request POST 'https://ind.idv.hyperverge.co/v1/match' --header 'appId: <appId>' --header "Content-Type: application/json" --data-raw '{"a": "b c"}'`,
+			want: Curl{
+				Method:  "POST",
+				URL:     "https://ind.idv.hyperverge.co/v1/match",
+				Headers: [][2]string{{"appId", "<appId>"}, {"Content-Type", "application/json"}},
+				Body:    `{"a": "b c"}`,
+			},
+		},
+		{
+			name: "escaped double quotes",
+			in:   `curl -X post "https://x.io/v1" -d "{\"name\": \"a \\\\ b\"}"`,
+			want: Curl{Method: "POST", URL: "https://x.io/v1", Body: `{"name": "a \\ b"}`},
+		},
+		{
+			name: "multiline with continuations",
+			in:   "curl --location 'https://ind.idv.hyperverge.co/v1/readId' \\\n  --header 'appKey: <appKey>' \\\r\n  --form 'image=@\"/path/to/id.jpg\"' \\\n  --form 'countryId=ind'",
+			want: Curl{
+				Method:  "POST",
+				URL:     "https://ind.idv.hyperverge.co/v1/readId",
+				Headers: [][2]string{{"appKey", "<appKey>"}},
+				Form:    [][2]string{{"image", `@"/path/to/id.jpg"`}, {"countryId", "ind"}},
+			},
+		},
+		{
+			name: "continuation glued to the next flag",
+			in:   "curl 'https://x.io/v1' \\--header 'transactionId: <id>' \\-F file=@selfie.png",
+			want: Curl{
+				Method:  "POST",
+				URL:     "https://x.io/v1",
+				Headers: [][2]string{{"transactionId", "<id>"}},
+				Form:    [][2]string{{"file", "@selfie.png"}},
+			},
+		},
+		{
+			name: "url flag",
+			in:   `curl --url 'https://x.io/v1?a=1&b=2' -H 'x: y'`,
+			want: Curl{Method: "GET", URL: "https://x.io/v1?a=1&b=2", Headers: [][2]string{{"x", "y"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseCurl(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCurl() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurlJSONBody(t *testing.T) {
+	tests := []struct {
+		body string
+		want map[string]interface{}
+		ok   bool
+	}{
+		{`{"a": 1}`, map[string]interface{}{"a": float64(1)}, true},
+		{`{"getCertificate": <Enter_true_or_false>, "ids": [<id>]}`, map[string]interface{}{"getCertificate": "<Enter_true_or_false>", "ids": []interface{}{"<id>"}}, true},
+		{``, nil, false},
+		{`a=1&b=2`, nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := Curl{Body: tt.body}.JSONBody()
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("JSONBody(%q) = %v, %v, want %v, %v", tt.body, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package flowgraph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Eval evaluates a condition against a decoded API response, the way the
+// generated clients do: missing fields are null and comparisons are
+// numeric when both sides are numbers, else textual.
+func Eval(e Expr, response interface{}) interface{} {
+	switch v := e.(type) {
+	case Literal:
+		return v.Value
+	case Path:
+		return Lookup(response, v)
+	case Unary:
+		return !Truthy(Eval(v.X, response))
+	case Binary:
+		switch v.Op {
+		case "&&":
+			return Truthy(Eval(v.L, response)) && Truthy(Eval(v.R, response))
+		case "||":
+			return Truthy(Eval(v.L, response)) || Truthy(Eval(v.R, response))
+		}
+		return compare(Eval(v.L, response), v.Op, Eval(v.R, response))
+	}
+	return nil
+}
+
+// Lookup walks a decoded JSON value; missing fields are nil
+func Lookup(value interface{}, path Path) interface{} {
+	for _, key := range path {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[key]
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil
+			}
+			value = v[i]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// Truthy is the truth value of a JSON value: null, false, 0, "" and empty
+// lists and objects are false
+func Truthy(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return val
+	case float64:
+		return val != 0
+	case string:
+		return val != ""
+	case []interface{}:
+		return len(val) > 0
+	case map[string]interface{}:
+		return len(val) > 0
+	}
+	return true
+}
+
+func compare(a interface{}, op string, b interface{}) bool {
+	if a == nil || b == nil {
+		switch op {
+		case "==":
+			return a == nil && b == nil
+		case "!=":
+			return a != nil || b != nil
+		}
+		return false
+	}
+
+	x, xok := number(a)
+	y, yok := number(b)
+	if xok && yok {
+		switch op {
+		case "==":
+			return x == y
+		case "!=":
+			return x != y
+		case "<":
+			return x < y
+		case "<=":
+			return x <= y
+		case ">":
+			return x > y
+		case ">=":
+			return x >= y
+		}
+		return false
+	}
+
+	s, t := text(a), text(b)
+	switch op {
+	case "==":
+		return s == t
+	case "!=":
+		return s != t
+	case "<":
+		return s < t
+	case "<=":
+		return s <= t
+	case ">":
+		return s > t
+	case ">=":
+		return s >= t
+	}
+	return false
+}
+
+func number(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case float64:
+		return val, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func text(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case bool:
+		// Python's str(True) and JavaScript's String(true) disagree; match
+		// the literals people write in conditions
+		return strconv.FormatBool(val)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,344 @@
+package flowgraph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expr is a parsed branch condition. The editor accepts free text; the
+// subset understood here covers what the catalog and our flows use:
+// field paths, literals, comparisons, &&, || and !, e.g.
+//
+//	response.status == 'success' && result.details.match != false
+//
+// JavaScript (===, !==) and Python (and, or, not, True, None) spellings are
+// accepted too.
+type Expr interface {
+	String() string
+}
+
+// Path reads a field of the API response. A leading "response" is dropped,
+// so response.status and status are the same path.
+type Path []string
+
+// Literal is a string, float64, bool or nil
+type Literal struct {
+	Value interface{}
+}
+
+type Unary struct {
+	Op string // "!"
+	X  Expr
+}
+
+type Binary struct {
+	Op   string // "&&", "||", "==", "!=", "<", "<=", ">", ">="
+	L, R Expr
+}
+
+func (p Path) String() string { return strings.Join(p, ".") }
+
+func (l Literal) String() string {
+	switch v := l.Value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(v)
+	}
+	return fmt.Sprint(l.Value)
+}
+
+func (u Unary) String() string { return "!" + group(u.X) }
+
+func (b Binary) String() string { return group(b.L) + " " + b.Op + " " + group(b.R) }
+
+func group(e Expr) string {
+	if b, ok := e.(Binary); ok {
+		return "(" + b.String() + ")"
+	}
+	return e.String()
+}
+
+// IsComparison reports whether the operator compares two values
+func (b Binary) IsComparison() bool {
+	switch b.Op {
+	case "&&", "||":
+		return false
+	}
+	return true
+}
+
+// Paths returns every response field the expression reads
+func Paths(e Expr) []Path {
+	var out []Path
+	switch v := e.(type) {
+	case Path:
+		out = append(out, v)
+	case Unary:
+		out = append(out, Paths(v.X)...)
+	case Binary:
+		out = append(out, Paths(v.L)...)
+		out = append(out, Paths(v.R)...)
+	}
+	return out
+}
+
+// ParseExpr parses a branch condition
+func ParseExpr(s string) (Expr, error) {
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) == 0 {
+		return nil, fmt.Errorf("empty condition")
+	}
+	p := &parser{toks: toks}
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %q", p.toks[p.pos].text)
+	}
+	return e, nil
+}
+
+type tokKind int
+
+const (
+	tokIdent tokKind = iota
+	tokString
+	tokNumber
+	tokOp
+)
+
+type token struct {
+	kind tokKind
+	text string
+}
+
+var keywordOps = map[string]string{"and": "&&", "or": "||", "not": "!"}
+
+func lex(s string) ([]token, error) {
+	var toks []token
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '\'' || r == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				b.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			toks = append(toks, token{tokString, b.String()})
+			i = j + 1
+
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]) && expectsOperand(toks)):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{tokNumber, string(runes[i:j])})
+			i = j
+
+		case unicode.IsLetter(r) || r == '_' || r == '$':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '$') {
+				j++
+			}
+			word := string(runes[i:j])
+			if op, ok := keywordOps[word]; ok {
+				toks = append(toks, token{tokOp, op})
+			} else {
+				toks = append(toks, token{tokIdent, word})
+			}
+			i = j
+
+		default:
+			matched := false
+			for _, op := range []string{"===", "!==", "==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", ".", "[", "]"} {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					i += len(op)
+					switch op {
+					case "===":
+						op = "=="
+					case "!==":
+						op = "!="
+					}
+					toks = append(toks, token{tokOp, op})
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q", r)
+			}
+		}
+	}
+	return toks, nil
+}
+
+// expectsOperand tells a minus sign from a subtraction we don't support
+func expectsOperand(toks []token) bool {
+	if len(toks) == 0 {
+		return true
+	}
+	last := toks[len(toks)-1]
+	return last.kind == tokOp && last.text != ")" && last.text != "]"
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek(text string) bool {
+	return p.pos < len(p.toks) && p.toks[p.pos].kind == tokOp && p.toks[p.pos].text == text
+}
+
+func (p *parser) or() (Expr, error) {
+	l, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek("||") {
+		p.pos++
+		r, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		l = Binary{Op: "||", L: l, R: r}
+	}
+	return l, nil
+}
+
+func (p *parser) and() (Expr, error) {
+	l, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek("&&") {
+		p.pos++
+		r, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l = Binary{Op: "&&", L: l, R: r}
+	}
+	return l, nil
+}
+
+func (p *parser) unary() (Expr, error) {
+	if p.peek("!") {
+		p.pos++
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return Unary{Op: "!", X: x}, nil
+	}
+	return p.comparison()
+}
+
+func (p *parser) comparison() (Expr, error) {
+	l, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.peek(op) {
+			p.pos++
+			r, err := p.operand()
+			if err != nil {
+				return nil, err
+			}
+			return Binary{Op: op, L: l, R: r}, nil
+		}
+	}
+	return l, nil
+}
+
+func (p *parser) operand() (Expr, error) {
+	if p.pos >= len(p.toks) {
+		return nil, fmt.Errorf("unexpected end of condition")
+	}
+	tok := p.toks[p.pos]
+	p.pos++
+
+	switch tok.kind {
+	case tokString:
+		return Literal{tok.text}, nil
+	case tokNumber:
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", tok.text)
+		}
+		return Literal{n}, nil
+	case tokIdent:
+		switch tok.text {
+		case "true", "True":
+			return Literal{true}, nil
+		case "false", "False":
+			return Literal{false}, nil
+		case "null", "None", "nil", "undefined":
+			return Literal{nil}, nil
+		}
+		return p.path(tok.text)
+	}
+
+	if tok.text == "(" {
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peek(")") {
+			return nil, fmt.Errorf("missing )")
+		}
+		p.pos++
+		return e, nil
+	}
+	return nil, fmt.Errorf("unexpected %q", tok.text)
+}
+
+func (p *parser) path(first string) (Expr, error) {
+	path := Path{first}
+	for {
+		switch {
+		case p.peek("."):
+			p.pos++
+			if p.pos >= len(p.toks) || p.toks[p.pos].kind != tokIdent {
+				return nil, fmt.Errorf("expected field name after .")
+			}
+			path = append(path, p.toks[p.pos].text)
+			p.pos++
+		case p.peek("["):
+			p.pos++
+			if p.pos >= len(p.toks) || (p.toks[p.pos].kind != tokString && p.toks[p.pos].kind != tokNumber) {
+				return nil, fmt.Errorf("expected index after [")
+			}
+			path = append(path, p.toks[p.pos].text)
+			p.pos++
+			if !p.peek("]") {
+				return nil, fmt.Errorf("missing ]")
+			}
+			p.pos++
+		default:
+			if len(path) > 1 && (path[0] == "response" || path[0] == "res" || path[0] == "body") {
+				path = path[1:]
+			}
+			return path, nil
+		}
+	}
+}
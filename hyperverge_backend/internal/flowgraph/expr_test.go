@@ -0,0 +1,56 @@
+package flowgraph
+
+import "testing"
+
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // String() of the parsed expression
+	}{
+		{`status == 'success'`, `status == "success"`},
+		{`response.status === "success"`, `status == "success"`},
+		{`res.status !== 'failure'`, `status != "failure"`},
+		{`result.details.match != false`, `result.details.match != false`},
+		{`name == 'O\'Brien'`, `name == "O'Brien"`},
+		{`name == "say \"hi\""`, `name == "say \"hi\""`},
+		{`score >= 0.8 && score < 1`, `(score >= 0.8) && (score < 1)`},
+		{`delta > -5`, `delta > -5`},
+		{`a == 1 || b == 2 && c == 3`, `(a == 1) || ((b == 2) && (c == 3))`},
+		{`(a == 1 || b == 2) && c == 3`, `((a == 1) || (b == 2)) && (c == 3)`},
+		{`!verified`, `!verified`},
+		{`not verified and score > 1`, `!verified && (score > 1)`},
+		{`result == None or result == True`, `(result == null) || (result == true)`},
+		{`result['face-match'].score > 70`, `result.face-match.score > 70`},
+		{`items[0] == null`, `items.0 == null`},
+		{"status == 'success'\n&& code == 200", `(status == "success") && (code == 200)`},
+	}
+	for _, tt := range tests {
+		e, err := ParseExpr(tt.in)
+		if err != nil {
+			t.Errorf("ParseExpr(%q) returned error %v", tt.in, err)
+			continue
+		}
+		if got := e.String(); got != tt.want {
+			t.Errorf("ParseExpr(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseExprErrors(t *testing.T) {
+	for _, in := range []string{
+		``,
+		`   `,
+		`status == 'success`,
+		`status == "success`,
+		`(a == 1`,
+		`a ==`,
+		`a == 1 b`,
+		`result.`,
+		`items[0`,
+		`a # b`,
+	} {
+		if e, err := ParseExpr(in); err == nil {
+			t.Errorf("ParseExpr(%q) = %s, want an error", in, e)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+// Package flowgraph parses the React Flow documents stored in flow_data and
+// gives the exporters a typed view of nodes, edges and branches.
+package flowgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Node types used by the editor
+const (
+	NodeStart     = "startNode"
+	NodeModule    = "moduleNode"
+	NodeCondition = "conditionNode"
+	NodeEndStatus = "endStatusNode"
+	NodeAPIModule = "apiModuleNode"
+	NodeAPIGroup  = "apiGroupNode"
+	NodeNote      = "noteNode"
+	NodeSdkInputs = "sdkInputsNode"
+	NodeCard      = "genericCardNode"
+)
+
+// End statuses of endStatusNode
+const (
+	StatusApproved    = "auto-approved"
+	StatusDeclined    = "auto-declined"
+	StatusNeedsReview = "needs-review"
+)
+
+type Position struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type Node struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	ParentNode string                 `json:"parentNode,omitempty"`
+	Position   Position               `json:"position"`
+	Data       map[string]interface{} `json:"data"`
+}
+
+type Edge struct {
+	ID           string      `json:"id"`
+	Source       string      `json:"source"`
+	Target       string      `json:"target"`
+	SourceHandle string      `json:"sourceHandle,omitempty"`
+	TargetHandle string      `json:"targetHandle,omitempty"`
+	Label        interface{} `json:"label,omitempty"`
+}
+
+// Graph is a parsed flow_data document
+type Graph struct {
+	Nodes       []Node
+	Edges       []Edge
+	FlowInputs  []string
+	FlowOutputs []string
+	FlowType    string
+
+	byID     map[string]*Node
+	outgoing map[string][]Edge
+	incoming map[string][]Edge
+}
+
+// Parse accepts flow_data either as a JSON string (how the workflow handlers
+// store it) or as a decoded object.
+func Parse(raw interface{}) (*Graph, error) {
+	var doc map[string]interface{}
+	switch v := raw.(type) {
+	case nil:
+		doc = map[string]interface{}{}
+	case string:
+		if strings.TrimSpace(v) == "" {
+			doc = map[string]interface{}{}
+		} else if err := json.Unmarshal([]byte(v), &doc); err != nil {
+			return nil, fmt.Errorf("invalid flow data: %v", err)
+		}
+	case map[string]interface{}:
+		doc = v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &doc); err != nil {
+			return nil, fmt.Errorf("invalid flow data: %v", err)
+		}
+	}
+
+	g := &Graph{
+		FlowInputs:  splitList(doc["flowInputs"]),
+		FlowOutputs: splitList(doc["flowOutputs"]),
+	}
+	g.FlowType, _ = doc["flowType"].(string)
+
+	if err := remarshal(doc["nodes"], &g.Nodes); err != nil {
+		return nil, fmt.Errorf("invalid nodes: %v", err)
+	}
+	if err := remarshal(doc["edges"], &g.Edges); err != nil {
+		return nil, fmt.Errorf("invalid edges: %v", err)
+	}
+
+	g.index()
+	return g, nil
+}
+
+func (g *Graph) index() {
+	g.byID = make(map[string]*Node, len(g.Nodes))
+	g.outgoing = make(map[string][]Edge)
+	g.incoming = make(map[string][]Edge)
+	for i := range g.Nodes {
+		if g.Nodes[i].Data == nil {
+			g.Nodes[i].Data = map[string]interface{}{}
+		}
+		g.byID[g.Nodes[i].ID] = &g.Nodes[i]
+	}
+	for _, e := range g.Edges {
+		g.outgoing[e.Source] = append(g.outgoing[e.Source], e)
+		g.incoming[e.Target] = append(g.incoming[e.Target], e)
+	}
+}
+
+func (g *Graph) Node(id string) *Node {
+	return g.byID[id]
+}
+
+func (g *Graph) Outgoing(id string) []Edge {
+	return g.outgoing[id]
+}
+
+func (g *Graph) Incoming(id string) []Edge {
+	return g.incoming[id]
+}
+
+// Children returns the nodes placed inside a group node
+func (g *Graph) Children(id string) []Node {
+	var out []Node
+	for _, n := range g.Nodes {
+		if n.ParentNode == id {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// Starts returns the start nodes, or nodes without incoming edges when the
+// flow has no explicit start node.
+func (g *Graph) Starts() []Node {
+	var starts []Node
+	for _, n := range g.Nodes {
+		if n.Type == NodeStart {
+			starts = append(starts, n)
+		}
+	}
+	if len(starts) > 0 {
+		return starts
+	}
+	for _, n := range g.Nodes {
+		if len(g.incoming[n.ID]) == 0 && len(g.outgoing[n.ID]) > 0 && n.Type != NodeNote {
+			starts = append(starts, n)
+		}
+	}
+	return starts
+}
+
+// Ordered returns the nodes reachable from the start nodes in breadth-first
+// order. Ties are broken by canvas position (top to bottom, left to right),
+// which is how people read the diagram.
+func (g *Graph) Ordered() []Node {
+	visited := make(map[string]bool)
+	var out []Node
+
+	queue := []string{}
+	for _, s := range sortByPosition(g.Starts()) {
+		queue = append(queue, s.ID)
+		visited[s.ID] = true
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		n := g.byID[id]
+		if n == nil {
+			continue
+		}
+		out = append(out, *n)
+
+		var next []Node
+		for _, e := range g.outgoing[id] {
+			if t := g.byID[e.Target]; t != nil && !visited[t.ID] {
+				visited[t.ID] = true
+				next = append(next, *t)
+			}
+		}
+		for _, t := range sortByPosition(next) {
+			queue = append(queue, t.ID)
+		}
+	}
+	return out
+}
+
+// Steps returns the nodes that do work (API calls and SDK modules) in order
+func (g *Graph) Steps() []Node {
+	var out []Node
+	for _, n := range g.Ordered() {
+		if n.Type == NodeAPIModule || n.Type == NodeModule {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// EndStatuses returns every end status node
+func (g *Graph) EndStatuses() []Node {
+	var out []Node
+	for _, n := range g.Nodes {
+		if n.Type == NodeEndStatus {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func sortByPosition(nodes []Node) []Node {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Position.Y != nodes[j].Position.Y {
+			return nodes[i].Position.Y < nodes[j].Position.Y
+		}
+		return nodes[i].Position.X < nodes[j].Position.X
+	})
+	return nodes
+}
+
+// Str returns a string field of the node data
+func (n Node) Str(key string) string {
+	if v, ok := n.Data[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// Label is the human readable name of a node
+func (n Node) Label() string {
+	for _, key := range []string{"title", "label", "name"} {
+		if v := strings.TrimSpace(n.Str(key)); v != "" {
+			return v
+		}
+	}
+	switch n.Type {
+	case NodeStart:
+		return "Start"
+	case NodeCondition:
+		if c := n.Str("condition"); c != "" {
+			return c
+		}
+		return "Condition"
+	case NodeEndStatus:
+		if s := n.Str("status"); s != "" {
+			return s
+		}
+		return "End"
+	}
+	return n.ID
+}
+
+// StringList returns a list-of-strings field of the node data
+func (n Node) StringList(key string) []string {
+	return toStrings(n.Data[key])
+}
+
+// LabelText returns the edge label as a string
+func (e Edge) LabelText() string {
+	if s, ok := e.Label.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// ToMap converts the graph back into a flow_data document
+func (g *Graph) ToMap() map[string]interface{} {
+	var nodes, edges []interface{}
+	remarshal(g.Nodes, &nodes)
+	remarshal(g.Edges, &edges)
+	if nodes == nil {
+		nodes = []interface{}{}
+	}
+	if edges == nil {
+		edges = []interface{}{}
+	}
+	return map[string]interface{}{
+		"nodes":       nodes,
+		"edges":       edges,
+		"flowInputs":  strings.Join(g.FlowInputs, ","),
+		"flowOutputs": strings.Join(g.FlowOutputs, ","),
+		"flowType":    g.FlowType,
+	}
+}
+
+func remarshal(in interface{}, out interface{}) error {
+	if in == nil {
+		return nil
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// flowInputs/flowOutputs are stored as comma separated strings, older
+// documents use arrays
+func splitList(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		var out []string
+		for _, part := range strings.Split(val, ",") {
+			if p := strings.TrimSpace(part); p != "" {
+				out = append(out, p)
+			}
+		}
+		return out
+	case []interface{}:
+		return toStrings(val)
+	}
+	return nil
+}
+
+func toStrings(v interface{}) []string {
+	list, ok := v.([]interface{})
+	if !ok {
+		if s, ok := v.([]string); ok {
+			return s
+		}
+		return nil
+	}
+	var out []string
+	for _, item := range list {
+		if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+			out = append(out, strings.TrimSpace(s))
+		}
+	}
+	return out
+}
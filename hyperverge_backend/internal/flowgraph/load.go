@@ -0,0 +1,258 @@
+package flowgraph
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/variables"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Where a loaded graph came from
+const (
+	OriginDraft       = "draft"
+	OriginVersion     = "version"
+	OriginEnvironment = "environment" // the environment's flow_data_override
+)
+
+// ErrNotFound is returned when the workflow, version or link doesn't exist
+var ErrNotFound = errors.New("not found")
+
+// Source selects which graph of a workflow to load. With an environment and
+// no version, the environment's effective graph is used: its override when it
+// has one, else the active published version, else the draft.
+type Source struct {
+	WorkflowID    string
+	VersionID     string
+	EnvironmentID string
+}
+
+type Meta struct {
+	WorkflowID     string `json:"workflow_id"`
+	WorkflowName   string `json:"workflow_name"`
+	Description    string `json:"description,omitempty"`
+	BusinessUnitID string `json:"business_unit_id"`
+	FlowType       string `json:"flow_type"`
+	Origin         string `json:"origin"`
+
+	VersionID     string `json:"version_id,omitempty"`
+	VersionNumber string `json:"version_number,omitempty"`
+
+	EnvironmentID   string                 `json:"environment_id,omitempty"`
+	EnvironmentName string                 `json:"environment_name,omitempty"`
+	Environment     map[string]interface{} `json:"-"`
+	Variables       map[string]interface{} `json:"-"` // resolved client -> BU -> environment variables
+}
+
+// Load reads a graph from the database
+func Load(src Source) (*Graph, *Meta, error) {
+	wf, err := selectOne("test_workflows", "id, name, description, business_unit_id, flow_data, flow_type, active_published_version_id", "id", src.WorkflowID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	meta := &Meta{
+		WorkflowID:     src.WorkflowID,
+		WorkflowName:   db.GetString(wf, "name"),
+		Description:    db.GetString(wf, "description"),
+		BusinessUnitID: db.GetString(wf, "business_unit_id"),
+		FlowType:       db.GetString(wf, "flow_type"),
+		Origin:         OriginDraft,
+	}
+	flowData := wf["flow_data"]
+
+	if src.EnvironmentID != "" {
+		env, err := selectOne("test_environments", "*", "id", src.EnvironmentID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if db.GetString(env, "business_unit_id") != meta.BusinessUnitID {
+			return nil, nil, ErrNotFound
+		}
+		meta.EnvironmentID = src.EnvironmentID
+		meta.EnvironmentName = db.GetString(env, "name")
+		meta.Environment = env
+		if resolved, err := variables.ResolveForEnvironment(env); err == nil {
+			meta.Variables = variables.Flatten(resolved)
+		}
+
+		if src.VersionID == "" {
+			link, err := selectLink(src.WorkflowID, src.EnvironmentID)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch {
+			case hasNodes(link["flow_data_override"]):
+				flowData = link["flow_data_override"]
+				meta.Origin = OriginEnvironment
+			case db.GetString(wf, "active_published_version_id") != "":
+				src.VersionID = db.GetString(wf, "active_published_version_id")
+			}
+		}
+	}
+
+	if src.VersionID != "" {
+		version, err := selectOne("workflow_versions", "id, workflow_id, version_number, flow_data, flow_type", "id", src.VersionID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if db.GetString(version, "workflow_id") != src.WorkflowID {
+			return nil, nil, ErrNotFound
+		}
+		flowData = version["flow_data"]
+		meta.Origin = OriginVersion
+		meta.VersionID = src.VersionID
+		meta.VersionNumber = db.GetString(version, "version_number")
+		if ft := db.GetString(version, "flow_type"); ft != "" {
+			meta.FlowType = ft
+		}
+	}
+
+	g, err := Parse(flowData)
+	if err != nil {
+		return nil, nil, err
+	}
+	if g.FlowType == "" {
+		g.FlowType = meta.FlowType
+	}
+	if meta.FlowType == "" {
+		meta.FlowType = g.FlowType
+	}
+	return g, meta, nil
+}
+
+// FromRequest loads the graph selected by :id, ?version_id= and
+// ?environment_id= after checking that the current user can read the
+// workflow. On failure it has already written the error response.
+func FromRequest(c *gin.Context) (*Graph, *Meta, bool) {
+	if !authorize(c, false) {
+		return nil, nil, false
+	}
+	return load(c)
+}
+
+// ForEdit loads the draft of :id after checking that the current user can
+// edit the workflow. On failure it has already written the error response.
+func ForEdit(c *gin.Context) (*Graph, *Meta, bool) {
+	if !authorize(c, true) {
+		return nil, nil, false
+	}
+	g, meta, err := Load(Source{WorkflowID: c.Param("id")})
+	if err == ErrNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
+		return nil, nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, nil, false
+	}
+	return g, meta, true
+}
+
+// FromPublicRequest is FromRequest for BU access links: the workflow has to
+// belong to the business unit the link was issued for.
+func FromPublicRequest(c *gin.Context, buId string) (*Graph, *Meta, bool) {
+	g, meta, ok := load(c)
+	if !ok {
+		return nil, nil, false
+	}
+	if meta.BusinessUnitID != buId {
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
+		return nil, nil, false
+	}
+	return g, meta, true
+}
+
+// authorize checks access to the business unit of :id before anything else
+// of the workflow is read
+func authorize(c *gin.Context, write bool) bool {
+	wf, err := selectOne("test_workflows", "business_unit_id", "id", c.Param("id"))
+	if err == ErrNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
+		return false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+	if !auth.CanAccessBU(db.GetString(wf, "business_unit_id"), c.GetString("userId"), write) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return false
+	}
+	return true
+}
+
+func load(c *gin.Context) (*Graph, *Meta, bool) {
+	g, meta, err := Load(Source{
+		WorkflowID:    c.Param("id"),
+		VersionID:     c.Query("version_id"),
+		EnvironmentID: c.Query("environment_id"),
+	})
+	if err == ErrNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow, version or environment not found"})
+		return nil, nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, nil, false
+	}
+	return g, meta, true
+}
+
+func selectOne(table, columns, column, value string) (map[string]interface{}, error) {
+	if value == "" {
+		return nil, ErrNotFound
+	}
+
+	data, _, err := db.Client.
+		From(table).
+		Select(columns, "", false).
+		Eq(column, value).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+	return results[0], nil
+}
+
+func selectLink(workflowId, environmentId string) (map[string]interface{}, error) {
+	data, _, err := db.Client.
+		From("test_workflow_environments").
+		Select("id, flow_data_override", "", false).
+		Eq("workflow_id", workflowId).
+		Eq("environment_id", environmentId).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+	return results[0], nil
+}
+
+// An override of {} or without nodes means "no override"
+func hasNodes(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	g, err := Parse(v)
+	return err == nil && len(g.Nodes) > 0
+}
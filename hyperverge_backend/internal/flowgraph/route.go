@@ -0,0 +1,97 @@
+package flowgraph
+
+// Route kinds
+const (
+	RouteStep = "step" // call another API node
+	RouteEnd  = "end"  // reach an end status
+	RouteIf   = "if"   // decide on a condition node
+	RouteStop = "stop" // the flow doesn't say what happens next
+)
+
+// Route is where a branch of an API call leads, looking through condition
+// nodes and SDK modules until the next API call or end status.
+type Route struct {
+	Kind      string `json:"kind"`
+	NodeID    string `json:"node_id,omitempty"`
+	Status    string `json:"status,omitempty"`    // RouteEnd
+	Condition string `json:"condition,omitempty"` // RouteIf
+	Then      *Route `json:"then,omitempty"`
+	Else      *Route `json:"else,omitempty"`
+}
+
+// StepRoutes returns where an API call goes on success (2xx and its condition
+// holds) and on failure
+func (g *Graph) StepRoutes(id string) (onSuccess, onFailure *Route) {
+	var success, failure []Branch
+	for _, b := range g.Branches(id) {
+		if b.Kind == BranchFailure {
+			failure = append(failure, b)
+		} else {
+			success = append(success, b)
+		}
+	}
+	seen := map[string]bool{id: true}
+	return g.follow(success, seen), g.follow(failure, seen)
+}
+
+// StartRoute is where the flow goes from its start node
+func (g *Graph) StartRoute() *Route {
+	for _, s := range sortByPosition(g.Starts()) {
+		if s.Type == NodeStart {
+			return g.follow(g.Branches(s.ID), map[string]bool{s.ID: true})
+		}
+	}
+	starts := sortByPosition(g.Starts())
+	if len(starts) == 0 {
+		return &Route{Kind: RouteStop}
+	}
+	return g.route(starts[0].ID, map[string]bool{})
+}
+
+func (g *Graph) follow(branches []Branch, seen map[string]bool) *Route {
+	if len(branches) == 0 {
+		return &Route{Kind: RouteStop}
+	}
+	return g.route(branches[0].Target, seen)
+}
+
+func (g *Graph) route(id string, seen map[string]bool) *Route {
+	n := g.Node(id)
+	if n == nil || seen[id] {
+		return &Route{Kind: RouteStop}
+	}
+
+	switch n.Type {
+	case NodeAPIModule:
+		return &Route{Kind: RouteStep, NodeID: id}
+	case NodeEndStatus:
+		return &Route{Kind: RouteEnd, NodeID: id, Status: n.Str("status")}
+	}
+
+	next := make(map[string]bool, len(seen)+1)
+	for k := range seen {
+		next[k] = true
+	}
+	next[id] = true
+
+	if n.Type == NodeCondition {
+		var whenTrue, whenFalse []Branch
+		for _, b := range g.Branches(id) {
+			if b.Kind == BranchFalse {
+				whenFalse = append(whenFalse, b)
+			} else {
+				whenTrue = append(whenTrue, b)
+			}
+		}
+		return &Route{
+			Kind:      RouteIf,
+			NodeID:    id,
+			Condition: n.Str("condition"),
+			Then:      g.follow(whenTrue, next),
+			Else:      g.follow(whenFalse, next),
+		}
+	}
+
+	// SDK modules and other nodes pass straight through
+	return g.follow(g.Branches(id), next)
+}
@@ -0,0 +1,118 @@
+package handbook
+
+import (
+	"strings"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// docs is the catalog documentation of the APIs and modules of a handbook
+type docs struct {
+	apis    map[string]catalog.API // by id and by url
+	modules catalog.ModuleIndex
+}
+
+func loadDocs() (*docs, error) {
+	apis, err := catalog.LoadAPIs(catalog.Filter{})
+	if err != nil {
+		return nil, err
+	}
+	modules, err := catalog.LoadModuleIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &docs{apis: make(map[string]catalog.API), modules: modules}
+	for _, a := range apis {
+		d.apis[a.ID] = a
+		if a.URL != "" {
+			d.apis[a.URL] = a
+		}
+	}
+	return d, nil
+}
+
+// lookupAPI finds the catalog entry an API node was created from: by the id in
+// the node id (api-doc-<id>-<timestamp>), else by its documentation URL or
+// endpoint
+func (c *docs) lookupAPI(n flowgraph.Node) (catalog.API, bool) {
+	if id, ok := strings.CutPrefix(n.ID, "api-doc-"); ok {
+		if i := strings.LastIndex(id, "-"); i > 0 {
+			if a, ok := c.apis[id[:i]]; ok {
+				return a, true
+			}
+		}
+	}
+	for _, key := range []string{n.Str("docUrl"), n.Str("endpoint")} {
+		if a, ok := c.apis[key]; ok && key != "" {
+			return a, true
+		}
+	}
+	return catalog.API{}, false
+}
+
+// steps describes the SDK modules and API calls of a graph in order,
+// preferring the catalog's documentation to what the node stores
+func (c *docs) steps(g *flowgraph.Graph) []Step {
+	var steps []Step
+	for _, n := range g.Steps() {
+		switch n.Type {
+		case flowgraph.NodeModule:
+			m, ok := c.modules[n.Str("moduleType")]
+			step := Step{Kind: "SDK module", Name: n.Label(), Category: n.Str("category"), Description: n.Str("description")}
+			if ok {
+				if m.Description != "" {
+					step.Description = m.Description
+				}
+				if m.Category != "" {
+					step.Category = m.Category
+				}
+			}
+			step.Category = strings.ReplaceAll(step.Category, "_", " ")
+			steps = append(steps, step)
+
+		case flowgraph.NodeAPIModule:
+			call := n.APICall()
+			step := Step{
+				Kind:        "API",
+				Name:        call.Title,
+				Description: call.Description,
+				Method:      call.Method,
+				URL:         call.URL,
+				Inputs:      nodeFields(call.Inputs),
+				Outputs:     nodeFields(call.Outputs),
+			}
+			if a, ok := c.lookupAPI(n); ok {
+				if a.Description != "" {
+					step.Description = a.Description
+				}
+				step.Category = strings.ReplaceAll(a.Category, "_", " ")
+				if len(a.Inputs) > 0 {
+					step.Inputs = catalogFields(a.Inputs)
+				}
+				if len(a.Outputs) > 0 {
+					step.Outputs = catalogFields(a.Outputs)
+				}
+			}
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+func nodeFields(fields []flowgraph.Field) []Field {
+	out := make([]Field, len(fields))
+	for i, f := range fields {
+		out[i] = Field{Name: f.Name, Type: f.Type, Required: f.Required}
+	}
+	return out
+}
+
+func catalogFields(fields []catalog.Field) []Field {
+	out := make([]Field, len(fields))
+	for i, f := range fields {
+		out[i] = Field{Name: f.Name, Type: f.Type, Description: f.Description, Required: f.Required}
+	}
+	return out
+}
@@ -0,0 +1,510 @@
+package handbook
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/pdf"
+	"hypervision_backend/internal/render"
+)
+
+// Page geometry and type sizes, in points
+const (
+	margin     = 48
+	bodySize   = 10
+	smallSize  = 8.5
+	cellSize   = 8.5
+	cellPad    = 4
+	lineFactor = 1.35
+)
+
+const (
+	colorText   = "#111827"
+	colorMuted  = "#6B7280"
+	colorAccent = "#4F46E5"
+	colorRule   = "#E5E7EB"
+	colorHeader = "#F3F4F6"
+)
+
+// Write lays out the handbook as a PDF
+func Write(h *Handbook) []byte {
+	w := &writer{doc: pdf.New(h.BusinessUnitName + " integration handbook")}
+	w.doc.Subject = "Integration handbook"
+	w.doc.Created = h.Generated
+
+	cover := w.doc.AddPage(pdf.A4Width, pdf.A4Height)
+	var contents []entry
+
+	for _, wf := range h.Workflows {
+		contents = append(contents, entry{wf.Meta.WorkflowName, len(w.doc.Pages) + 1})
+		w.workflow(wf)
+	}
+	contents = append(contents, entry{"Environments", len(w.doc.Pages) + 1})
+	w.environments(h.Environments)
+	contents = append(contents, entry{"Network allowlists", len(w.doc.Pages) + 1})
+	w.allowlists(h)
+
+	writeCover(cover, h, contents)
+	w.footers(h)
+	return w.doc.Bytes()
+}
+
+// entry is a line of the table of contents
+type entry struct {
+	title string
+	page  int
+}
+
+// writer flows text down A4 pages, starting new ones as they fill up
+type writer struct {
+	doc  *pdf.Document
+	page *pdf.Page
+	y    float64
+}
+
+func (w *writer) newPage() {
+	w.page = w.doc.AddPage(pdf.A4Width, pdf.A4Height)
+	w.y = margin
+}
+
+func (w *writer) width() float64 {
+	return w.page.Width - 2*margin
+}
+
+// need starts a new page unless height points are left
+func (w *writer) need(height float64) {
+	if w.page == nil || w.y+height > w.page.Height-margin-16 {
+		w.newPage()
+	}
+}
+
+func (w *writer) title(text string) {
+	w.need(40)
+	w.y += 22
+	w.page.Text(margin, w.y, text, pdf.HelveticaBold, 20, colorText)
+	w.y += 8
+	w.page.Rect(margin, w.y, w.width(), 2, 0, pdf.Style{Fill: colorAccent})
+	w.y += 16
+}
+
+func (w *writer) heading(text string) {
+	w.need(48)
+	w.y += 14
+	w.page.Text(margin, w.y, text, pdf.HelveticaBold, 13, colorText)
+	w.y += 10
+}
+
+func (w *writer) subheading(text string) {
+	w.need(36)
+	w.y += 8
+	w.page.Text(margin, w.y, text, pdf.HelveticaBold, bodySize, colorMuted)
+	w.y += 6
+}
+
+// paragraph writes wrapped text
+func (w *writer) paragraph(text string, f pdf.Font, size float64, color string) {
+	lead := size * lineFactor
+	for _, line := range pdf.Wrap(text, f, size, w.width()) {
+		w.need(lead)
+		w.y += lead
+		w.page.Text(margin, w.y, line, f, size, color)
+	}
+	w.y += 4
+}
+
+// facts writes label/value pairs, skipping empty values
+func (w *writer) facts(pairs [][2]string) {
+	const labelWidth = 120
+	lead := bodySize * lineFactor
+	for _, p := range pairs {
+		if strings.TrimSpace(p[1]) == "" {
+			continue
+		}
+		lines := pdf.Wrap(p[1], pdf.Helvetica, bodySize, w.width()-labelWidth)
+		w.need(lead * float64(len(lines)))
+		for i, line := range lines {
+			w.y += lead
+			if i == 0 {
+				w.page.Text(margin, w.y, p[0], pdf.HelveticaBold, bodySize, colorMuted)
+			}
+			w.page.Text(margin+labelWidth, w.y, line, pdf.Helvetica, bodySize, colorText)
+		}
+	}
+	w.y += 6
+}
+
+// column of a table; Share is its part of the page width
+type column struct {
+	Title string
+	Share float64
+	Font  pdf.Font
+}
+
+// table draws rows with wrapped cells, repeating the header on every page
+func (w *writer) table(cols []column, rows [][]string) {
+	lead := cellSize * lineFactor
+	widths := make([]float64, len(cols))
+	for i, c := range cols {
+		widths[i] = c.Share * w.width()
+	}
+
+	header := func() {
+		h := lead + 2*cellPad
+		w.page.Rect(margin, w.y, w.width(), h, 0, pdf.Style{Fill: colorHeader})
+		x := float64(margin)
+		for i, c := range cols {
+			w.page.Text(x+cellPad, w.y+cellPad+cellSize, c.Title, pdf.HelveticaBold, cellSize, colorText)
+			x += widths[i]
+		}
+		w.y += h
+	}
+
+	w.need(3 * (lead + 2*cellPad))
+	header()
+	for _, row := range rows {
+		cells := make([][]string, len(cols))
+		lines := 1
+		for i, c := range cols {
+			text := ""
+			if i < len(row) {
+				text = row[i]
+			}
+			cells[i] = pdf.Wrap(text, c.Font, cellSize, widths[i]-2*cellPad)
+			lines = max(lines, len(cells[i]))
+		}
+		h := float64(lines)*lead + 2*cellPad
+		if w.y+h > w.page.Height-margin-16 {
+			w.newPage()
+			header()
+		}
+		x := float64(margin)
+		for i, c := range cols {
+			for j, line := range cells[i] {
+				w.page.Text(x+cellPad, w.y+cellPad+cellSize+float64(j)*lead, line, c.Font, cellSize, colorText)
+			}
+			x += widths[i]
+		}
+		w.y += h
+		w.page.Polyline([]pdf.Point{{X: margin, Y: w.y}, {X: margin + w.width(), Y: w.y}}, pdf.Style{Stroke: colorRule, Width: 0.75})
+	}
+	w.y += 10
+}
+
+func (w *writer) workflow(wf Workflow) {
+	w.newPage()
+	w.title(wf.Meta.WorkflowName)
+	if wf.Meta.Description != "" {
+		w.paragraph(wf.Meta.Description, pdf.Helvetica, bodySize, colorText)
+	}
+
+	graph := "Draft (nothing published yet)"
+	if wf.Meta.VersionNumber != "" {
+		graph = graphName(wf.Meta) + " (active)"
+	}
+	var ends []string
+	for _, n := range wf.Graph.EndStatuses() {
+		ends = append(ends, n.Label())
+	}
+	w.facts([][2]string{
+		{"Flow type", strings.ToUpper(wf.Meta.FlowType)},
+		{"Documented graph", graph},
+		{"Flow inputs", strings.Join(wf.Graph.FlowInputs, ", ")},
+		{"Flow outputs", strings.Join(wf.Graph.FlowOutputs, ", ")},
+		{"End statuses", strings.Join(unique(ends), ", ")},
+	})
+
+	w.diagram(wf)
+
+	w.newPage()
+	w.heading("Steps")
+	if len(wf.Steps) == 0 {
+		w.paragraph("This workflow has no SDK modules or API calls yet.", pdf.Helvetica, bodySize, colorMuted)
+	}
+	for i, s := range wf.Steps {
+		w.step(i+1, s)
+	}
+
+	if wf.Coverage != nil {
+		w.coverage(wf.Coverage)
+	} else {
+		w.heading("Decision coverage")
+		w.paragraph("Coverage unavailable: "+wf.CoverageError, pdf.Helvetica, bodySize, colorMuted)
+	}
+
+	w.heading("Version history")
+	if len(wf.Versions) == 0 {
+		w.paragraph("No version has been published.", pdf.Helvetica, bodySize, colorMuted)
+		return
+	}
+	var rows [][]string
+	for _, v := range wf.Versions {
+		number := v.Number
+		if v.Active {
+			number += " (active)"
+		}
+		rows = append(rows, []string{number, v.PublishedAt, v.Details})
+	}
+	w.table([]column{
+		{"Version", 0.18, pdf.HelveticaBold},
+		{"Published", 0.24, pdf.Helvetica},
+		{"Notes", 0.58, pdf.Helvetica},
+	}, rows)
+}
+
+// diagram puts the workflow diagram on a page of its own, turned to
+// landscape when it's wider than tall and shrunk to fit
+func (w *writer) diagram(wf Workflow) {
+	d := wf.Diagram
+	width, height := pdf.A4Width, pdf.A4Height
+	if d.Width > d.Height {
+		width, height = height, width
+	}
+	w.page = w.doc.AddPage(width, height)
+
+	availW, availH := width-2*margin, height-2*margin-16
+	scale := math.Min(1, math.Min(availW/d.Width, availH/d.Height))
+	x := margin + (availW-d.Width*scale)/2
+	render.DrawPDF(d, w.page, x, margin, scale)
+	w.page.Rect(x, margin, d.Width*scale, d.Height*scale, 0, pdf.Style{Stroke: colorRule, Width: 0.75})
+	w.y = height
+}
+
+func (w *writer) step(n int, s Step) {
+	w.heading(fmt.Sprintf("%d. %s", n, s.Name))
+	kind := s.Kind
+	if s.Category != "" {
+		kind += ", " + s.Category
+	}
+	w.paragraph(kind, pdf.Helvetica, smallSize, colorAccent)
+	if s.Method != "" || s.URL != "" {
+		w.paragraph(strings.TrimSpace(s.Method+" "+s.URL), pdf.Courier, smallSize, colorText)
+	}
+	if s.Description != "" {
+		w.paragraph(s.Description, pdf.Helvetica, bodySize, colorText)
+	}
+	if len(s.Inputs) > 0 {
+		w.subheading("Inputs")
+		w.fields(s.Inputs, true)
+	}
+	if len(s.Outputs) > 0 {
+		w.subheading("Outputs")
+		w.fields(s.Outputs, false)
+	}
+}
+
+// maxPathRows bounds the path table; the JSON report has every path
+const maxPathRows = 100
+
+// coverage lists the decision paths and the gaps a risk review looks for
+func (w *writer) coverage(r *coverage.Report) {
+	w.heading("Decision coverage")
+	paths := fmt.Sprint(len(r.Paths))
+	if r.Truncated {
+		paths = fmt.Sprintf("more than %d; the first %d are listed", coverage.MaxPaths, len(r.Paths))
+	}
+	var unreachable, missing, traps []string
+	for _, e := range r.UnreachableEnds {
+		unreachable = append(unreachable, e.Title)
+	}
+	for _, gap := range r.MissingBranches {
+		missing = append(missing, gap.Message)
+	}
+	for _, loop := range r.Traps {
+		traps = append(traps, strings.Join(loop.Titles, " -> "))
+	}
+	facts := [][2]string{
+		{"Start-to-end paths", paths},
+		{"Reachable statuses", strings.Join(r.Statuses, ", ")},
+		{"Unreachable ends", strings.Join(unreachable, ", ")},
+		{"Missing branches", strings.Join(missing, "; ")},
+		{"Loops without exit", strings.Join(traps, "; ")},
+	}
+	if t := r.Tests; t != nil {
+		facts = append(facts,
+			[2]string{"Test cases", fmt.Sprintf("%d, %d passing", t.Cases, t.Passed)},
+			[2]string{"Branch coverage", fmt.Sprintf("%d of %d (%.1f%%)", t.Branches.Covered, t.Branches.Total, t.Branches.Percent)},
+			[2]string{"Path coverage", fmt.Sprintf("%d of %d (%.1f%%)", t.Paths.Covered, t.Paths.Total, t.Paths.Percent)},
+		)
+	} else {
+		facts = append(facts, [2]string{"Test cases", "None"})
+	}
+	w.facts(facts)
+
+	if len(r.Paths) == 0 {
+		w.paragraph("No path leads from a start node to an end.", pdf.Helvetica, bodySize, colorMuted)
+		return
+	}
+	var rows [][]string
+	for i, p := range r.Paths {
+		if i == maxPathRows {
+			break
+		}
+		end := p.Status
+		if !p.Complete {
+			end = "Stops at " + p.Steps[len(p.Steps)-1].Title
+		}
+		conditions := strings.Join(p.Conditions, "; ")
+		if conditions == "" {
+			conditions = "Always"
+		}
+		row := []string{fmt.Sprint(i + 1), conditions, end}
+		if r.Tests != nil {
+			row = append(row, strings.Join(p.Cases, ", "))
+		}
+		rows = append(rows, row)
+	}
+	cols := []column{{"#", 0.06, pdf.HelveticaBold}, {"Conditions", 0.62, pdf.Helvetica}, {"Ends", 0.32, pdf.Helvetica}}
+	if r.Tests != nil {
+		cols = []column{{"#", 0.06, pdf.HelveticaBold}, {"Conditions", 0.50, pdf.Helvetica}, {"Ends", 0.22, pdf.Helvetica}, {"Test cases", 0.22, pdf.Helvetica}}
+	}
+	w.table(cols, rows)
+	if len(r.Paths) > maxPathRows {
+		w.paragraph(fmt.Sprintf("%d more paths are in the coverage report of the workflow.", len(r.Paths)-maxPathRows), pdf.Helvetica, bodySize, colorMuted)
+	}
+}
+
+func (w *writer) fields(fields []Field, inputs bool) {
+	var rows [][]string
+	for _, f := range fields {
+		row := []string{f.Name, f.Type}
+		if inputs {
+			required := "optional"
+			if f.Required {
+				required = "required"
+			}
+			row = append(row, required)
+		}
+		rows = append(rows, append(row, f.Description))
+	}
+	cols := []column{{"Name", 0.28, pdf.Courier}, {"Type", 0.14, pdf.Helvetica}}
+	if inputs {
+		cols = append(cols, column{"Required", 0.12, pdf.Helvetica}, column{"Description", 0.46, pdf.Helvetica})
+	} else {
+		cols = append(cols, column{"Description", 0.58, pdf.Helvetica})
+	}
+	w.table(cols, rows)
+}
+
+func (w *writer) environments(envs []Environment) {
+	w.newPage()
+	w.title("Environments")
+	w.paragraph("Effective variables after client, business unit and environment values are merged. Secrets and credentials are shown as "+Redacted+"; they are shared separately.", pdf.Helvetica, bodySize, colorMuted)
+	if len(envs) == 0 {
+		w.paragraph("No environments are configured.", pdf.Helvetica, bodySize, colorMuted)
+	}
+	for _, env := range envs {
+		w.heading(env.Name)
+		w.facts([][2]string{
+			{"Integration type", env.IntegrationType},
+			{"Description", env.Description},
+		})
+		if len(env.Variables) == 0 {
+			w.paragraph("No variables.", pdf.Helvetica, bodySize, colorMuted)
+			continue
+		}
+		rows := make([][]string, len(env.Variables))
+		for i, v := range env.Variables {
+			rows[i] = []string{v.Key, v.Value, v.Source}
+		}
+		w.table([]column{
+			{"Variable", 0.32, pdf.Courier},
+			{"Value", 0.48, pdf.Courier},
+			{"Defined at", 0.20, pdf.Helvetica},
+		}, rows)
+	}
+}
+
+func (w *writer) allowlists(h *Handbook) {
+	w.newPage()
+	w.title("Network allowlists")
+
+	sections := []struct {
+		heading, intro, column string
+		entries                []Allowed
+	}{
+		{"Content Security Policy", "Origins the SDK loads from. Add them to the CSP of the pages that embed it.", "URL", h.CspURLs},
+		{"IP addresses", "Addresses to allow through firewalls.", "IP address", h.IPAddresses},
+		{"API hosts", "Hosts your backend calls.", "Host", h.APIHosts},
+	}
+	for _, s := range sections {
+		w.heading(s.heading)
+		w.paragraph(s.intro, pdf.Helvetica, bodySize, colorMuted)
+		if len(s.entries) == 0 {
+			w.paragraph("None needed.", pdf.Helvetica, bodySize, colorMuted)
+			continue
+		}
+		rows := make([][]string, len(s.entries))
+		for i, e := range s.entries {
+			rows[i] = []string{e.Value, strings.Join(e.Workflows, ", ")}
+		}
+		w.table([]column{{s.column, 0.55, pdf.Courier}, {"Used by", 0.45, pdf.Helvetica}}, rows)
+	}
+}
+
+func writeCover(p *pdf.Page, h *Handbook, contents []entry) {
+	width := p.Width - 2*margin
+	p.Rect(0, 0, p.Width, 260, 0, pdf.Style{Fill: colorAccent})
+	p.Text(margin, 110, "INTEGRATION HANDBOOK", pdf.HelveticaBold, 11, "#E0E7FF")
+
+	y := 150.0
+	for _, line := range pdf.Wrap(h.BusinessUnitName, pdf.HelveticaBold, 30, width) {
+		p.Text(margin, y, line, pdf.HelveticaBold, 30, "#FFFFFF")
+		y += 36
+	}
+	if h.ClientName != "" {
+		p.Text(margin, y, h.ClientName, pdf.Helvetica, 14, "#E0E7FF")
+	}
+
+	y = 300
+	for _, line := range pdf.Wrap(h.Description, pdf.Helvetica, 11, width) {
+		p.Text(margin, y, line, pdf.Helvetica, 11, colorText)
+		y += 11 * lineFactor
+	}
+	p.Text(margin, y+10, "Generated "+h.Generated.Format("2 January 2006, 15:04 UTC"), pdf.Helvetica, bodySize, colorMuted)
+
+	y += 50
+	p.Text(margin, y, "Contents", pdf.HelveticaBold, 13, colorText)
+	y += 8
+	for _, e := range contents {
+		y += 18
+		if y > p.Height-margin-16 {
+			break
+		}
+		title := e.title
+		number := fmt.Sprint(e.page)
+		numberX := margin + width - pdf.Width(number, pdf.Helvetica, 11)
+		for runes := []rune(title); len(runes) > 0 && pdf.Width(title, pdf.Helvetica, 11) > numberX-margin-24; {
+			runes = runes[:len(runes)-1]
+			title = strings.TrimSpace(string(runes)) + "..."
+		}
+		p.Text(margin, y, title, pdf.Helvetica, 11, colorText)
+		p.Polyline([]pdf.Point{{X: margin + pdf.Width(title, pdf.Helvetica, 11) + 6, Y: y}, {X: numberX - 6, Y: y}},
+			pdf.Style{Stroke: colorRule, Width: 0.75, Dash: []float64{1, 2}})
+		p.Text(numberX, y, number, pdf.Helvetica, 11, colorText)
+	}
+}
+
+// footers number the pages once the count is known
+func (w *writer) footers(h *Handbook) {
+	total := len(w.doc.Pages)
+	for i, p := range w.doc.Pages[1:] {
+		y := p.Height - margin/2
+		p.Text(margin, y, h.BusinessUnitName+" integration handbook", pdf.Helvetica, smallSize, colorMuted)
+		number := fmt.Sprintf("Page %d of %d", i+2, total)
+		p.Text(p.Width-margin-pdf.Width(number, pdf.Helvetica, smallSize), y, number, pdf.Helvetica, smallSize, colorMuted)
+	}
+}
+
+func unique(items []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, s := range items {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
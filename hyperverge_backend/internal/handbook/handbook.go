@@ -0,0 +1,377 @@
+// Package handbook builds the integration handbook of a business unit: one
+// PDF with every workflow's diagram, what each step does and exchanges, its
+// decision paths and their test coverage, the environments without their
+// secrets, the network allowlists and the release history.
+package handbook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/network"
+	"hypervision_backend/internal/render"
+	"hypervision_backend/internal/variables"
+
+	"github.com/supabase-community/postgrest-go"
+)
+
+// ErrNotFound is returned when the business unit or a requested workflow
+// doesn't exist
+var ErrNotFound = errors.New("not found")
+
+// Redacted replaces secret variable values
+const Redacted = "[redacted]"
+
+// Handbook is everything that goes into the document
+type Handbook struct {
+	BusinessUnitID   string
+	BusinessUnitName string
+	Description      string
+	ClientName       string
+	Workflows        []Workflow
+	Environments     []Environment
+	CspURLs          []Allowed // loaded by the SDK in the browser or app
+	IPAddresses      []Allowed
+	APIHosts         []Allowed // called server to server
+	Generated        time.Time
+}
+
+type Workflow struct {
+	Graph         *flowgraph.Graph
+	Meta          *flowgraph.Meta
+	Diagram       *render.Diagram
+	Steps         []Step
+	Coverage      *coverage.Report
+	CoverageError string // why Coverage is missing
+	Versions      []Version
+}
+
+// Step is an SDK module or API call with its catalog documentation
+type Step struct {
+	Kind        string // "SDK module" or "API"
+	Name        string
+	Category    string
+	Description string
+	Method      string
+	URL         string
+	Inputs      []Field
+	Outputs     []Field
+}
+
+type Field struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+}
+
+type Version struct {
+	Number      string
+	Details     string
+	PublishedAt string
+	Active      bool
+}
+
+type Environment struct {
+	Name            string
+	Description     string
+	IntegrationType string
+	Variables       []Variable
+}
+
+// Variable is a resolved environment variable; Source says which level
+// (client, business unit or environment) defines it
+type Variable struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+// Allowed is an allowlist entry and the workflows that need it
+type Allowed struct {
+	Value     string
+	Workflows []string
+}
+
+// Build loads the handbook of a business unit. With workflow ids only those
+// workflows are included, in the order given.
+func Build(buId string, workflowIds []string) (*Handbook, error) {
+	bu, err := selectOne("test_business_units", "id, name, description, client_id", "id", buId)
+	if err != nil {
+		return nil, err
+	}
+	h := &Handbook{
+		BusinessUnitID:   buId,
+		BusinessUnitName: db.GetString(bu, "name"),
+		Description:      db.GetString(bu, "description"),
+		Generated:        time.Now().UTC(),
+	}
+	if client, err := selectOne("test_clients", "name", "id", db.GetString(bu, "client_id")); err == nil {
+		h.ClientName = db.GetString(client, "name")
+	}
+
+	rows, err := selectWorkflows(buId, workflowIds)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := loadDocs()
+	if err != nil {
+		return nil, err
+	}
+
+	// The allowlists come from the same report as GET /business-units/:buId/network
+	report := network.NewReport(buId)
+	for _, row := range rows {
+		wf, err := loadWorkflow(row)
+		if err != nil {
+			return nil, err
+		}
+		name := wf.Meta.WorkflowName
+		wf.Steps = docs.steps(wf.Graph)
+		wf.Diagram = render.Layout(wf.Graph, docs.modules, name, graphName(wf.Meta))
+		// One workflow whose test cases can't run shouldn't hold up the
+		// handbook of the whole business unit
+		if wf.Coverage, err = coverage.Check(wf.Graph, wf.Meta); err != nil {
+			log.Printf("handbook: coverage of workflow %s: %v", name, err)
+			wf.CoverageError = err.Error()
+		}
+		report.Add(wf.Graph, wf.Meta, docs.modules, nil)
+		h.Workflows = append(h.Workflows, wf)
+	}
+	report.Sort()
+	for _, e := range report.Entries {
+		entry := Allowed{Value: e.Address, Workflows: e.Workflows}
+		switch {
+		case e.Kind == network.KindIP:
+			h.IPAddresses = append(h.IPAddresses, entry)
+		case e.Direction == network.FromDevice:
+			entry.Value = origin(e)
+			h.CspURLs = append(h.CspURLs, entry)
+		default:
+			h.APIHosts = append(h.APIHosts, entry)
+		}
+	}
+
+	h.Environments, err = loadEnvironments(buId)
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// selectWorkflows returns the workflow rows of the business unit
+func selectWorkflows(buId string, ids []string) ([]map[string]interface{}, error) {
+	data, _, err := db.Client.
+		From("test_workflows").
+		Select("id, name, active_published_version_id", "", false).
+		Eq("business_unit_id", buId).
+		Order("created_at", &postgrest.OrderOpts{Ascending: true}).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return rows, nil
+	}
+
+	byID := make(map[string]map[string]interface{}, len(rows))
+	for _, row := range rows {
+		byID[db.GetString(row, "id")] = row
+	}
+	var out []map[string]interface{}
+	for _, id := range ids {
+		row, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("workflow %s: %w", id, ErrNotFound)
+		}
+		out = append(out, row)
+	}
+	return out, nil
+}
+
+// loadWorkflow reads the active published version of a workflow, or its
+// draft when nothing is published
+func loadWorkflow(row map[string]interface{}) (Workflow, error) {
+	id := db.GetString(row, "id")
+	active := db.GetString(row, "active_published_version_id")
+
+	g, meta, err := flowgraph.Load(flowgraph.Source{WorkflowID: id, VersionID: active})
+	if err == flowgraph.ErrNotFound && active != "" {
+		g, meta, err = flowgraph.Load(flowgraph.Source{WorkflowID: id})
+	}
+	if err != nil {
+		return Workflow{}, fmt.Errorf("workflow %s: %w", db.GetString(row, "name"), err)
+	}
+
+	versions, err := loadVersions(id, active)
+	if err != nil {
+		return Workflow{}, err
+	}
+	return Workflow{Graph: g, Meta: meta, Versions: versions}, nil
+}
+
+func loadVersions(workflowId, active string) ([]Version, error) {
+	data, _, err := db.Client.
+		From("workflow_versions").
+		Select("id, version_number, version_details, published_at", "", false).
+		Eq("workflow_id", workflowId).
+		Order("published_at", &postgrest.OrderOpts{Ascending: false}).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+
+	versions := make([]Version, len(rows))
+	for i, row := range rows {
+		versions[i] = Version{
+			Number:      db.GetString(row, "version_number"),
+			Details:     db.GetString(row, "version_details"),
+			PublishedAt: formatTime(db.GetString(row, "published_at")),
+			Active:      db.GetString(row, "id") == active,
+		}
+	}
+	return versions, nil
+}
+
+// loadEnvironments reads the environments with their effective variables.
+// Secret values are replaced before they leave this function.
+func loadEnvironments(buId string) ([]Environment, error) {
+	data, _, err := db.Client.
+		From("test_environments").
+		Select("id, name, description, integration_type, variables", "", false).
+		Eq("business_unit_id", buId).
+		Order("created_at", &postgrest.OrderOpts{Ascending: true}).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+
+	parents, _, err := variables.ParentLayers(buId)
+	if err != nil {
+		return nil, err
+	}
+
+	envs := make([]Environment, len(rows))
+	for i, row := range rows {
+		layers := append([]variables.Layer{}, parents...)
+		layers = append(layers, variables.Layer{
+			Source:    variables.SourceEnvironment,
+			SourceID:  db.GetString(row, "id"),
+			Variables: getMap(row, "variables"),
+		})
+		resolved := variables.Resolve(layers...)
+
+		env := Environment{
+			Name:            db.GetString(row, "name"),
+			Description:     db.GetString(row, "description"),
+			IntegrationType: db.GetString(row, "integration_type"),
+		}
+		for key, v := range resolved {
+			value := Redacted
+			if !variables.IsSecret(key) && !flowgraph.IsCredential(key) {
+				value = formatValue(v.Value)
+			}
+			env.Variables = append(env.Variables, Variable{Key: key, Value: value, Source: strings.ReplaceAll(v.Source, "_", " ")})
+		}
+		sort.Slice(env.Variables, func(a, b int) bool { return env.Variables[a].Key < env.Variables[b].Key })
+		envs[i] = env
+	}
+	return envs, nil
+}
+
+// origin writes a host entry as a CSP source, with its port when it isn't
+// the protocol's default
+func origin(e network.Entry) string {
+	o := e.Protocol + "://" + e.Address
+	if e.Port != 443 && e.Port != 80 {
+		o += ":" + strconv.Itoa(e.Port)
+	}
+	return o
+}
+
+// graphName says which graph of a workflow is documented
+func graphName(meta *flowgraph.Meta) string {
+	if meta.VersionNumber != "" {
+		return "Version " + meta.VersionNumber
+	}
+	return "Draft"
+}
+
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func formatTime(s string) string {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return s
+	}
+	return t.UTC().Format("2 Jan 2006 15:04 UTC")
+}
+
+func selectOne(table, columns, column, value string) (map[string]interface{}, error) {
+	if value == "" {
+		return nil, ErrNotFound
+	}
+	data, _, err := db.Client.
+		From(table).
+		Select(columns, "", false).
+		Eq(column, value).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+	return results[0], nil
+}
+
+func getMap(m map[string]interface{}, key string) map[string]interface{} {
+	switch val := m[key].(type) {
+	case map[string]interface{}:
+		return val
+	case string:
+		var out map[string]interface{}
+		if json.Unmarshal([]byte(val), &out) == nil && out != nil {
+			return out
+		}
+	}
+	return map[string]interface{}{}
+}
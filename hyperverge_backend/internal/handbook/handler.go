@@ -0,0 +1,63 @@
+package handbook
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/buaccesslinks"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/slug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler renders the handbook of a business unit
+// (GET /business-units/:buId/handbook.pdf?workflow_id=a,b)
+func Handler(c *gin.Context) {
+	buId := c.Param("buId")
+	if !auth.CanAccessBU(buId, c.GetString("userId"), false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+	write(c, buId)
+}
+
+// PublicHandler renders the handbook of a shared business unit
+// (GET /public/bu-links/:linkId/handbook.pdf?token=...)
+func PublicHandler(c *gin.Context) {
+	buId, ok := buaccesslinks.AuthorizedBusinessUnit(c)
+	if !ok {
+		return
+	}
+	write(c, buId)
+}
+
+func write(c *gin.Context, buId string) {
+	h, err := Build(buId, workflowIds(c))
+	if errors.Is(err, ErrNotFound) || errors.Is(err, flowgraph.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "business unit or workflow not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\""+slug.Make(h.BusinessUnitName, "business-unit")+"-handbook.pdf\"")
+	c.Data(http.StatusOK, "application/pdf", Write(h))
+}
+
+// workflowIds reads ?workflow_id=, repeated or comma separated
+func workflowIds(c *gin.Context) []string {
+	var ids []string
+	for _, v := range c.QueryArray("workflow_id") {
+		for _, id := range strings.Split(v, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
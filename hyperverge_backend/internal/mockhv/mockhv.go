@@ -0,0 +1,320 @@
+// Package mockhv serves the API catalog as a mock HyperVerge API, so
+// integrations can be tested offline. Every API with a curl example is
+// served at the path of its endpoint, and every API at /apis/<id>. Requests
+// are checked for the appId/appKey of an environment and for the required
+// inputs of the catalog; a scenario then picks the documented success
+// response or one of the documented errors.
+package mockhv
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ScenarioHeader overrides the scenario for one request
+const ScenarioHeader = "X-Mock-Outcome"
+
+// Credentials are the appId/appKey requests must send. Empty credentials
+// accept any non-empty appId and appKey.
+type Credentials struct {
+	AppID  string
+	AppKey string
+}
+
+// Endpoint is a catalog API as the mock serves it
+type Endpoint struct {
+	APIID  string `json:"api_id"`
+	Name   string `json:"name"`
+	Method string `json:"method"`
+	Path   string `json:"path,omitempty"` // empty for APIs without a curl example
+	Host   string `json:"host,omitempty"`
+
+	call   flowgraph.APICall
+	inputs []flowgraph.Field
+}
+
+func (e *Endpoint) keys() []string {
+	keys := []string{e.APIID, strings.ToLower(e.Name)}
+	if e.Path != "" {
+		keys = append(keys, e.Path)
+	}
+	return keys
+}
+
+// Server is the mock API
+type Server struct {
+	creds    Credentials
+	scenario Scenario
+
+	byRoute map[string]*Endpoint // method and path, with and without the host
+	byID    map[string]*Endpoint
+	list    []*Endpoint
+
+	mu    sync.Mutex
+	calls map[string]int // requests so far, by api id
+}
+
+// New builds a mock of the catalog. It fails when the scenario names an
+// API the catalog doesn't have.
+func New(apis []catalog.API, creds Credentials, sc Scenario) (*Server, error) {
+	s := &Server{
+		creds:    creds,
+		byRoute:  make(map[string]*Endpoint),
+		byID:     make(map[string]*Endpoint),
+		calls:    make(map[string]int),
+		scenario: Scenario{Default: sc.Default, APIs: make(map[string]Outcomes)},
+	}
+	for k, v := range sc.APIs {
+		if strings.HasPrefix(k, "/") {
+			s.scenario.APIs[k] = v
+		} else {
+			s.scenario.APIs[strings.ToLower(k)] = v
+		}
+	}
+
+	for i := range apis {
+		e := endpoint(&apis[i])
+		s.byID[e.APIID] = e
+		s.list = append(s.list, e)
+		if e.Path == "" {
+			continue
+		}
+		route := e.Method + " " + e.Path
+		// Regional hosts can serve the same path; /<host>/<path> tells them apart
+		if _, taken := s.byRoute[route]; !taken {
+			s.byRoute[route] = e
+		}
+		s.byRoute[e.Method+" /"+e.Host+e.Path] = e
+	}
+	sort.SliceStable(s.list, func(i, j int) bool { return s.list[i].Name < s.list[j].Name })
+
+	known := make(map[string]bool)
+	for _, e := range s.list {
+		for _, k := range e.keys() {
+			known[k] = true
+		}
+	}
+	for k := range s.scenario.APIs {
+		if !known[k] {
+			return nil, fmt.Errorf("the scenario names %q, which isn't an API id, name or path of the catalog", k)
+		}
+	}
+	return s, nil
+}
+
+func endpoint(api *catalog.API) *Endpoint {
+	// The catalog stores what a node copies; reading it as a node gives the
+	// parsed curl example, responses and errors
+	n := flowgraph.Node{Type: flowgraph.NodeAPIModule, Data: map[string]interface{}{
+		"title":            api.Name,
+		"endpoint":         api.URL,
+		"curlExample":      api.CurlExample,
+		"successResponse":  api.SuccessResponse,
+		"failureResponses": api.FailureResponses,
+		"errorDetails":     api.ErrorDetails,
+	}}
+	call := n.APICall()
+
+	e := &Endpoint{APIID: api.ID, Name: api.Name, Method: call.Method, call: call}
+	if e.Method == "" {
+		e.Method = http.MethodPost
+	}
+	if call.Curl.URL != "" {
+		if u, err := url.Parse(call.Curl.URL); err == nil {
+			e.Host = u.Host
+			e.Path = u.Path
+		}
+	}
+	// The catalog sometimes lists response fields as inputs; when the curl
+	// example shows the request, only what it sends can be required
+	shown := make(map[string]bool)
+	for _, h := range call.Curl.Headers {
+		shown[flowgraph.FieldKey(h[0])] = true
+	}
+	for _, f := range call.Curl.Form {
+		shown[flowgraph.FieldKey(f[0])] = true
+	}
+	for k := range call.Body {
+		shown[flowgraph.FieldKey(k)] = true
+	}
+	for _, f := range api.Inputs {
+		lower := strings.ToLower(f.Name)
+		if len(shown) > 0 && !shown[flowgraph.FieldKey(f.Name)] {
+			continue
+		}
+		if f.Required && !flowgraph.IsCredential(f.Name) && lower != "content-type" {
+			e.inputs = append(e.inputs, flowgraph.Field{Name: f.Name, Type: f.Type, Required: true})
+		}
+	}
+	return e
+}
+
+// Endpoints lists what the mock serves
+func (s *Server) Endpoints() []*Endpoint {
+	return s.list
+}
+
+// Register adds the mock's routes to r
+func (s *Server) Register(r *gin.Engine) {
+	r.GET("/_mock/endpoints", func(c *gin.Context) { c.JSON(http.StatusOK, s.list) })
+	r.POST("/_mock/reset", func(c *gin.Context) {
+		s.mu.Lock()
+		s.calls = make(map[string]int)
+		s.mu.Unlock()
+		c.JSON(http.StatusOK, gin.H{"message": "request counts reset"})
+	})
+	r.Any("/apis/:apiId", func(c *gin.Context) {
+		e := s.byID[c.Param("apiId")]
+		if e == nil {
+			c.JSON(http.StatusNotFound, failure(http.StatusNotFound, "no API "+c.Param("apiId")+" in the catalog"))
+			return
+		}
+		s.serve(c, e)
+	})
+	r.NoRoute(func(c *gin.Context) {
+		e := s.byRoute[c.Request.Method+" "+c.Request.URL.Path]
+		if e == nil {
+			c.JSON(http.StatusNotFound, failure(http.StatusNotFound, "no catalog API at "+c.Request.Method+" "+c.Request.URL.Path))
+			return
+		}
+		s.serve(c, e)
+	})
+}
+
+func (s *Server) serve(c *gin.Context, e *Endpoint) {
+	appID, appKey := c.GetHeader("appId"), c.GetHeader("appKey")
+	if appID == "" || appKey == "" || (s.creds.AppID != "" && appID != s.creds.AppID) || (s.creds.AppKey != "" && appKey != s.creds.AppKey) {
+		s.respond(c, e, http.StatusUnauthorized, "Missing/Invalid credentials")
+		return
+	}
+
+	sent := received(c)
+	var missing []string
+	for _, f := range e.inputs {
+		if !sent[flowgraph.FieldKey(f.Name)] {
+			missing = append(missing, f.Name)
+		}
+	}
+	if len(missing) > 0 {
+		s.respond(c, e, http.StatusBadRequest, "Missing required inputs: "+strings.Join(missing, ", "))
+		return
+	}
+
+	code := s.next(e)
+	if override := c.GetHeader(ScenarioHeader); override != "" {
+		var err error
+		if code, err = ParseOutcome(override); err != nil {
+			c.JSON(http.StatusBadRequest, failure(http.StatusBadRequest, err.Error()))
+			return
+		}
+	}
+	s.respond(c, e, code, "")
+}
+
+// next is the scenario's outcome for the next request to an endpoint
+func (s *Server) next(e *Endpoint) int {
+	s.mu.Lock()
+	n := s.calls[e.APIID]
+	s.calls[e.APIID]++
+	s.mu.Unlock()
+	return s.scenario.outcome(e, n)
+}
+
+// respond sends the documented response for a status code, or a
+// HyperVerge-shaped one when the catalog has no example
+func (s *Server) respond(c *gin.Context, e *Endpoint, code int, message string) {
+	c.Header("X-Mock-API", e.APIID)
+	if code >= 200 && code < 300 {
+		if m, ok := e.call.SuccessResponse.(map[string]interface{}); e.call.SuccessResponse != nil && (!ok || len(m) > 0) {
+			example(c, code, e.call.SuccessResponse)
+			return
+		}
+		c.JSON(code, gin.H{"status": "success", "statusCode": code, "result": gin.H{}})
+		return
+	}
+
+	if message == "" {
+		for _, er := range e.call.Errors {
+			if er.StatusCode != fmt.Sprint(code) {
+				continue
+			}
+			if er.Example != nil {
+				example(c, code, er.Example)
+				return
+			}
+			message = er.Description
+			break
+		}
+	}
+	if message == "" {
+		message = http.StatusText(code)
+	}
+	c.JSON(code, failure(code, message))
+}
+
+// example sends a documented response. Examples that aren't valid JSON,
+// often because of placeholders such as <Enter_true_or_false>, are sent as
+// they are documented.
+func example(c *gin.Context, code int, v interface{}) {
+	if s, ok := v.(string); ok {
+		c.Data(code, "application/json; charset=utf-8", []byte(s))
+		return
+	}
+	c.JSON(code, v)
+}
+
+func failure(code int, message string) gin.H {
+	return gin.H{"status": "failure", "statusCode": code, "error": message}
+}
+
+// received lists the field keys a request sent as headers, query
+// parameters, JSON body fields or form fields and files
+func received(c *gin.Context) map[string]bool {
+	sent := make(map[string]bool)
+	for k := range c.Request.Header {
+		sent[flowgraph.FieldKey(k)] = true
+	}
+	for k := range c.Request.URL.Query() {
+		sent[flowgraph.FieldKey(k)] = true
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	switch mediaType {
+	case "multipart/form-data":
+		if form, err := c.MultipartForm(); err == nil {
+			for k := range form.Value {
+				sent[flowgraph.FieldKey(k)] = true
+			}
+			for k := range form.File {
+				sent[flowgraph.FieldKey(k)] = true
+			}
+		}
+	case "application/x-www-form-urlencoded":
+		if err := c.Request.ParseForm(); err == nil {
+			for k := range c.Request.PostForm {
+				sent[flowgraph.FieldKey(k)] = true
+			}
+		}
+	default:
+		var body map[string]interface{}
+		if c.Request.Body != nil && json.NewDecoder(c.Request.Body).Decode(&body) == nil {
+			for k, v := range body {
+				if v != nil {
+					sent[flowgraph.FieldKey(k)] = true
+				}
+			}
+		}
+	}
+	return sent
+}
@@ -0,0 +1,100 @@
+package mockhv
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Success is how scenarios spell a successful response
+const Success = "success"
+
+// Outcomes are the status codes an endpoint answers with, one per request;
+// the last one repeats. 200 is success.
+type Outcomes []int
+
+// UnmarshalJSON accepts "success", a status code or a list of those
+func (o *Outcomes) UnmarshalJSON(data []byte) error {
+	var list []json.RawMessage
+	if err := json.Unmarshal(data, &list); err != nil {
+		list = []json.RawMessage{data}
+	}
+	if len(list) == 0 {
+		return fmt.Errorf("empty list of outcomes")
+	}
+	out := make(Outcomes, 0, len(list))
+	for _, raw := range list {
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		code, err := ParseOutcome(fmt.Sprint(v))
+		if err != nil {
+			return err
+		}
+		out = append(out, code)
+	}
+	*o = out
+	return nil
+}
+
+// ParseOutcome reads "success" or a status code
+func ParseOutcome(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, Success) {
+		return 200, nil
+	}
+	code, err := strconv.Atoi(s)
+	if err != nil || code < 100 || code > 599 {
+		return 0, fmt.Errorf("outcome %q must be %q or a status code", s, Success)
+	}
+	return code, nil
+}
+
+// Scenario chooses what each endpoint answers. Endpoints are keyed by the
+// catalog API's id, its name or the path it is served at.
+//
+//	{
+//	  "default": "success",
+//	  "apis": {
+//	    "/v1/readKYC": [200, 422],
+//	    "Face Match API": 440
+//	  }
+//	}
+type Scenario struct {
+	Default Outcomes            `json:"default"`
+	APIs    map[string]Outcomes `json:"apis"`
+}
+
+// LoadScenario reads a scenario file
+func LoadScenario(path string) (Scenario, error) {
+	var sc Scenario
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return sc, err
+	}
+	if err := json.Unmarshal(data, &sc); err != nil {
+		return sc, fmt.Errorf("invalid scenario %s: %v", path, err)
+	}
+	return sc, nil
+}
+
+// outcome is the status code of the nth request (from 0) to an endpoint
+func (sc Scenario) outcome(e *Endpoint, n int) int {
+	outcomes := sc.Default
+	for _, k := range e.keys() {
+		if o, ok := sc.APIs[k]; ok {
+			outcomes = o
+			break
+		}
+	}
+	if len(outcomes) == 0 {
+		return 200
+	}
+	if n >= len(outcomes) {
+		n = len(outcomes) - 1
+	}
+	return outcomes[n]
+}
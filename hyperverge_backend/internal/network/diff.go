@@ -0,0 +1,39 @@
+package network
+
+import "hypervision_backend/internal/flowgraph"
+
+// Diff is what a firewall has to change between two versions of a workflow
+type Diff struct {
+	From      *flowgraph.Meta `json:"from"`
+	To        *flowgraph.Meta `json:"to"`
+	Added     []Entry         `json:"added"`
+	Removed   []Entry         `json:"removed"`
+	Unchanged int             `json:"unchanged"`
+}
+
+// Compare lists the entries only one of the reports has. Entries are matched
+// on direction, address and port; a changed purpose or node list isn't a
+// change for the firewall.
+func Compare(from, to *Report) *Diff {
+	d := &Diff{Added: []Entry{}, Removed: []Entry{}}
+	if len(from.Workflows) > 0 {
+		d.From = from.Workflows[0]
+	}
+	if len(to.Workflows) > 0 {
+		d.To = to.Workflows[0]
+	}
+
+	for _, e := range to.Entries {
+		if _, ok := from.index[e.key()]; ok {
+			d.Unchanged++
+		} else {
+			d.Added = append(d.Added, e)
+		}
+	}
+	for _, e := range from.Entries {
+		if _, ok := to.index[e.key()]; !ok {
+			d.Removed = append(d.Removed, e)
+		}
+	}
+	return d
+}
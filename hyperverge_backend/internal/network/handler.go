@@ -0,0 +1,227 @@
+package network
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/csp"
+	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/slug"
+
+	"github.com/gin-gonic/gin"
+	"github.com/supabase-community/postgrest-go"
+)
+
+// Report formats
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// Handler reports the network requirements of a workflow
+// (GET /workflows/:id/network?format=json|csv&country=ind)
+func Handler(c *gin.Context) {
+	format, ok := readFormat(c)
+	if !ok {
+		return
+	}
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	modules, ok := loadModules(c)
+	if !ok {
+		return
+	}
+
+	r := NewReport(meta.BusinessUnitID)
+	r.Add(g, meta, modules, csp.Countries(c))
+	r.Sort()
+	write(c, format, slug.Make(meta.WorkflowName, "workflow"), r)
+}
+
+// BusinessUnitHandler reports the network requirements of every workflow of
+// a business unit, using each one's active published version or its draft
+// (GET /business-units/:buId/network?format=json|csv&country=ind)
+func BusinessUnitHandler(c *gin.Context) {
+	buId := c.Param("buId")
+	if !auth.CanAccessBU(buId, c.GetString("userId"), false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+	format, ok := readFormat(c)
+	if !ok {
+		return
+	}
+	modules, ok := loadModules(c)
+	if !ok {
+		return
+	}
+
+	data, _, err := db.Client.
+		From("test_workflows").
+		Select("id, active_published_version_id", "", false).
+		Eq("business_unit_id", buId).
+		Order("created_at", &postgrest.OrderOpts{Ascending: true}).
+		Execute()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(data, &rows); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	r := NewReport(buId)
+	selected := csp.Countries(c)
+	for _, row := range rows {
+		id := db.GetString(row, "id")
+		active := db.GetString(row, "active_published_version_id")
+		g, meta, err := flowgraph.Load(flowgraph.Source{WorkflowID: id, VersionID: active})
+		if errors.Is(err, flowgraph.ErrNotFound) && active != "" {
+			g, meta, err = flowgraph.Load(flowgraph.Source{WorkflowID: id})
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "workflow " + id + ": " + err.Error()})
+			return
+		}
+		r.Add(g, meta, modules, selected)
+	}
+	r.Sort()
+	write(c, format, "business-unit-"+buId, r)
+}
+
+// DiffHandler shows what changed in the network requirements between two
+// published versions of a workflow
+// (GET /workflows/:id/network/diff?from=<version id>&to=<version id>)
+func DiffHandler(c *gin.Context) {
+	format, ok := readFormat(c)
+	if !ok {
+		return
+	}
+	from, to := c.Query("from"), c.Query("to")
+	if from == "" || to == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "from and to version ids are required"})
+		return
+	}
+	modules, ok := loadModules(c)
+	if !ok {
+		return
+	}
+
+	var reports [2]*Report
+	for i, versionId := range []string{from, to} {
+		g, meta, err := flowgraph.Load(flowgraph.Source{WorkflowID: c.Param("id"), VersionID: versionId})
+		if errors.Is(err, flowgraph.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "workflow or version not found"})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !auth.CanAccessBU(meta.BusinessUnitID, c.GetString("userId"), false) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+			return
+		}
+		reports[i] = NewReport(meta.BusinessUnitID)
+		reports[i].Add(g, meta, modules, csp.Countries(c))
+		reports[i].Sort()
+	}
+
+	d := Compare(reports[0], reports[1])
+	if format == FormatJSON {
+		c.JSON(http.StatusOK, d)
+		return
+	}
+
+	var rows [][]string
+	for _, e := range d.Added {
+		rows = append(rows, append([]string{"added"}, record(e)...))
+	}
+	for _, e := range d.Removed {
+		rows = append(rows, append([]string{"removed"}, record(e)...))
+	}
+	name := slug.Make(d.To.WorkflowName, "workflow") + "-v" + d.From.VersionNumber + "-v" + d.To.VersionNumber + "-network-diff.csv"
+	writeCSV(c, name, append([]string{"change"}, header...), rows)
+}
+
+func readFormat(c *gin.Context) (string, bool) {
+	format := strings.ToLower(c.DefaultQuery("format", FormatJSON))
+	if format != FormatJSON && format != FormatCSV {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or csv"})
+		return "", false
+	}
+	return format, true
+}
+
+func loadModules(c *gin.Context) (catalog.ModuleIndex, bool) {
+	modules, err := catalog.LoadModuleIndex()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return modules, true
+}
+
+func write(c *gin.Context, format, name string, r *Report) {
+	if format == FormatJSON {
+		c.JSON(http.StatusOK, r)
+		return
+	}
+	rows := make([][]string, len(r.Entries))
+	for i, e := range r.Entries {
+		rows[i] = record(e)
+	}
+	writeCSV(c, name+"-network.csv", header, rows)
+}
+
+var header = []string{"kind", "address", "port", "protocol", "direction", "purpose", "workflows", "nodes"}
+
+func record(e Entry) []string {
+	return []string{
+		e.Kind,
+		e.Address,
+		strconv.Itoa(e.Port),
+		e.Protocol,
+		e.Direction,
+		strings.Join(e.Purpose, "; "),
+		strings.Join(e.Workflows, "; "),
+		strings.Join(e.Nodes, "; "),
+	}
+}
+
+func writeCSV(c *gin.Context, name string, header []string, rows [][]string) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(header)
+	for _, row := range rows {
+		for i := range row {
+			row[i] = safeCell(row[i])
+		}
+	}
+	w.WriteAll(rows)
+
+	c.Header("Content-Disposition", "attachment; filename=\""+name+"\"")
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// safeCell keeps spreadsheets from running a cell as a formula. Node and
+// workflow names are user input, so cells starting with = + - @ (or a tab
+// or carriage return) are prefixed with a quote.
+func safeCell(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
@@ -0,0 +1,211 @@
+// Package network lists the hosts and IP addresses a customer's firewall has
+// to allow for their workflows: what end-user devices reach through the SDK
+// and what the customer's backend reaches through HyperVerge APIs.
+package network
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/csp"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Directions of the traffic an entry allows
+const (
+	// FromDevice is traffic from the end user's browser or app (the SDK)
+	FromDevice = "outbound_device"
+	// FromBackend is traffic from the customer's servers
+	FromBackend = "outbound_backend"
+)
+
+// Entry kinds
+const (
+	KindHost = "hostname"
+	KindIP   = "ip"
+)
+
+// Entry is one destination to allow
+type Entry struct {
+	Kind      string   `json:"kind"`
+	Address   string   `json:"address"`
+	Port      int      `json:"port"`
+	Protocol  string   `json:"protocol"`
+	Direction string   `json:"direction"`
+	Purpose   []string `json:"purpose"`
+	Workflows []string `json:"workflows"`
+	Nodes     []string `json:"nodes,omitempty"`
+}
+
+func (e Entry) key() string {
+	return e.Direction + " " + e.Kind + " " + e.Address + ":" + strconv.Itoa(e.Port)
+}
+
+// Report is the network requirements of one or more workflows
+type Report struct {
+	BusinessUnitID string            `json:"business_unit_id"`
+	Workflows      []*flowgraph.Meta `json:"workflows"`
+	Countries      []string          `json:"countries,omitempty"`
+	Entries        []Entry           `json:"entries"`
+	Unresolved     []string          `json:"unresolved,omitempty"` // URL templates no country resolved
+
+	index map[string]int
+}
+
+func NewReport(buId string) *Report {
+	return &Report{
+		BusinessUnitID: buId,
+		Workflows:      []*flowgraph.Meta{},
+		Entries:        []Entry{},
+		index:          make(map[string]int),
+	}
+}
+
+// Add merges the requirements of a workflow graph into the report. The
+// device side comes from the workflow's CSP, so SDK hosts, regional hosts and
+// module hosts match what the CSP endpoint allows.
+func (r *Report) Add(g *flowgraph.Graph, meta *flowgraph.Meta, modules catalog.ModuleIndex, countries []string) {
+	r.Workflows = append(r.Workflows, meta)
+	name := meta.WorkflowName
+	policy := csp.Generate(g, meta, modules, countries)
+
+	device := FromDevice
+	if !policy.SDK {
+		device = FromBackend
+	}
+	for _, need := range policy.Sources {
+		kind, address, port, protocol, ok := parseSource(need.Source)
+		if !ok {
+			continue
+		}
+		r.add(Entry{Kind: kind, Address: address, Port: port, Protocol: protocol, Direction: device}, need.Reason, name, need.Nodes...)
+	}
+
+	for _, n := range g.Nodes {
+		var ips []string
+		direction, purpose := device, "Module"
+		switch n.Type {
+		case flowgraph.NodeModule, flowgraph.NodeSdkInputs:
+			ips = append(n.StringList("ipAddresses"), modules[n.Str("moduleType")].IPAddresses...)
+		case flowgraph.NodeAPIModule:
+			ips = n.StringList("ipAddresses")
+			direction, purpose = FromBackend, "API"
+			call := n.APICall()
+			if kind, address, port, protocol, ok := parseSource(call.URL); ok {
+				r.add(Entry{Kind: kind, Address: address, Port: port, Protocol: protocol, Direction: FromBackend}, "API", name, n.Label())
+			}
+		default:
+			continue
+		}
+		for _, ip := range ips {
+			if ip = strings.TrimSpace(ip); ip != "" {
+				r.add(Entry{Kind: KindIP, Address: ip, Port: 443, Protocol: "https", Direction: direction}, purpose, name, n.Label())
+			}
+		}
+	}
+
+	for _, c := range policy.Countries {
+		if !contains(r.Countries, c) {
+			r.Countries = append(r.Countries, c)
+		}
+	}
+	for _, raw := range policy.Unresolved {
+		if !contains(r.Unresolved, raw) {
+			r.Unresolved = append(r.Unresolved, raw)
+		}
+	}
+}
+
+func (r *Report) add(e Entry, purpose, workflow string, nodes ...string) {
+	key := e.key()
+	i, ok := r.index[key]
+	if !ok {
+		r.index[key] = len(r.Entries)
+		r.Entries = append(r.Entries, e)
+		i = len(r.Entries) - 1
+	}
+	entry := &r.Entries[i]
+	if purpose != "" && !contains(entry.Purpose, purpose) {
+		entry.Purpose = append(entry.Purpose, purpose)
+	}
+	if !contains(entry.Workflows, workflow) {
+		entry.Workflows = append(entry.Workflows, workflow)
+	}
+	for _, n := range nodes {
+		if n != "" && !contains(entry.Nodes, n) {
+			entry.Nodes = append(entry.Nodes, n)
+		}
+	}
+}
+
+// Sort orders the entries by direction, kind and address
+func (r *Report) Sort() {
+	sort.SliceStable(r.Entries, func(i, j int) bool {
+		a, b := r.Entries[i], r.Entries[j]
+		if a.Direction != b.Direction {
+			return a.Direction == FromDevice
+		}
+		if a.Kind != b.Kind {
+			return a.Kind == KindHost
+		}
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.Port < b.Port
+	})
+	sort.Strings(r.Unresolved)
+	for i, e := range r.Entries {
+		r.index[e.key()] = i
+	}
+}
+
+// parseSource reads a CSP source or URL as a destination. Keywords, scheme
+// sources such as blob: and unresolved templates aren't destinations.
+func parseSource(raw string) (kind, address string, port int, protocol string, ok bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "*" || strings.HasPrefix(raw, "'") || strings.HasSuffix(raw, ":") || strings.ContainsAny(raw, "{} ") {
+		return "", "", 0, "", false
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return "", "", 0, "", false
+	}
+
+	protocol = strings.ToLower(u.Scheme)
+	switch protocol {
+	case "https", "wss":
+		port = 443
+	case "http", "ws":
+		port = 80
+	default:
+		return "", "", 0, "", false
+	}
+	if p := u.Port(); p != "" {
+		if port, err = strconv.Atoi(p); err != nil {
+			return "", "", 0, "", false
+		}
+	}
+
+	address = strings.ToLower(u.Hostname())
+	kind = KindHost
+	if net.ParseIP(address) != nil {
+		kind = KindIP
+	}
+	return kind, address, port, protocol, true
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,340 @@
+// Package openapi builds an OpenAPI 3.1 document from the Go request and
+// response types used by the handlers.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Operation describes one registered route
+type Operation struct {
+	Method  string
+	Path    string // Gin-style path, e.g. /api/workflows/:id
+	Tag     string
+	Summary string
+	Public  bool // No bearer token required
+
+	// Zero values of the JSON body types; nil means no body / free-form object
+	Request  interface{}
+	Response interface{}
+
+	Status      int    // Success status, defaults to 200
+	ContentType string // Response content type when it isn't JSON
+	Query       []Param
+}
+
+// Param is a query string parameter
+type Param struct {
+	Name        string
+	Description string
+	Required    bool
+	Enum        []string
+}
+
+type Info struct {
+	Title       string
+	Version     string
+	Description string
+}
+
+var ginParam = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// PathFromGin converts /workflows/:id to /workflows/{id}
+func PathFromGin(path string) string {
+	return ginParam.ReplaceAllString(path, "{$1}")
+}
+
+// Build produces the OpenAPI document for a set of operations
+func Build(info Info, ops []Operation) map[string]interface{} {
+	g := &generator{schemas: make(map[string]interface{})}
+
+	g.schemas["Error"] = map[string]interface{}{
+		"type":     "object",
+		"required": []string{"error"},
+		"properties": map[string]interface{}{
+			"error": map[string]interface{}{"type": "string"},
+		},
+	}
+
+	paths := make(map[string]map[string]interface{})
+	tagSet := make(map[string]bool)
+
+	for _, op := range ops {
+		path := PathFromGin(op.Path)
+		if paths[path] == nil {
+			paths[path] = make(map[string]interface{})
+		}
+		paths[path][strings.ToLower(op.Method)] = g.operation(op)
+		if op.Tag != "" {
+			tagSet[op.Tag] = true
+		}
+	}
+
+	var tags []map[string]interface{}
+	var tagNames []string
+	for name := range tagSet {
+		tagNames = append(tagNames, name)
+	}
+	sort.Strings(tagNames)
+	for _, name := range tagNames {
+		tags = append(tags, map[string]interface{}{"name": name})
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.1.0",
+		"info": map[string]interface{}{
+			"title":       info.Title,
+			"version":     info.Version,
+			"description": info.Description,
+		},
+		"tags":  tags,
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": g.schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+				},
+			},
+		},
+	}
+}
+
+type generator struct {
+	schemas map[string]interface{}
+}
+
+func (g *generator) operation(op Operation) map[string]interface{} {
+	out := map[string]interface{}{
+		"operationId": operationID(op),
+		"summary":     op.Summary,
+	}
+	if op.Tag != "" {
+		out["tags"] = []string{op.Tag}
+	}
+
+	var params []map[string]interface{}
+	for _, m := range ginParam.FindAllStringSubmatch(op.Path, -1) {
+		params = append(params, map[string]interface{}{
+			"name":     m[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+	for _, q := range op.Query {
+		schema := map[string]interface{}{"type": "string"}
+		if len(q.Enum) > 0 {
+			schema["enum"] = q.Enum
+		}
+		param := map[string]interface{}{
+			"name":     q.Name,
+			"in":       "query",
+			"required": q.Required,
+			"schema":   schema,
+		}
+		if q.Description != "" {
+			param["description"] = q.Description
+		}
+		params = append(params, param)
+	}
+	if len(params) > 0 {
+		out["parameters"] = params
+	}
+
+	if op.Request != nil {
+		out["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": g.schemaFor(reflect.TypeOf(op.Request)),
+				},
+			},
+		}
+	}
+
+	status := op.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	success := map[string]interface{}{"description": http.StatusText(status)}
+	switch {
+	case op.ContentType != "":
+		success["content"] = map[string]interface{}{
+			op.ContentType: map[string]interface{}{"schema": map[string]interface{}{}},
+		}
+	case status == http.StatusNoContent:
+	default:
+		schema := map[string]interface{}{"type": "object"}
+		if op.Response != nil {
+			schema = g.schemaFor(reflect.TypeOf(op.Response))
+		}
+		success["content"] = map[string]interface{}{
+			"application/json": map[string]interface{}{"schema": schema},
+		}
+	}
+
+	out["responses"] = map[string]interface{}{
+		strconv.Itoa(status): success,
+		"default": map[string]interface{}{
+			"description": "Error",
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{"$ref": "#/components/schemas/Error"},
+				},
+			},
+		},
+	}
+
+	if op.Public {
+		out["security"] = []interface{}{}
+	} else {
+		out["security"] = []map[string][]string{{"bearerAuth": {}}}
+	}
+
+	return out
+}
+
+// schemaFor returns an inline schema, or a $ref for named struct types
+func (g *generator) schemaFor(t reflect.Type) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Ptr:
+		inner := g.schemaFor(t.Elem())
+		if typ, ok := inner["type"].(string); ok {
+			nullable := copySchema(inner)
+			nullable["type"] = []string{typ, "null"}
+			return nullable
+		}
+		return map[string]interface{}{"anyOf": []interface{}{inner, map[string]interface{}{"type": "null"}}}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]interface{}{"type": "array", "items": g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schemaFor(t.Elem())}
+	case reflect.Interface:
+		return map[string]interface{}{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		name := schemaName(t)
+		if _, ok := g.schemas[name]; !ok {
+			// Reserve the name first so recursive types terminate
+			g.schemas[name] = map[string]interface{}{}
+			g.schemas[name] = g.structSchema(t)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	}
+	return map[string]interface{}{}
+}
+
+func (g *generator) structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && name == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inner := g.structSchema(embedded)
+				for k, v := range inner["properties"].(map[string]interface{}) {
+					properties[k] = v
+				}
+				if req, ok := inner["required"].([]string); ok {
+					required = append(required, req...)
+				}
+				continue
+			}
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		properties[name] = g.schemaFor(f.Type)
+
+		if !strings.Contains(opts, "omitempty") && f.Type.Kind() != reflect.Ptr {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+	return schema
+}
+
+// schemaName qualifies the type with its package, e.g. workflows.WorkflowResponse
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	if pkg == "" {
+		return t.Name()
+	}
+	return pkg + "." + t.Name()
+}
+
+func operationID(op Operation) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(op.Method))
+	for _, part := range strings.FieldsFunc(op.Path, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.' || r == '_'
+	}) {
+		if part == "api" {
+			continue
+		}
+		if part[0] == ':' || part[0] == '*' {
+			b.WriteString("By")
+			part = part[1:]
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func copySchema(s map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(s))
+	for k, v := range s {
+		out[k] = v
+	}
+	return out
+}
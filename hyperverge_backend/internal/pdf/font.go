@@ -0,0 +1,148 @@
+package pdf
+
+import "strings"
+
+// Font is one of the standard Type 1 fonts every PDF reader has
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+	Courier
+)
+
+type fontInfo struct {
+	base   string
+	widths []int // of the printable ASCII range, in 1/1000 of the size
+}
+
+var fonts = []fontInfo{
+	Helvetica: {"Helvetica", []int{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+	}},
+	HelveticaBold: {"Helvetica-Bold", []int{
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	}},
+	Courier: {"Courier", nil},
+}
+
+func (f Font) resource() string {
+	return [...]string{"F1", "F2", "F3"}[f]
+}
+
+// winAnsi maps the typography outside Latin-1 that WinAnsiEncoding has
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92,
+	'“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// toWinAnsi converts text to the encoding of the fonts; characters the
+// fonts don't have become question marks
+func toWinAnsi(s string) []byte {
+	out := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r == '\t' || r == '\n' || r == '\r':
+			out = append(out, ' ')
+		case r >= 0x20 && r < 0x7F, r >= 0xA0 && r <= 0xFF:
+			out = append(out, byte(r))
+		default:
+			if b, ok := winAnsi[r]; ok {
+				out = append(out, b)
+			} else {
+				out = append(out, '?')
+			}
+		}
+	}
+	return out
+}
+
+// encode escapes text for a PDF string literal
+func encode(s string) string {
+	var b strings.Builder
+	for _, c := range toWinAnsi(s) {
+		switch c {
+		case '(', ')', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// Width measures text in points
+func Width(s string, f Font, size float64) float64 {
+	total := 0
+	for _, c := range toWinAnsi(s) {
+		total += charWidth(f, c)
+	}
+	return float64(total) * size / 1000
+}
+
+func charWidth(f Font, c byte) int {
+	widths := fonts[f].widths
+	switch {
+	case widths == nil:
+		return 600
+	case c >= 0x20 && c < 0x7F:
+		return widths[c-0x20]
+	case c == 0xA0:
+		return widths[0]
+	}
+	// Accented letters and typography are about as wide as a digit
+	return 556
+}
+
+// Wrap splits text into lines no wider than width. Words longer than a
+// line are broken.
+func Wrap(s string, f Font, size, width float64) []string {
+	var lines []string
+	for _, para := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		line := ""
+		for _, word := range strings.Fields(para) {
+			next := word
+			if line != "" {
+				next = line + " " + word
+			}
+			if Width(next, f, size) <= width {
+				line = next
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			for Width(word, f, size) > width {
+				cut := fitRunes(word, f, size, width)
+				lines = append(lines, word[:cut])
+				word = word[cut:]
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// fitRunes returns how many bytes of s fit in width, at least one rune
+func fitRunes(s string, f Font, size, width float64) int {
+	end := 0
+	for i, r := range s {
+		if i > 0 && Width(s[:i+len(string(r))], f, size) > width {
+			break
+		}
+		end = i + len(string(r))
+	}
+	return end
+}
@@ -0,0 +1,281 @@
+// Package pdf writes simple PDF documents with the standard library only:
+// pages of vector shapes and text in the built-in Helvetica and Courier
+// fonts. Coordinates are in points from the top-left corner of the page,
+// like SVG, and are flipped when the page is written.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Page sizes in points
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+type Point struct {
+	X, Y float64
+}
+
+// Style says how a shape is painted. Colours are #RRGGBB; an empty fill or
+// stroke isn't painted.
+type Style struct {
+	Fill   string
+	Stroke string
+	Width  float64
+	Dash   []float64
+}
+
+// Document is a PDF under construction
+type Document struct {
+	Title   string
+	Subject string
+	Pages   []*Page
+	Created time.Time
+}
+
+// Page is one page; drawing appends to its content stream
+type Page struct {
+	Width, Height float64
+	content       bytes.Buffer
+}
+
+func New(title string) *Document {
+	return &Document{Title: title, Created: time.Now()}
+}
+
+// AddPage appends a page of the given size
+func (d *Document) AddPage(width, height float64) *Page {
+	p := &Page{Width: width, Height: height}
+	d.Pages = append(d.Pages, p)
+	return p
+}
+
+// Rect draws a rectangle with corners rounded by r
+func (p *Page) Rect(x, y, w, h, r float64, s Style) {
+	if !p.begin(s) {
+		return
+	}
+	if r <= 0 {
+		fmt.Fprintf(&p.content, "%s %s %s %s re\n", num(x), num(p.Height-y-h), num(w), num(h))
+	} else {
+		r = min(r, w/2, h/2)
+		// Control points of a quarter circle
+		k := r * 0.5523
+		p.move(x+r, y)
+		p.line(x+w-r, y)
+		p.curve(Point{x + w - r + k, y}, Point{x + w, y + r - k}, Point{x + w, y + r})
+		p.line(x+w, y+h-r)
+		p.curve(Point{x + w, y + h - r + k}, Point{x + w - r + k, y + h}, Point{x + w - r, y + h})
+		p.line(x+r, y+h)
+		p.curve(Point{x + r - k, y + h}, Point{x, y + h - r + k}, Point{x, y + h - r})
+		p.line(x, y+r)
+		p.curve(Point{x, y + r - k}, Point{x + r - k, y}, Point{x + r, y})
+		p.content.WriteString("h\n")
+	}
+	p.end(s)
+}
+
+// Circle draws a circle around (cx, cy)
+func (p *Page) Circle(cx, cy, r float64, s Style) {
+	if !p.begin(s) {
+		return
+	}
+	k := r * 0.5523
+	p.move(cx+r, cy)
+	p.curve(Point{cx + r, cy + k}, Point{cx + k, cy + r}, Point{cx, cy + r})
+	p.curve(Point{cx - k, cy + r}, Point{cx - r, cy + k}, Point{cx - r, cy})
+	p.curve(Point{cx - r, cy - k}, Point{cx - k, cy - r}, Point{cx, cy - r})
+	p.curve(Point{cx + k, cy - r}, Point{cx + r, cy - k}, Point{cx + r, cy})
+	p.content.WriteString("h\n")
+	p.end(s)
+}
+
+// Polygon draws a closed shape
+func (p *Page) Polygon(pts []Point, s Style) {
+	if len(pts) < 2 || !p.begin(s) {
+		return
+	}
+	p.move(pts[0].X, pts[0].Y)
+	for _, pt := range pts[1:] {
+		p.line(pt.X, pt.Y)
+	}
+	p.content.WriteString("h\n")
+	p.end(s)
+}
+
+// Polyline strokes an open path; the fill of the style is ignored
+func (p *Page) Polyline(pts []Point, s Style) {
+	s.Fill = ""
+	if len(pts) < 2 || !p.begin(s) {
+		return
+	}
+	p.move(pts[0].X, pts[0].Y)
+	for _, pt := range pts[1:] {
+		p.line(pt.X, pt.Y)
+	}
+	p.end(s)
+}
+
+// Text draws one line of text with its baseline at y
+func (p *Page) Text(x, y float64, text string, f Font, size float64, color string) {
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(&p.content, "BT %s rg /%s %s Tf %s %s Td (%s) Tj ET\n",
+		rgb(color), f.resource(), num(size), num(x), num(p.Height-y), encode(text))
+}
+
+// begin saves the graphics state and sets up the style. It returns false
+// when there's nothing to paint.
+func (p *Page) begin(s Style) bool {
+	stroke := s.Stroke != "" && s.Width > 0
+	if s.Fill == "" && !stroke {
+		return false
+	}
+	p.content.WriteString("q\n")
+	if s.Fill != "" {
+		fmt.Fprintf(&p.content, "%s rg\n", rgb(s.Fill))
+	}
+	if stroke {
+		fmt.Fprintf(&p.content, "%s RG %s w 1 j 1 J\n", rgb(s.Stroke), num(s.Width))
+		if len(s.Dash) > 0 {
+			dash := make([]string, len(s.Dash))
+			for i, d := range s.Dash {
+				dash[i] = num(d)
+			}
+			fmt.Fprintf(&p.content, "[%s] 0 d\n", strings.Join(dash, " "))
+		}
+	}
+	return true
+}
+
+// end paints the current path and restores the graphics state
+func (p *Page) end(s Style) {
+	stroke := s.Stroke != "" && s.Width > 0
+	switch {
+	case s.Fill != "" && stroke:
+		p.content.WriteString("B\n")
+	case s.Fill != "":
+		p.content.WriteString("f\n")
+	default:
+		p.content.WriteString("S\n")
+	}
+	p.content.WriteString("Q\n")
+}
+
+func (p *Page) move(x, y float64) {
+	fmt.Fprintf(&p.content, "%s %s m\n", num(x), num(p.Height-y))
+}
+
+func (p *Page) line(x, y float64) {
+	fmt.Fprintf(&p.content, "%s %s l\n", num(x), num(p.Height-y))
+}
+
+func (p *Page) curve(c1, c2, to Point) {
+	fmt.Fprintf(&p.content, "%s %s %s %s %s %s c\n",
+		num(c1.X), num(p.Height-c1.Y), num(c2.X), num(p.Height-c2.Y), num(to.X), num(p.Height-to.Y))
+}
+
+// Bytes serialises the document
+func (d *Document) Bytes() []byte {
+	w := &writer{}
+	w.buf.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	// Object numbers: 1 catalog, 2 page tree, 3 info, then the fonts, then
+	// a page and its content stream for every page
+	const catalog, tree, info = 1, 2, 3
+	fontBase := 4
+	pageBase := fontBase + len(fonts)
+
+	w.object(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", tree))
+
+	kids := make([]string, len(d.Pages))
+	for i := range d.Pages {
+		kids[i] = fmt.Sprintf("%d 0 R", pageBase+2*i)
+	}
+	w.object(tree, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.Pages)))
+
+	w.object(info, fmt.Sprintf("<< /Title (%s) /Subject (%s) /Producer (HyperFlow) /CreationDate (D:%s) >>",
+		encode(d.Title), encode(d.Subject), d.Created.UTC().Format("20060102150405Z")))
+
+	var resources []string
+	for i, f := range fonts {
+		w.object(fontBase+i, fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", f.base))
+		resources = append(resources, fmt.Sprintf("/%s %d 0 R", Font(i).resource(), fontBase+i))
+	}
+
+	for i, p := range d.Pages {
+		n := pageBase + 2*i
+		w.object(n, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			tree, num(p.Width), num(p.Height), strings.Join(resources, " "), n+1))
+		w.stream(n+1, p.content.Bytes())
+	}
+
+	return w.finish(info)
+}
+
+type writer struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (w *writer) object(n int, body string) {
+	w.mark(n)
+	fmt.Fprintf(&w.buf, "%d 0 obj\n%s\nendobj\n", n, body)
+}
+
+func (w *writer) stream(n int, data []byte) {
+	var z bytes.Buffer
+	zw := zlib.NewWriter(&z)
+	zw.Write(data)
+	zw.Close()
+
+	w.mark(n)
+	fmt.Fprintf(&w.buf, "%d 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", n, z.Len())
+	w.buf.Write(z.Bytes())
+	w.buf.WriteString("\nendstream\nendobj\n")
+}
+
+func (w *writer) mark(n int) {
+	for len(w.offsets) <= n {
+		w.offsets = append(w.offsets, 0)
+	}
+	w.offsets[n] = w.buf.Len()
+}
+
+// finish writes the cross-reference table and trailer
+func (w *writer) finish(info int) []byte {
+	start := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets))
+	for _, off := range w.offsets[1:] {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets), info, start)
+	return w.buf.Bytes()
+}
+
+// rgb turns #RRGGBB into PDF colour components
+func rgb(hex string) string {
+	v, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil {
+		v = 0
+	}
+	return num(float64(v>>16&0xFF)/255) + " " + num(float64(v>>8&0xFF)/255) + " " + num(float64(v&0xFF)/255)
+}
+
+// num prints a number with at most three decimals
+func num(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 3, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "" || s == "-" || s == "-0" {
+		return "0"
+	}
+	return s
+}
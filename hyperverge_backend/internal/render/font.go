@@ -0,0 +1,129 @@
+package render
+
+import "strings"
+
+// A 5x8 bitmap font for printable ASCII, used to draw text into PNGs. Each
+// glyph is eight rows of five pixels; the baseline is under row seven and
+// the eighth row holds descenders. Cells are six pixels wide.
+const (
+	glyphCols  = 5
+	glyphCell  = 6
+	glyphAbove = 7 // rows above the baseline
+)
+
+var glyphs = map[rune]string{
+	' ':  "..... ..... ..... ..... ..... ..... ..... .....",
+	'!':  "..#.. ..#.. ..#.. ..#.. ..#.. ..... ..#.. .....",
+	'"':  ".#.#. .#.#. .#.#. ..... ..... ..... ..... .....",
+	'#':  ".#.#. .#.#. ##### .#.#. ##### .#.#. .#.#. .....",
+	'$':  "..#.. .#### #.#.. .###. ..#.# ####. ..#.. .....",
+	'%':  "##... ##..# ...#. ..#.. .#... #..## ...## .....",
+	'&':  ".##.. #..#. #.#.. .#... #.#.# #..#. .##.# .....",
+	'\'': "..#.. ..#.. ..#.. ..... ..... ..... ..... .....",
+	'(':  "...#. ..#.. .#... .#... .#... ..#.. ...#. .....",
+	')':  ".#... ..#.. ...#. ...#. ...#. ..#.. .#... .....",
+	'*':  "..... ..#.. #.#.# .###. #.#.# ..#.. ..... .....",
+	'+':  "..... ..#.. ..#.. ##### ..#.. ..#.. ..... .....",
+	',':  "..... ..... ..... ..... ..... .##.. ..#.. .#...",
+	'-':  "..... ..... ..... ##### ..... ..... ..... .....",
+	'.':  "..... ..... ..... ..... ..... .##.. .##.. .....",
+	'/':  "..... ....# ...#. ..#.. .#... #.... ..... .....",
+	'0':  ".###. #...# #..## #.#.# ##..# #...# .###. .....",
+	'1':  "..#.. .##.. ..#.. ..#.. ..#.. ..#.. .###. .....",
+	'2':  ".###. #...# ....# ...#. ..#.. .#... ##### .....",
+	'3':  "##### ...#. ..#.. ...#. ....# #...# .###. .....",
+	'4':  "...#. ..##. .#.#. #..#. ##### ...#. ...#. .....",
+	'5':  "##### #.... ####. ....# ....# #...# .###. .....",
+	'6':  "..##. .#... #.... ####. #...# #...# .###. .....",
+	'7':  "##### ....# ...#. ..#.. .#... .#... .#... .....",
+	'8':  ".###. #...# #...# .###. #...# #...# .###. .....",
+	'9':  ".###. #...# #...# .#### ....# ...#. .##.. .....",
+	':':  "..... .##.. .##.. ..... .##.. .##.. ..... .....",
+	';':  "..... .##.. .##.. ..... .##.. ..#.. .#... .....",
+	'<':  "...#. ..#.. .#... #.... .#... ..#.. ...#. .....",
+	'=':  "..... ..... ##### ..... ##### ..... ..... .....",
+	'>':  ".#... ..#.. ...#. ....# ...#. ..#.. .#... .....",
+	'?':  ".###. #...# ....# ...#. ..#.. ..... ..#.. .....",
+	'@':  ".###. #...# ....# .##.# #.#.# #.#.# .###. .....",
+	'A':  ".###. #...# #...# ##### #...# #...# #...# .....",
+	'B':  "####. #...# #...# ####. #...# #...# ####. .....",
+	'C':  ".###. #...# #.... #.... #.... #...# .###. .....",
+	'D':  "###.. #..#. #...# #...# #...# #..#. ###.. .....",
+	'E':  "##### #.... #.... ####. #.... #.... ##### .....",
+	'F':  "##### #.... #.... ####. #.... #.... #.... .....",
+	'G':  ".###. #...# #.... #.### #...# #...# .#### .....",
+	'H':  "#...# #...# #...# ##### #...# #...# #...# .....",
+	'I':  ".###. ..#.. ..#.. ..#.. ..#.. ..#.. .###. .....",
+	'J':  "..### ...#. ...#. ...#. ...#. #..#. .##.. .....",
+	'K':  "#...# #..#. #.#.. ##... #.#.. #..#. #...# .....",
+	'L':  "#.... #.... #.... #.... #.... #.... ##### .....",
+	'M':  "#...# ##.## #.#.# #.#.# #...# #...# #...# .....",
+	'N':  "#...# #...# ##..# #.#.# #..## #...# #...# .....",
+	'O':  ".###. #...# #...# #...# #...# #...# .###. .....",
+	'P':  "####. #...# #...# ####. #.... #.... #.... .....",
+	'Q':  ".###. #...# #...# #...# #.#.# #..#. .##.# .....",
+	'R':  "####. #...# #...# ####. #.#.. #..#. #...# .....",
+	'S':  ".#### #.... #.... .###. ....# ....# ####. .....",
+	'T':  "##### ..#.. ..#.. ..#.. ..#.. ..#.. ..#.. .....",
+	'U':  "#...# #...# #...# #...# #...# #...# .###. .....",
+	'V':  "#...# #...# #...# #...# #...# .#.#. ..#.. .....",
+	'W':  "#...# #...# #...# #.#.# #.#.# #.#.# .#.#. .....",
+	'X':  "#...# #...# .#.#. ..#.. .#.#. #...# #...# .....",
+	'Y':  "#...# #...# .#.#. ..#.. ..#.. ..#.. ..#.. .....",
+	'Z':  "##### ....# ...#. ..#.. .#... #.... ##### .....",
+	'[':  ".###. .#... .#... .#... .#... .#... .###. .....",
+	'\\': "..... #.... .#... ..#.. ...#. ....# ..... .....",
+	']':  ".###. ...#. ...#. ...#. ...#. ...#. .###. .....",
+	'^':  "..#.. .#.#. #...# ..... ..... ..... ..... .....",
+	'_':  "..... ..... ..... ..... ..... ..... ..... #####",
+	'`':  ".#... ..#.. ...#. ..... ..... ..... ..... .....",
+	'a':  "..... ..... .###. ....# .#### #...# .#### .....",
+	'b':  "#.... #.... #.##. ##..# #...# #...# ####. .....",
+	'c':  "..... ..... .###. #.... #.... #...# .###. .....",
+	'd':  "....# ....# .##.# #..## #...# #...# .#### .....",
+	'e':  "..... ..... .###. #...# ##### #.... .###. .....",
+	'f':  "..##. .#..# .#... ###.. .#... .#... .#... .....",
+	'g':  "..... ..... .#### #...# #...# .#### ....# .###.",
+	'h':  "#.... #.... #.##. ##..# #...# #...# #...# .....",
+	'i':  "..#.. ..... .##.. ..#.. ..#.. ..#.. .###. .....",
+	'j':  "...#. ..... ..##. ...#. ...#. ...#. #..#. .##..",
+	'k':  "#.... #.... #..#. #.#.. ##... #.#.. #..#. .....",
+	'l':  ".##.. ..#.. ..#.. ..#.. ..#.. ..#.. .###. .....",
+	'm':  "..... ..... ##.#. #.#.# #.#.# #...# #...# .....",
+	'n':  "..... ..... #.##. ##..# #...# #...# #...# .....",
+	'o':  "..... ..... .###. #...# #...# #...# .###. .....",
+	'p':  "..... ..... ####. #...# #...# ####. #.... #....",
+	'q':  "..... ..... .##.# #..## #...# .#### ....# ....#",
+	'r':  "..... ..... #.##. ##..# #.... #.... #.... .....",
+	's':  "..... ..... .###. #.... .###. ....# ####. .....",
+	't':  ".#... .#... ###.. .#... .#... .#..# ..##. .....",
+	'u':  "..... ..... #...# #...# #...# #..## .##.# .....",
+	'v':  "..... ..... #...# #...# #...# .#.#. ..#.. .....",
+	'w':  "..... ..... #...# #...# #.#.# #.#.# .#.#. .....",
+	'x':  "..... ..... #...# .#.#. ..#.. .#.#. #...# .....",
+	'y':  "..... ..... #...# #...# #...# .#### ....# .###.",
+	'z':  "..... ..... ##### ...#. ..#.. .#... ##### .....",
+	'{':  "...#. ..#.. ..#.. .#... ..#.. ..#.. ...#. .....",
+	'|':  "..#.. ..#.. ..#.. ..#.. ..#.. ..#.. ..#.. .....",
+	'}':  ".#... ..#.. ..#.. ...#. ..#.. ..#.. .#... .....",
+	'~':  "..... ..... .#... #.#.# ...#. ..... ..... .....",
+}
+
+// lookalikes stand in for common typography outside ASCII
+var lookalikes = map[rune]rune{
+	'–': '-', '—': '-', '‘': '\'', '’': '\'', '“': '"', '”': '"',
+	'·': '|', '•': '*', '→': '>', '←': '<', '\u00a0': ' ',
+}
+
+// glyph returns the rows of a character; anything else outside printable
+// ASCII is drawn as a question mark
+func glyph(r rune) []string {
+	if alt, ok := lookalikes[r]; ok {
+		r = alt
+	}
+	g, ok := glyphs[r]
+	if !ok {
+		g = glyphs['?']
+	}
+	return strings.Fields(g)
+}
@@ -0,0 +1,120 @@
+package render
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hypervision_backend/internal/buaccesslinks"
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/slug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SVGHandler renders a workflow as SVG (GET /workflows/:id/render.svg)
+func SVGHandler(c *gin.Context) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	writeSVG(c, g, meta)
+}
+
+// PNGHandler renders a workflow as PNG (GET /workflows/:id/render.png?scale=2)
+func PNGHandler(c *gin.Context) {
+	scale, ok := parseScale(c)
+	if !ok {
+		return
+	}
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	writePNG(c, g, meta, scale)
+}
+
+// PublicSVG renders a workflow of a shared business unit as SVG
+// (GET /public/bu-links/:linkId/workflows/:id/render.svg?token=...)
+func PublicSVG(c *gin.Context) {
+	g, meta, ok := publicGraph(c)
+	if !ok {
+		return
+	}
+	writeSVG(c, g, meta)
+}
+
+// PublicPNG renders a workflow of a shared business unit as PNG
+// (GET /public/bu-links/:linkId/workflows/:id/render.png?token=...)
+func PublicPNG(c *gin.Context) {
+	scale, ok := parseScale(c)
+	if !ok {
+		return
+	}
+	g, meta, ok := publicGraph(c)
+	if !ok {
+		return
+	}
+	writePNG(c, g, meta, scale)
+}
+
+func publicGraph(c *gin.Context) (*flowgraph.Graph, *flowgraph.Meta, bool) {
+	buId, ok := buaccesslinks.AuthorizedBusinessUnit(c)
+	if !ok {
+		return nil, nil, false
+	}
+	return flowgraph.FromPublicRequest(c, buId)
+}
+
+// Draw lays out a workflow with the module catalog. A catalog that can't be
+// read only costs the module colours, so it doesn't fail the render.
+func Draw(g *flowgraph.Graph, meta *flowgraph.Meta) *Diagram {
+	modules, err := catalog.LoadModuleIndex()
+	if err != nil {
+		log.Printf("render: module catalog unavailable: %v", err)
+	}
+	return Layout(g, modules, meta.WorkflowName, subtitle(meta))
+}
+
+func writeSVG(c *gin.Context, g *flowgraph.Graph, meta *flowgraph.Meta) {
+	out := SVG(Draw(g, meta))
+	c.Header("Content-Disposition", "inline; filename=\""+slug.Make(meta.WorkflowName, "workflow")+".svg\"")
+	c.Data(http.StatusOK, "image/svg+xml", out)
+}
+
+func writePNG(c *gin.Context, g *flowgraph.Graph, meta *flowgraph.Meta, scale float64) {
+	out, err := PNG(Draw(g, meta), scale)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.Header("Content-Disposition", "inline; filename=\""+slug.Make(meta.WorkflowName, "workflow")+".png\"")
+	c.Data(http.StatusOK, "image/png", out)
+}
+
+func parseScale(c *gin.Context) (float64, bool) {
+	raw := c.DefaultQuery("scale", "2")
+	scale, err := strconv.ParseFloat(raw, 64)
+	if err != nil || scale < 0.5 || scale > 4 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "scale must be a number between 0.5 and 4"})
+		return 0, false
+	}
+	return scale, true
+}
+
+// subtitle says which graph was drawn
+func subtitle(meta *flowgraph.Meta) string {
+	var parts []string
+	switch {
+	case meta.VersionNumber != "":
+		parts = append(parts, "Version "+meta.VersionNumber)
+	case meta.Origin == flowgraph.OriginDraft:
+		parts = append(parts, "Draft")
+	}
+	if meta.EnvironmentName != "" {
+		parts = append(parts, meta.EnvironmentName+" environment")
+	}
+	return strings.Join(parts, " | ")
+}
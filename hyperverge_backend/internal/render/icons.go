@@ -0,0 +1,45 @@
+package render
+
+// Icons of the module palette (frontend/src/shared/components/ModuleIcon.tsx):
+// SVG markup on a 24x24 grid, stroked with the node colour.
+var icons = map[string]icon{
+	"id-card-validation":           {stroke: 1.5, markup: `<rect x="3" y="6" width="18" height="12" rx="2" /><circle cx="8.5" cy="11" r="2" /><path d="M14 10h4" /><path d="M14 14h4" />`},
+	"aml-search":                   {stroke: 1.5, markup: `<circle cx="11" cy="11" r="8" /><path d="M21 21l-4.35-4.35" /><path d="M11 8v6" /><path d="M8 11h6" />`},
+	"selfie-liveness":              {stroke: 1.5, markup: `<rect x="5" y="2" width="14" height="20" rx="2" /><circle cx="12" cy="10" r="3" /><path d="M8 18h8" />`},
+	"face-match":                   {stroke: 1.5, markup: `<circle cx="12" cy="8" r="5" /><path d="M20 21a8 8 0 0 0-16 0" /><circle cx="9" cy="8" r="1" /><circle cx="15" cy="8" r="1" />`},
+	"field-match":                  {stroke: 1.5, markup: `<path d="M14 2H6a2 2 0 0 0-2 2v16a2 2 0 0 0 2 2h12a2 2 0 0 0 2-2V8z" /><polyline points="14 2 14 8 20 8" /><line x1="9" y1="13" x2="15" y2="13" /><line x1="9" y1="17" x2="15" y2="17" />`},
+	"bank-account-verification":    {stroke: 1.5, markup: `<path d="M3 9l9-7 9 7v11a2 2 0 0 1-2 2H5a2 2 0 0 1-2-2z" /><polyline points="9 22 9 12 15 12 15 22" /><path d="M7 9h10" />`},
+	"nominee-collection":           {stroke: 1.5, markup: `<path d="M17 21v-2a4 4 0 0 0-4-4H5a4 4 0 0 0-4 4v2" /><circle cx="9" cy="7" r="4" /><path d="M23 21v-2a4 4 0 0 0-3-3.87" /><path d="M16 3.13a4 4 0 0 1 0 7.75" />`},
+	"geo-ip":                       {stroke: 1.5, markup: `<circle cx="12" cy="12" r="10" /><line x1="2" y1="12" x2="22" y2="12" /><path d="M12 2a15.3 15.3 0 0 1 4 10 15.3 15.3 0 0 1-4 10 15.3 15.3 0 0 1-4-10 15.3 15.3 0 0 1 4-10z" />`},
+	"nsdl-pan-verification":        {stroke: 1.5, markup: `<rect x="3" y="4" width="18" height="16" rx="2" /><path d="M7 8h10" /><path d="M7 12h10" /><path d="M7 16h6" />`},
+	"account-aggregator":           {stroke: 1.5, markup: `<line x1="12" y1="1" x2="12" y2="23" /><path d="M17 5H9.5a3.5 3.5 0 0 0 0 7h5a3.5 3.5 0 0 1 0 7H6" />`},
+	"email-mobile-verification":    {stroke: 1.5, markup: `<path d="M4 4h16c1.1 0 2 .9 2 2v12c0 1.1-.9 2-2 2H4c-1.1 0-2-.9-2-2V6c0-1.1.9-2 2-2z" /><polyline points="22,6 12,13 2,6" />`},
+	"driving-license-verification": {stroke: 1.5, markup: `<path d="M5 17H4a2 2 0 01-2-2V7c0-1.1.9-2 2-2h16a2 2 0 012 2v8a2 2 0 01-2 2h-1" /><path d="M16 19h3a1 1 0 001-1v-3H12v3a1 1 0 001 1h3z" /><circle cx="7" cy="11" r="2" />`},
+	"voter-id-verification":        {stroke: 1.5, markup: `<path d="M9 11H6l4-6 4 6h-3v4h-2v-4z" /><rect x="4" y="14" width="16" height="6" rx="1" /><circle cx="8" cy="17" r="1" />`},
+	"passport-verification":        {stroke: 1.5, markup: `<path d="M2 3h6a4 4 0 0 1 4 4v14a3 3 0 0 0-3-3H2z" /><path d="M22 3h-6a4 4 0 0 0-4 4v14a3 3 0 0 1 3-3h7z" /><circle cx="8" cy="9" r="2" />`},
+	"cheque-ocr":                   {stroke: 1.5, markup: `<path d="M14 2H6a2 2 0 0 0-2 2v16a2 2 0 0 0 2 2h12a2 2 0 0 0 2-2V8z" /><polyline points="14 2 14 8 20 8" /><line x1="9" y1="13" x2="15" y2="13" />`},
+	"mask-aadhaar":                 {stroke: 1.5, markup: `<rect x="3" y="11" width="18" height="11" rx="2" ry="2" /><circle cx="12" cy="16" r="1" /><path d="M7 11V7a5 5 0 0 1 10 0v4" />`},
+	"ckyc-validation":              {stroke: 1.5, markup: `<polyline points="20 6 9 17 4 12" /><circle cx="12" cy="12" r="10" />`},
+	"penny-drop":                   {stroke: 1.5, markup: `<circle cx="12" cy="12" r="10" /><line x1="12" y1="6" x2="12" y2="10" /><line x1="12" y1="14" x2="12" y2="18" />`},
+	"passbook-ocr":                 {stroke: 1.5, markup: `<path d="M2 3h6a4 4 0 0 1 4 4v14a3 3 0 0 0-3-3H2z" /><path d="M22 3h-6a4 4 0 0 0-4 4v14a3 3 0 0 1 3-3h7z" />`},
+	"ckyc-upload":                  {stroke: 1.5, markup: `<path d="M21 15v4a2 2 0 0 1-2 2H5a2 2 0 0 1-2-2v-4" /><polyline points="7 10 12 15 17 10" /><line x1="12" y1="15" x2="12" y2="3" />`},
+	"ckyc-search-download":         {stroke: 1.5, markup: `<circle cx="11" cy="11" r="8" /><path d="M21 21l-4.35-4.35" /><path d="M21 9v4a2 2 0 01-2 2h-4" />`},
+	"digilocker-consent":           {stroke: 1.5, markup: `<path d="M10 13a5 5 0 0 0 7.54.54l3-3a5 5 0 0 0-7.07-7.07l-1.72 1.71" /><rect x="5" y="2" width="14" height="20" rx="2" />`},
+	"digilocker-fetch-documents":   {stroke: 1.5, markup: `<rect x="5" y="2" width="14" height="20" rx="2" /><path d="M14 2H6a2 2 0 0 0-2 2v16a2 2 0 0 0 2 2h12a2 2 0 0 0 2-2V8z" />`},
+	"reverse-penny-drop":           {stroke: 1.5, markup: `<circle cx="12" cy="12" r="10" /><polyline points="8 12 12 8 16 12" /><line x1="12" y1="8" x2="12" y2="16" />`},
+	"pennyless-verification":       {stroke: 1.5, markup: `<path d="M3 9l9-7 9 7v11a2 2 0 0 1-2 2H5a2 2 0 0 1-2-2z" /><polyline points="20 6 9 17 4 12" />`},
+	"crime-detection":              {stroke: 1.5, markup: `<path d="M10.29 3.86L1.82 18a2 2 0 0 0 1.71 3h16.94a2 2 0 0 0 1.71-3L13.71 3.86a2 2 0 0 0-3.42 0z" /><line x1="12" y1="9" x2="12" y2="13" /><line x1="12" y1="17" x2="12.01" y2="17" />`},
+	"criminal-risk-verification":   {stroke: 1.5, markup: `<path d="M16 16v1a2 2 0 01-2 2H6a2 2 0 01-2-2V7a2 2 0 012-2h2" /><rect x="8" y="3" width="8" height="4" rx="1" /><path d="M8 11h.01" /><path d="M12 11h.01" /><path d="M16 11h.01" />`},
+	"efir-verification":            {stroke: 1.5, markup: `<path d="M14 2H6a2 2 0 0 0-2 2v16a2 2 0 0 0 2 2h12a2 2 0 0 0 2-2V8z" /><polyline points="14 2 14 8 20 8" /><path d="M16 13H8" /><path d="M16 17H8" /><polyline points="10 9 9 9 8 9" />`},
+	"deduplication":                {stroke: 1.5, markup: `<path d="M17 21v-2a4 4 0 0 0-4-4H5a4 4 0 0 0-4 4v2" /><circle cx="9" cy="7" r="4" /><circle cx="19" cy="7" r="2" /><path d="M14 19h6" />`},
+	"condition":                    {stroke: 1.5, markup: `<path d="M12 2 L22 12 L12 22 L2 12 Z" /><path d="M12 8 L12 12" /><circle cx="12" cy="16" r="0.5" fill="currentColor" />`},
+	"api-module":                   {stroke: 1.5, markup: `<path d="M10 13a5 5 0 0 0 7.54.54l3-3a5 5 0 0 0-7.07-7.07l-1.72 1.71" /><path d="M14 11a5 5 0 0 0-7.54-.54l-3 3a5 5 0 0 0 7.07 7.07l1.71-1.71" />`},
+	"user":                         {stroke: 2, markup: `<path d="M20 21v-2a4 4 0 0 0-4-4H8a4 4 0 0 0-4 4v2" /><circle cx="12" cy="7" r="4" />`},
+	"document":                     {stroke: 2, markup: `<path d="M14 2H6a2 2 0 0 0-2 2v16a2 2 0 0 0 2 2h12a2 2 0 0 0 2-2V8z" /><polyline points="14,2 14,8 20,8" /><line x1="16" y1="13" x2="8" y2="13" /><line x1="16" y1="17" x2="8" y2="17" /><polyline points="10,9 9,9 8,9" />`},
+	"eye":                          {stroke: 2, markup: `<path d="M1 12s4-8 11-8 11 8 11 8-4 8-11 8-11-8-11-8z" /><circle cx="12" cy="12" r="3" />`},
+	"location":                     {stroke: 2, markup: `<path d="M21 10c0 7-9 13-9 13s-9-6-9-13a9 9 0 0 1 18 0z" /><circle cx="12" cy="10" r="3" />`},
+	"module":                       {stroke: 2, markup: `<rect x="3" y="3" width="7" height="7" /><rect x="14" y="3" width="7" height="7" /><rect x="14" y="14" width="7" height="7" /><rect x="3" y="14" width="7" height="7" />`},
+}
+
+// defaultIcon is drawn for icon keys the palette doesn't know
+var defaultIcon = icon{stroke: 1.5, markup: `<circle cx="12" cy="12" r="10" /><line x1="12" y1="8" x2="12" y2="12" /><line x1="12" y1="16" x2="12.01" y2="16" />`}
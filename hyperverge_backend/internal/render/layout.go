@@ -0,0 +1,471 @@
+package render
+
+import (
+	"math"
+	"sort"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Spacing, in SVG user units
+const (
+	headerHeight = 64
+	laneHeader   = 36
+	lanePadding  = 28
+	minLaneWidth = 260
+	layerGap     = 64
+	nodeGap      = 36
+	dummyWidth   = 16
+	gutterStep   = 14
+	margin       = 24
+)
+
+// item is a box or a bend point of a long edge in a layer
+type item struct {
+	box   *Box // nil for dummies
+	id    string
+	lane  string
+	layer int
+	order float64
+	x, w  float64 // x is the centre
+	h     float64
+	up    []*item // neighbours in the layer above
+	down  []*item // neighbours in the layer below
+}
+
+type edge struct {
+	from, to string
+	kind     string
+	label    string
+	back     bool
+	chain    []*item // dummies between from and to
+}
+
+// Layout arranges a graph in layers from its start, top to bottom, with SDK
+// nodes in the left lane and backend API calls in the right one. Conditions
+// and end statuses sit in the lane of the node that leads to them.
+func Layout(g *flowgraph.Graph, modules catalog.ModuleIndex, title, subtitle string) *Diagram {
+	d := &Diagram{Title: title, Subtitle: subtitle}
+	nodes := drawable(g)
+
+	items := make(map[string]*item, len(nodes))
+	var order []*item
+	for _, n := range nodes {
+		box := style(n, modules)
+		it := &item{box: &box, id: n.ID, w: box.W, h: box.H, order: absoluteX(g, n)}
+		items[n.ID] = it
+		order = append(order, it)
+	}
+
+	var edges []*edge
+	for _, n := range nodes {
+		for _, br := range g.Branches(n.ID) {
+			if items[br.Target] == nil || br.Target == n.ID {
+				continue
+			}
+			edges = append(edges, &edge{from: n.ID, to: br.Target, kind: br.Kind, label: br.Label()})
+		}
+	}
+
+	markBackEdges(order, edges)
+	assignLayers(order, edges, items)
+	assignLanes(order, edges, items)
+
+	// Long edges get a bend point in every layer they cross so they route
+	// between boxes instead of through them
+	layers := make(map[int][]*item)
+	maxLayer := 0
+	for _, it := range order {
+		layers[it.layer] = append(layers[it.layer], it)
+		if it.layer > maxLayer {
+			maxLayer = it.layer
+		}
+	}
+	for _, e := range edges {
+		from, to := items[e.from], items[e.to]
+		if e.back {
+			continue
+		}
+		prev := from
+		for l := from.layer + 1; l < to.layer; l++ {
+			dummy := &item{lane: from.lane, layer: l, w: dummyWidth, order: from.order}
+			e.chain = append(e.chain, dummy)
+			layers[l] = append(layers[l], dummy)
+			prev.down = append(prev.down, dummy)
+			dummy.up = append(dummy.up, prev)
+			prev = dummy
+		}
+		prev.down = append(prev.down, to)
+		to.up = append(to.up, prev)
+	}
+
+	orderLayers(layers, maxLayer)
+
+	// Lanes that have something in them, SDK first
+	laneWidth := make(map[string]float64)
+	for l := 0; l <= maxLayer; l++ {
+		widths := make(map[string]float64)
+		counts := make(map[string]int)
+		for _, it := range layers[l] {
+			widths[it.lane] += it.w
+			counts[it.lane]++
+		}
+		for lane, w := range widths {
+			w += float64(counts[lane]-1)*nodeGap + 2*lanePadding
+			laneWidth[lane] = math.Max(laneWidth[lane], math.Max(w, minLaneWidth))
+		}
+	}
+	x := float64(margin)
+	laneX := make(map[string]float64)
+	for _, id := range []string{LaneSDK, LaneBackend} {
+		if laneWidth[id] == 0 {
+			continue
+		}
+		laneX[id] = x
+		d.Lanes = append(d.Lanes, Lane{ID: id, Title: laneTitles[id], X: x, W: laneWidth[id]})
+		x += laneWidth[id]
+	}
+	contentRight := x
+
+	// Rows
+	y := float64(headerHeight + laneHeader + lanePadding)
+	rowY := make([]float64, maxLayer+1)
+	rowH := make([]float64, maxLayer+1)
+	for l := 0; l <= maxLayer; l++ {
+		for _, it := range layers[l] {
+			rowH[l] = math.Max(rowH[l], it.h)
+		}
+		rowY[l] = y
+		y += rowH[l] + layerGap
+	}
+
+	// Columns: centre each lane's share of a layer, then pull boxes towards
+	// their neighbours without letting them overlap
+	for l := 0; l <= maxLayer; l++ {
+		for lane, list := range byLane(layers[l]) {
+			total := float64(len(list)-1) * nodeGap
+			for _, it := range list {
+				total += it.w
+			}
+			cx := laneX[lane] + (laneWidth[lane]-total)/2
+			for _, it := range list {
+				it.x = cx + it.w/2
+				cx += it.w + nodeGap
+			}
+		}
+	}
+	for pass := 0; pass < 4; pass++ {
+		if pass%2 == 0 {
+			for l := 1; l <= maxLayer; l++ {
+				align(layers[l], func(it *item) []*item { return it.up }, laneX, laneWidth)
+			}
+		} else {
+			for l := maxLayer - 1; l >= 0; l-- {
+				align(layers[l], func(it *item) []*item { return it.down }, laneX, laneWidth)
+			}
+		}
+	}
+
+	for _, it := range order {
+		b := it.box
+		b.Lane = it.lane
+		b.X = it.x - b.W/2
+		b.Y = rowY[it.layer] + (rowH[it.layer]-b.H)/2
+		d.Boxes = append(d.Boxes, *b)
+	}
+	boxes := make(map[string]*Box, len(d.Boxes))
+	for i := range d.Boxes {
+		boxes[d.Boxes[i].NodeID] = &d.Boxes[i]
+	}
+
+	// Spread the ends of edges along the bottom and top of rectangles, back
+	// edges rightmost since they head for the gutter
+	outs := make(map[string][]*edge)
+	ins := make(map[string][]*edge)
+	for _, e := range edges {
+		outs[e.from] = append(outs[e.from], e)
+		ins[e.to] = append(ins[e.to], e)
+	}
+	// rank returns the position of an edge among a node's edges, sorted by
+	// where they lead
+	rank := func(list []*edge, e *edge, next func(*edge) float64) (int, int) {
+		sorted := append([]*edge(nil), list...)
+		sort.SliceStable(sorted, func(i, j int) bool { return next(sorted[i]) < next(sorted[j]) })
+		for i, other := range sorted {
+			if other == e {
+				return i, len(sorted)
+			}
+		}
+		return 0, 1
+	}
+	exit := func(b *Box, e *edge, next func(*edge) float64) Point {
+		i, n := rank(outs[e.from], e, next)
+		c := b.Center()
+		switch b.Shape {
+		case ShapeRect, ShapePill:
+			return Point{b.X + b.W*float64(i+1)/float64(n+1), b.Y + b.H}
+		case ShapeDiamond:
+			// Branches leave through the left, bottom and right corners
+			switch {
+			case n > 1 && i == n-1:
+				return Point{b.X + b.W, c.Y}
+			case n > 2 && i == 0:
+				return Point{b.X, c.Y}
+			}
+		}
+		return Point{c.X, b.Y + b.H}
+	}
+	entry := func(b *Box, e *edge, next func(*edge) float64) Point {
+		i, n := rank(ins[e.to], e, next)
+		if b.Shape == ShapeRect || b.Shape == ShapePill {
+			return Point{b.X + b.W*float64(i+1)/float64(n+1), b.Y}
+		}
+		return Point{b.X + b.W/2, b.Y}
+	}
+	firstX := func(e *edge) float64 {
+		switch {
+		case e.back:
+			return math.Inf(1)
+		case len(e.chain) > 0:
+			return e.chain[0].x
+		}
+		return boxes[e.to].Center().X
+	}
+	lastX := func(e *edge) float64 {
+		switch {
+		case e.back:
+			return math.Inf(1)
+		case len(e.chain) > 0:
+			return e.chain[len(e.chain)-1].x
+		}
+		return boxes[e.from].Center().X
+	}
+
+	gutter := contentRight + gutterStep
+	for _, e := range edges {
+		from, to := boxes[e.from], boxes[e.to]
+		link := Link{From: e.from, To: e.to, Kind: e.kind, Label: e.label, Back: e.back}
+		start := exit(from, e, firstX)
+		end := entry(to, e, lastX)
+		if e.back {
+			// Down into the gap below the node, across to the gutter, up to
+			// the gap above the earlier node and into its top. The gaps
+			// never hold boxes, so the edge can't cross one.
+			below := rowY[items[e.from].layer] + rowH[items[e.from].layer] + layerGap/2
+			above := rowY[items[e.to].layer] - layerGap/2
+			link.Points = []Point{start, {start.X, below}, {gutter, below}, {gutter, above}, {end.X, above}, end}
+			gutter += gutterStep
+		} else {
+			link.Points = append(link.Points, start)
+			for _, dummy := range e.chain {
+				top := rowY[dummy.layer]
+				link.Points = append(link.Points, Point{dummy.x, top}, Point{dummy.x, top + rowH[dummy.layer]})
+			}
+			link.Points = append(link.Points, end)
+		}
+		d.Links = append(d.Links, link)
+	}
+
+	d.Width = math.Max(gutter, contentRight+margin)
+	d.Height = y - layerGap + lanePadding + margin
+	if len(order) == 0 {
+		d.Width = 2*margin + minLaneWidth
+		d.Height = headerHeight + margin
+	}
+	return d
+}
+
+// markBackEdges finds the edges that close a cycle with a depth-first search
+// from the nodes in flow order
+func markBackEdges(order []*item, edges []*edge) {
+	out := make(map[string][]*edge)
+	for _, e := range edges {
+		out[e.from] = append(out[e.from], e)
+	}
+	const (
+		unvisited = iota
+		active
+		done
+	)
+	state := make(map[string]int)
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = active
+		for _, e := range out[id] {
+			switch state[e.to] {
+			case active:
+				e.back = true
+			case unvisited:
+				visit(e.to)
+			}
+		}
+		state[id] = done
+	}
+	for _, it := range order {
+		if state[it.id] == unvisited {
+			visit(it.id)
+		}
+	}
+}
+
+// assignLayers puts every node one layer below its deepest predecessor
+func assignLayers(order []*item, edges []*edge, items map[string]*item) {
+	indegree := make(map[string]int)
+	out := make(map[string][]*edge)
+	for _, e := range edges {
+		if !e.back {
+			indegree[e.to]++
+			out[e.from] = append(out[e.from], e)
+		}
+	}
+	var queue []*item
+	for _, it := range order {
+		if indegree[it.id] == 0 {
+			queue = append(queue, it)
+		}
+	}
+	for len(queue) > 0 {
+		it := queue[0]
+		queue = queue[1:]
+		for _, e := range out[it.id] {
+			next := items[e.to]
+			if next.layer < it.layer+1 {
+				next.layer = it.layer + 1
+			}
+			if indegree[e.to]--; indegree[e.to] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+}
+
+// assignLanes puts API calls in the backend lane and SDK nodes in the SDK
+// lane; everything else follows its first predecessor
+func assignLanes(order []*item, edges []*edge, items map[string]*item) {
+	sorted := append([]*item(nil), order...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].layer < sorted[j].layer })
+
+	preds := make(map[string][]string)
+	for _, e := range edges {
+		if !e.back {
+			preds[e.to] = append(preds[e.to], e.from)
+		}
+	}
+	for _, it := range sorted {
+		switch it.box.Type {
+		case flowgraph.NodeAPIModule:
+			it.lane = LaneBackend
+		case flowgraph.NodeStart, flowgraph.NodeModule, flowgraph.NodeSdkInputs, flowgraph.NodeCard:
+			it.lane = LaneSDK
+		default:
+			it.lane = LaneSDK
+			if p := preds[it.id]; len(p) > 0 {
+				it.lane = items[p[0]].lane
+			}
+		}
+	}
+}
+
+// orderLayers sorts each layer by lane, then by the average position of the
+// neighbours in the layer above (going down) or below (going up)
+func orderLayers(layers map[int][]*item, maxLayer int) {
+	sortLayer := func(list []*item) {
+		sort.SliceStable(list, func(i, j int) bool {
+			if list[i].lane != list[j].lane {
+				return list[i].lane == LaneSDK
+			}
+			return list[i].order < list[j].order
+		})
+		for i, it := range list {
+			it.order = float64(i)
+		}
+	}
+	barycenter := func(list []*item, neighbours func(*item) []*item) {
+		for _, it := range list {
+			ns := neighbours(it)
+			if len(ns) == 0 {
+				continue
+			}
+			sum := 0.0
+			for _, n := range ns {
+				sum += n.order
+			}
+			it.order = sum / float64(len(ns))
+		}
+		sortLayer(list)
+	}
+
+	for l := 0; l <= maxLayer; l++ {
+		sortLayer(layers[l])
+	}
+	for sweep := 0; sweep < 4; sweep++ {
+		for l := 1; l <= maxLayer; l++ {
+			barycenter(layers[l], func(it *item) []*item { return it.up })
+		}
+		for l := maxLayer - 1; l >= 0; l-- {
+			barycenter(layers[l], func(it *item) []*item { return it.down })
+		}
+	}
+}
+
+// align moves the items of a layer towards the mean x of their neighbours,
+// keeping their order, gaps and lane bounds
+func align(list []*item, neighbours func(*item) []*item, laneX, laneWidth map[string]float64) {
+	for lane, row := range byLane(list) {
+		left := laneX[lane] + lanePadding
+		right := laneX[lane] + laneWidth[lane] - lanePadding
+
+		want := make([]float64, len(row))
+		for i, it := range row {
+			want[i] = it.x
+			ns := neighbours(it)
+			if len(ns) == 0 {
+				continue
+			}
+			sum := 0.0
+			for _, n := range ns {
+				sum += n.x
+			}
+			want[i] = sum / float64(len(ns))
+		}
+
+		edge := left
+		for i, it := range row {
+			it.x = math.Max(want[i], edge+it.w/2)
+			edge = it.x + it.w/2 + nodeGap
+		}
+		edge = right
+		for i := len(row) - 1; i >= 0; i-- {
+			it := row[i]
+			it.x = math.Min(it.x, edge-it.w/2)
+			edge = it.x - it.w/2 - nodeGap
+		}
+	}
+}
+
+// byLane splits an ordered layer by lane
+func byLane(list []*item) map[string][]*item {
+	out := make(map[string][]*item)
+	for _, it := range list {
+		out[it.lane] = append(out[it.lane], it)
+	}
+	return out
+}
+
+// absoluteX is a node's x on the canvas, used to keep the initial left to
+// right order the author drew
+func absoluteX(g *flowgraph.Graph, n flowgraph.Node) float64 {
+	x := n.Position.X
+	seen := map[string]bool{n.ID: true}
+	for parent := n.ParentNode; parent != "" && !seen[parent]; {
+		seen[parent] = true
+		p := g.Node(parent)
+		if p == nil {
+			break
+		}
+		x += p.Position.X
+		parent = p.ParentNode
+	}
+	return x
+}
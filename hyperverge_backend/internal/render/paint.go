@@ -0,0 +1,254 @@
+package render
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Drawing operations shared by the SVG and PNG writers
+type op interface{}
+
+type opRect struct {
+	X, Y, W, H, R float64
+	Fill          string
+	Stroke        string
+	Width         float64
+}
+
+type opCircle struct {
+	X, Y, R float64
+	Fill    string
+	Stroke  string
+	Width   float64
+}
+
+type opPolygon struct {
+	Points []Point
+	Fill   string
+	Stroke string
+	Width  float64
+}
+
+type opLine struct {
+	Points []Point
+	Stroke string
+	Width  float64
+	Dashed bool
+}
+
+// opText is one line of text; Y is the baseline
+type opText struct {
+	X, Y   float64
+	Text   string
+	Size   float64
+	Color  string
+	Bold   bool
+	Middle bool // X is the centre instead of the start
+}
+
+type opIcon struct {
+	X, Y, Size float64
+	Key        string
+	Color      string
+}
+
+// Text metrics. Layout and both writers agree on a fixed advance so text
+// fits the same way in SVG and in the bitmap font of the PNG.
+const (
+	advance   = 0.6 // of the font size
+	titleSize = 16
+	textSize  = 11
+	smallSize = 9.5
+)
+
+const (
+	colorText     = "#111827"
+	colorMuted    = "#6B7280"
+	colorEdge     = "#94A3B8"
+	colorSuccess  = "#16A34A"
+	colorFailure  = "#DC2626"
+	colorLaneA    = "#F8FAFC"
+	colorLaneB    = "#F5F3FF"
+	colorLaneLine = "#CBD5E1"
+)
+
+func textWidth(s string, size float64) float64 {
+	return float64(utf8.RuneCountInString(s)) * size * advance
+}
+
+// fit shortens text to a width, ending it with "..."
+func fit(s string, size, width float64) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if textWidth(s, size) <= width {
+		return s
+	}
+	max := int(width/(size*advance)) - 3
+	if max < 1 {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) > max {
+		runes = runes[:max]
+	}
+	return strings.TrimSpace(string(runes)) + "..."
+}
+
+// paint turns a diagram into drawing operations, back to front
+func paint(d *Diagram) []op {
+	var ops []op
+	ops = append(ops, opRect{W: d.Width, H: d.Height, Fill: "#FFFFFF"})
+
+	ops = append(ops, opText{X: margin, Y: 30, Text: fit(d.Title, titleSize, d.Width-2*margin), Size: titleSize, Color: colorText, Bold: true})
+	if d.Subtitle != "" {
+		ops = append(ops, opText{X: margin, Y: 50, Text: fit(d.Subtitle, textSize, d.Width-2*margin), Size: textSize, Color: colorMuted})
+	}
+
+	top := float64(headerHeight)
+	for i, lane := range d.Lanes {
+		fill := colorLaneA
+		if i%2 == 1 {
+			fill = colorLaneB
+		}
+		ops = append(ops,
+			opRect{X: lane.X, Y: top, W: lane.W, H: d.Height - top - margin, Fill: fill, Stroke: colorLaneLine, Width: 1},
+			opRect{X: lane.X, Y: top, W: lane.W, H: laneHeader, Fill: tint(colorLaneLine, 0.5), Stroke: colorLaneLine, Width: 1},
+			opText{X: lane.X + lane.W/2, Y: top + laneHeader/2 + 4, Text: fit(lane.Title, textSize, lane.W-16), Size: textSize, Color: colorText, Bold: true, Middle: true},
+		)
+	}
+
+	for _, l := range d.Links {
+		ops = append(ops, paintLink(l)...)
+	}
+	for _, b := range d.Boxes {
+		ops = append(ops, paintBox(b)...)
+	}
+	// Labels last so boxes don't hide them
+	for _, l := range d.Links {
+		ops = append(ops, paintLabel(l)...)
+	}
+	return ops
+}
+
+func linkColor(kind string) string {
+	switch kind {
+	case flowgraph.BranchSuccess, flowgraph.BranchTrue:
+		return colorSuccess
+	case flowgraph.BranchFailure:
+		return colorFailure
+	}
+	return colorEdge
+}
+
+func paintLink(l Link) []op {
+	if len(l.Points) < 2 {
+		return nil
+	}
+	color := linkColor(l.Kind)
+	dashed := l.Back || l.Kind == flowgraph.BranchFailure || l.Kind == flowgraph.BranchFalse
+	ops := []op{opLine{Points: l.Points, Stroke: color, Width: 1.5, Dashed: dashed}}
+
+	// Arrow head along the last segment
+	tip, from := l.Points[len(l.Points)-1], l.Points[len(l.Points)-2]
+	dx, dy := tip.X-from.X, tip.Y-from.Y
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return ops
+	}
+	ux, uy := dx/length, dy/length
+	const size = 8
+	base := Point{tip.X - ux*size, tip.Y - uy*size}
+	ops = append(ops, opPolygon{
+		Points: []Point{
+			tip,
+			{base.X - uy*size/2, base.Y + ux*size/2},
+			{base.X + uy*size/2, base.Y - ux*size/2},
+		},
+		Fill: color,
+	})
+	return ops
+}
+
+// paintLabel puts a link's label on its first segment
+func paintLabel(l Link) []op {
+	if l.Label == "" || len(l.Points) < 2 {
+		return nil
+	}
+	text := fit(l.Label, smallSize, 150)
+	a, b := l.Points[0], l.Points[1]
+	at := Point{a.X + (b.X-a.X)*0.45, a.Y + (b.Y-a.Y)*0.45}
+	w := textWidth(text, smallSize) + 8
+	return []op{
+		opRect{X: at.X - w/2, Y: at.Y - 8, W: w, H: 15, R: 3, Fill: "#FFFFFF", Stroke: tint(linkColor(l.Kind), 0.6), Width: 0.75},
+		opText{X: at.X, Y: at.Y + 3, Text: text, Size: smallSize, Color: linkColor(l.Kind), Middle: true},
+	}
+}
+
+func paintBox(b Box) []op {
+	c := b.Center()
+	var ops []op
+
+	switch b.Shape {
+	case ShapeCircle:
+		ops = append(ops,
+			opCircle{X: c.X, Y: c.Y, R: b.W / 2, Fill: b.Fill, Stroke: b.Color, Width: 2},
+			opText{X: c.X, Y: c.Y + 4, Text: fit(b.Title, textSize, b.W-6), Size: textSize, Color: colorText, Bold: true, Middle: true},
+		)
+		return ops
+
+	case ShapeDiamond:
+		ops = append(ops, opPolygon{
+			Points: []Point{{c.X, b.Y}, {b.X + b.W, c.Y}, {c.X, b.Y + b.H}, {b.X, c.Y}},
+			Fill:   b.Fill, Stroke: b.Color, Width: 1.5,
+		})
+		// The text has to fit in the middle half of the diamond
+		width := b.W * 0.55
+		if b.Subtitle == "" {
+			ops = append(ops, opText{X: c.X, Y: c.Y + 4, Text: fit(b.Title, textSize, width), Size: textSize, Color: colorText, Bold: true, Middle: true})
+		} else {
+			ops = append(ops,
+				opText{X: c.X, Y: c.Y - 2, Text: fit(b.Title, textSize, width), Size: textSize, Color: colorText, Bold: true, Middle: true},
+				opText{X: c.X, Y: c.Y + 11, Text: fit(b.Subtitle, smallSize, width), Size: smallSize, Color: colorMuted, Middle: true},
+			)
+		}
+		return ops
+
+	case ShapePill:
+		ops = append(ops, opRect{X: b.X, Y: b.Y, W: b.W, H: b.H, R: b.H / 2, Fill: b.Fill, Stroke: b.Color, Width: 2})
+		if b.Subtitle == "" {
+			ops = append(ops, opText{X: c.X, Y: c.Y + 4, Text: fit(b.Title, textSize, b.W-24), Size: textSize, Color: b.Color, Bold: true, Middle: true})
+		} else {
+			ops = append(ops,
+				opText{X: c.X, Y: c.Y - 2, Text: fit(b.Title, textSize, b.W-24), Size: textSize, Color: b.Color, Bold: true, Middle: true},
+				opText{X: c.X, Y: c.Y + 11, Text: fit(b.Subtitle, smallSize, b.W-24), Size: smallSize, Color: colorMuted, Middle: true},
+			)
+		}
+		return ops
+	}
+
+	// Card with a coloured bar on the left, like the canvas nodes
+	ops = append(ops,
+		opRect{X: b.X, Y: b.Y, W: b.W, H: b.H, R: 8, Fill: b.Fill, Stroke: tint(b.Color, 0.4), Width: 1.5},
+		opRect{X: b.X, Y: b.Y, W: 5, H: b.H, R: 2.5, Fill: b.Color},
+	)
+	textX := b.X + 14
+	if b.Icon != "" {
+		ops = append(ops,
+			opRect{X: b.X + 14, Y: c.Y - 16, W: 32, H: 32, R: 8, Fill: tint(b.Color, 0.88)},
+			opIcon{X: b.X + 20, Y: c.Y - 10, Size: 20, Key: b.Icon, Color: b.Color},
+		)
+		textX = b.X + 56
+	}
+	width := b.X + b.W - 10 - textX
+	if b.Subtitle == "" {
+		ops = append(ops, opText{X: textX, Y: c.Y + 4, Text: fit(b.Title, textSize, width), Size: textSize, Color: colorText, Bold: true})
+	} else {
+		ops = append(ops,
+			opText{X: textX, Y: c.Y - 3, Text: fit(b.Title, textSize, width), Size: textSize, Color: colorText, Bold: true},
+			opText{X: textX, Y: c.Y + 12, Text: fit(b.Subtitle, smallSize, width), Size: smallSize, Color: colorMuted},
+		)
+	}
+	return ops
+}
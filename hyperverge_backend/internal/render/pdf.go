@@ -0,0 +1,67 @@
+package render
+
+import (
+	"hypervision_backend/internal/pdf"
+)
+
+// DrawPDF draws a diagram onto a PDF page as vector shapes, with its
+// top-left corner at (x, y) and every diagram unit scale points wide
+func DrawPDF(d *Diagram, p *pdf.Page, x, y, scale float64) {
+	at := func(pt Point) pdf.Point {
+		return pdf.Point{X: x + pt.X*scale, Y: y + pt.Y*scale}
+	}
+	all := func(pts []Point) []pdf.Point {
+		out := make([]pdf.Point, len(pts))
+		for i, pt := range pts {
+			out[i] = at(pt)
+		}
+		return out
+	}
+
+	for _, o := range paint(d) {
+		switch v := o.(type) {
+		case opRect:
+			tl := at(Point{v.X, v.Y})
+			p.Rect(tl.X, tl.Y, v.W*scale, v.H*scale, v.R*scale, pdf.Style{Fill: v.Fill, Stroke: v.Stroke, Width: v.Width * scale})
+		case opCircle:
+			c := at(Point{v.X, v.Y})
+			p.Circle(c.X, c.Y, v.R*scale, pdf.Style{Fill: v.Fill, Stroke: v.Stroke, Width: v.Width * scale})
+		case opPolygon:
+			p.Polygon(all(v.Points), pdf.Style{Fill: v.Fill, Stroke: v.Stroke, Width: v.Width * scale})
+		case opLine:
+			s := pdf.Style{Stroke: v.Stroke, Width: v.Width * scale}
+			if v.Dashed {
+				s.Dash = []float64{6 * scale, 4 * scale}
+			}
+			p.Polyline(all(v.Points), s)
+		case opText:
+			font := pdf.Helvetica
+			if v.Bold {
+				font = pdf.HelveticaBold
+			}
+			size := v.Size * scale
+			pt := at(Point{v.X, v.Y})
+			if v.Middle {
+				pt.X -= pdf.Width(v.Text, font, size) / 2
+			}
+			p.Text(pt.X, pt.Y, v.Text, font, size, v.Color)
+		case opIcon:
+			ic := lookupIcon(v.Key)
+			k := v.Size / 24
+			for _, sp := range parseIcon(ic.markup) {
+				pts := make([]pdf.Point, len(sp.points))
+				for i, pt := range sp.points {
+					pts[i] = at(Point{v.X + pt.X*k, v.Y + pt.Y*k})
+				}
+				if sp.filled {
+					p.Polygon(pts, pdf.Style{Fill: v.Color})
+					continue
+				}
+				if sp.closed && len(pts) > 0 {
+					pts = append(pts, pts[0])
+				}
+				p.Polyline(pts, pdf.Style{Stroke: v.Color, Width: ic.stroke * k * scale})
+			}
+		}
+	}
+}
@@ -0,0 +1,598 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxPixels bounds the size of a PNG; larger diagrams are drawn at a
+// smaller scale
+const maxPixels = 40_000_000
+
+// PNG draws a diagram as a PNG image. Scale is device pixels per SVG unit;
+// 2 gives crisp images on high density screens.
+func PNG(d *Diagram, scale float64) ([]byte, error) {
+	if scale <= 0 {
+		scale = 1
+	}
+	if px := d.Width * d.Height * scale * scale; px > maxPixels {
+		scale *= math.Sqrt(maxPixels / px)
+	}
+
+	c := &canvas{
+		img:   image.NewRGBA(image.Rect(0, 0, int(math.Ceil(d.Width*scale)), int(math.Ceil(d.Height*scale)))),
+		scale: scale,
+	}
+	for _, o := range paint(d) {
+		c.draw(o)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, c.img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// canvas is a small anti-aliased rasterizer. Shapes are filled polygons in
+// SVG units; strokes are built from quads and round joints.
+type canvas struct {
+	img   *image.RGBA
+	scale float64
+}
+
+func (c *canvas) draw(o op) {
+	switch v := o.(type) {
+	case opRect:
+		if v.Stroke != "" && v.Width > 0 {
+			h := v.Width / 2
+			c.fill(roundedRect(v.X-h, v.Y-h, v.W+v.Width, v.H+v.Width, v.R+h), v.Stroke)
+			if v.Fill != "" {
+				c.fill(roundedRect(v.X+h, v.Y+h, v.W-v.Width, v.H-v.Width, math.Max(v.R-h, 0)), v.Fill)
+			}
+		} else if v.Fill != "" {
+			c.fill(roundedRect(v.X, v.Y, v.W, v.H, v.R), v.Fill)
+		}
+	case opCircle:
+		if v.Stroke != "" && v.Width > 0 {
+			c.fill(c.circle(v.X, v.Y, v.R+v.Width/2), v.Stroke)
+			if v.Fill != "" {
+				c.fill(c.circle(v.X, v.Y, v.R-v.Width/2), v.Fill)
+			}
+		} else if v.Fill != "" {
+			c.fill(c.circle(v.X, v.Y, v.R), v.Fill)
+		}
+	case opPolygon:
+		if v.Fill != "" {
+			c.fill(v.Points, v.Fill)
+		}
+		if v.Stroke != "" && v.Width > 0 {
+			closed := append(append([]Point(nil), v.Points...), v.Points[0])
+			c.stroke(closed, v.Width, v.Stroke)
+		}
+	case opLine:
+		if v.Dashed {
+			for _, dash := range dashes(v.Points, 6, 4) {
+				c.stroke(dash, v.Width, v.Stroke)
+			}
+		} else {
+			c.stroke(v.Points, v.Width, v.Stroke)
+		}
+	case opText:
+		c.text(v)
+	case opIcon:
+		c.icon(v)
+	}
+}
+
+// fill paints a polygon with the even-odd rule. Coverage is sampled on four
+// sub-scanlines per pixel row and computed exactly along each of them.
+func (c *canvas) fill(poly []Point, hex string) {
+	if len(poly) < 3 {
+		return
+	}
+	const sub = 4
+	bounds := c.img.Bounds()
+
+	pts := make([]Point, len(poly))
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for i, p := range poly {
+		pts[i] = Point{p.X * c.scale, p.Y * c.scale}
+		minX, maxX = math.Min(minX, pts[i].X), math.Max(maxX, pts[i].X)
+		minY, maxY = math.Min(minY, pts[i].Y), math.Max(maxY, pts[i].Y)
+	}
+	x0, y0 := max(int(math.Floor(minX)), bounds.Min.X), max(int(math.Floor(minY)), bounds.Min.Y)
+	x1, y1 := min(int(math.Ceil(maxX)), bounds.Max.X), min(int(math.Ceil(maxY)), bounds.Max.Y)
+	if x0 >= x1 || y0 >= y1 {
+		return
+	}
+
+	width := x1 - x0
+	cover := make([]float64, width)
+	var xs []float64
+	for py := y0; py < y1; py++ {
+		for i := range cover {
+			cover[i] = 0
+		}
+		for s := 0; s < sub; s++ {
+			sy := float64(py) + (float64(s)+0.5)/sub
+			xs = xs[:0]
+			for i := range pts {
+				a, b := pts[i], pts[(i+1)%len(pts)]
+				if (a.Y <= sy) == (b.Y <= sy) {
+					continue
+				}
+				xs = append(xs, a.X+(sy-a.Y)*(b.X-a.X)/(b.Y-a.Y))
+			}
+			sort.Float64s(xs)
+			for i := 0; i+1 < len(xs); i += 2 {
+				from, to := math.Max(xs[i], float64(x0)), math.Min(xs[i+1], float64(x1))
+				for px := int(math.Floor(from)); float64(px) < to; px++ {
+					overlap := math.Min(to, float64(px+1)) - math.Max(from, float64(px))
+					if overlap > 0 {
+						cover[px-x0] += overlap / sub
+					}
+				}
+			}
+		}
+		c.blendRow(py, x0, cover, hex)
+	}
+}
+
+// fillRect paints an axis-aligned rectangle with exact coverage
+func (c *canvas) fillRect(x, y, w, h float64, hex string) {
+	bounds := c.img.Bounds()
+	fx0, fy0 := x*c.scale, y*c.scale
+	fx1, fy1 := (x+w)*c.scale, (y+h)*c.scale
+	x0, y0 := max(int(math.Floor(fx0)), bounds.Min.X), max(int(math.Floor(fy0)), bounds.Min.Y)
+	x1, y1 := min(int(math.Ceil(fx1)), bounds.Max.X), min(int(math.Ceil(fy1)), bounds.Max.Y)
+	if x0 >= x1 || y0 >= y1 {
+		return
+	}
+	cover := make([]float64, x1-x0)
+	for py := y0; py < y1; py++ {
+		dy := math.Min(fy1, float64(py+1)) - math.Max(fy0, float64(py))
+		for px := x0; px < x1; px++ {
+			dx := math.Min(fx1, float64(px+1)) - math.Max(fx0, float64(px))
+			cover[px-x0] = dx * dy
+		}
+		c.blendRow(py, x0, cover, hex)
+	}
+}
+
+func (c *canvas) blendRow(py, x0 int, cover []float64, hex string) {
+	r, g, b := rgb(hex)
+	for i, a := range cover {
+		if a <= 0 {
+			continue
+		}
+		if a > 1 {
+			a = 1
+		}
+		off := c.img.PixOffset(x0+i, py)
+		pix := c.img.Pix[off : off+4 : off+4]
+		pix[0] = mix(pix[0], r, a)
+		pix[1] = mix(pix[1], g, a)
+		pix[2] = mix(pix[2], b, a)
+		pix[3] = mix(pix[3], 255, a)
+	}
+}
+
+func mix(dst, src uint8, a float64) uint8 {
+	return uint8(float64(dst)*(1-a) + float64(src)*a + 0.5)
+}
+
+// stroke draws a polyline with round joins and caps
+func (c *canvas) stroke(pts []Point, width float64, hex string) {
+	h := width / 2
+	for i := 0; i+1 < len(pts); i++ {
+		a, b := pts[i], pts[i+1]
+		dx, dy := b.X-a.X, b.Y-a.Y
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+		nx, ny := -dy/length*h, dx/length*h
+		c.fill([]Point{{a.X + nx, a.Y + ny}, {b.X + nx, b.Y + ny}, {b.X - nx, b.Y - ny}, {a.X - nx, a.Y - ny}}, hex)
+	}
+	// Joints only matter once they are wider than a device pixel
+	if width*c.scale > 1.5 {
+		for _, p := range pts {
+			c.fill(c.circle(p.X, p.Y, h), hex)
+		}
+	}
+}
+
+// circle approximates a circle with enough segments to look round
+func (c *canvas) circle(cx, cy, r float64) []Point {
+	if r <= 0 {
+		return nil
+	}
+	n := int(math.Max(12, math.Ceil(2*math.Pi*r*c.scale/3)))
+	pts := make([]Point, n)
+	for i := range pts {
+		t := 2 * math.Pi * float64(i) / float64(n)
+		pts[i] = Point{cx + r*math.Cos(t), cy + r*math.Sin(t)}
+	}
+	return pts
+}
+
+func roundedRect(x, y, w, h, r float64) []Point {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	r = math.Min(r, math.Min(w, h)/2)
+	if r <= 0 {
+		return []Point{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}}
+	}
+	const steps = 8
+	var pts []Point
+	corners := []struct{ cx, cy, start float64 }{
+		{x + w - r, y + r, -math.Pi / 2},
+		{x + w - r, y + h - r, 0},
+		{x + r, y + h - r, math.Pi / 2},
+		{x + r, y + r, math.Pi},
+	}
+	for _, k := range corners {
+		for i := 0; i <= steps; i++ {
+			t := k.start + math.Pi/2*float64(i)/steps
+			pts = append(pts, Point{k.cx + r*math.Cos(t), k.cy + r*math.Sin(t)})
+		}
+	}
+	return pts
+}
+
+// dashes cuts a polyline into dashes of on units separated by off units
+func dashes(pts []Point, on, off float64) [][]Point {
+	var out [][]Point
+	var cur []Point
+	drawing, left := true, on
+	for i := 0; i+1 < len(pts); i++ {
+		a, b := pts[i], pts[i+1]
+		length := math.Hypot(b.X-a.X, b.Y-a.Y)
+		pos := 0.0
+		if drawing && len(cur) == 0 {
+			cur = append(cur, a)
+		}
+		for length-pos > left {
+			pos += left
+			p := Point{a.X + (b.X-a.X)*pos/length, a.Y + (b.Y-a.Y)*pos/length}
+			if drawing {
+				out = append(out, append(cur, p))
+				cur = nil
+				left = off
+			} else {
+				cur = []Point{p}
+				left = on
+			}
+			drawing = !drawing
+		}
+		left -= length - pos
+		if drawing {
+			cur = append(cur, b)
+		}
+	}
+	if len(cur) > 1 {
+		out = append(out, cur)
+	}
+	return out
+}
+
+// text draws a line with the bitmap font, scaled so a cell is as wide as
+// the advance the layout measured with
+func (c *canvas) text(t opText) {
+	if t.Text == "" {
+		return
+	}
+	hex := parseColor(t.Color)
+	if hex == "" {
+		hex = colorText
+	}
+	px := t.Size * advance / glyphCell
+	x := t.X
+	if t.Middle {
+		x -= textWidth(t.Text, t.Size) / 2
+	}
+	top := t.Y - glyphAbove*px
+	w := px
+	if t.Bold {
+		w = px * 1.6
+	}
+	for _, r := range t.Text {
+		for row, bits := range glyph(r) {
+			for col := 0; col < glyphCols && col < len(bits); col++ {
+				if bits[col] == '#' {
+					c.fillRect(x+float64(col)*px, top+float64(row)*px, w, px, hex)
+				}
+			}
+		}
+		x += glyphCell * px
+	}
+}
+
+// icon draws the same line art the SVG writer embeds
+func (c *canvas) icon(o opIcon) {
+	ic := lookupIcon(o.Key)
+	k := o.Size / 24
+	at := func(p Point) Point { return Point{o.X + p.X*k, o.Y + p.Y*k} }
+
+	for _, s := range parseIcon(ic.markup) {
+		pts := make([]Point, len(s.points))
+		for i, p := range s.points {
+			pts[i] = at(p)
+		}
+		if s.filled {
+			c.fill(pts, o.Color)
+			continue
+		}
+		if s.closed && len(pts) > 0 {
+			pts = append(pts, pts[0])
+		}
+		c.stroke(pts, ic.stroke*k, o.Color)
+	}
+}
+
+// subpath is a flattened piece of icon line art on the 24x24 grid
+type subpath struct {
+	points []Point
+	closed bool
+	filled bool
+}
+
+// parseIcon flattens the rect, circle, line, polyline and path elements of
+// icon markup
+func parseIcon(markup string) []subpath {
+	var out []subpath
+	dec := xml.NewDecoder(strings.NewReader("<g>" + markup + "</g>"))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF || err != nil {
+			break
+		}
+		el, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		attr := func(name string) float64 {
+			for _, a := range el.Attr {
+				if a.Name.Local == name {
+					f, _ := strconv.ParseFloat(a.Value, 64)
+					return f
+				}
+			}
+			return 0
+		}
+		str := func(name string) string {
+			for _, a := range el.Attr {
+				if a.Name.Local == name {
+					return a.Value
+				}
+			}
+			return ""
+		}
+
+		switch el.Name.Local {
+		case "rect":
+			r := math.Max(attr("rx"), attr("ry"))
+			out = append(out, subpath{points: roundedRect(attr("x"), attr("y"), attr("width"), attr("height"), r), closed: true})
+		case "circle":
+			cx, cy, r := attr("cx"), attr("cy"), attr("r")
+			n := 24
+			pts := make([]Point, n)
+			for i := range pts {
+				t := 2 * math.Pi * float64(i) / float64(n)
+				pts[i] = Point{cx + r*math.Cos(t), cy + r*math.Sin(t)}
+			}
+			filled := str("fill") != "" && str("fill") != "none"
+			out = append(out, subpath{points: pts, closed: true, filled: filled})
+		case "line":
+			out = append(out, subpath{points: []Point{{attr("x1"), attr("y1")}, {attr("x2"), attr("y2")}}})
+		case "polyline", "polygon":
+			nums := numbers(str("points"))
+			var pts []Point
+			for i := 0; i+1 < len(nums); i += 2 {
+				pts = append(pts, Point{nums[i], nums[i+1]})
+			}
+			out = append(out, subpath{points: pts, closed: el.Name.Local == "polygon"})
+		case "path":
+			out = append(out, parsePath(str("d"))...)
+		}
+	}
+	return out
+}
+
+var pathToken = regexp.MustCompile(`[A-Za-z]|[-+]?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?`)
+
+func numbers(s string) []float64 {
+	var out []float64
+	for _, tok := range pathToken.FindAllString(s, -1) {
+		if f, err := strconv.ParseFloat(tok, 64); err == nil {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// parsePath flattens SVG path data: moves, lines, cubic curves and
+// elliptical arcs, absolute or relative
+func parsePath(d string) []subpath {
+	var out []subpath
+	var cur subpath
+	var pos, start, ctrl Point
+	var cmd byte
+	var args []float64
+
+	flush := func() {
+		if len(cur.points) > 1 {
+			out = append(out, cur)
+		}
+		cur = subpath{}
+	}
+	run := func() {
+		rel := cmd >= 'a'
+		offset := func(x, y float64) Point {
+			if rel {
+				return Point{pos.X + x, pos.Y + y}
+			}
+			return Point{x, y}
+		}
+		lastCtrl := ctrl
+		ctrl = Point{math.NaN(), math.NaN()}
+		switch cmd | 0x20 {
+		case 'm':
+			for i := 0; i+1 < len(args); i += 2 {
+				p := offset(args[i], args[i+1])
+				if i == 0 {
+					flush()
+					start = p
+				}
+				cur.points = append(cur.points, p)
+				pos = p
+			}
+		case 'l':
+			for i := 0; i+1 < len(args); i += 2 {
+				pos = offset(args[i], args[i+1])
+				cur.points = append(cur.points, pos)
+			}
+		case 'h':
+			for _, x := range args {
+				if rel {
+					pos.X += x
+				} else {
+					pos.X = x
+				}
+				cur.points = append(cur.points, pos)
+			}
+		case 'v':
+			for _, y := range args {
+				if rel {
+					pos.Y += y
+				} else {
+					pos.Y = y
+				}
+				cur.points = append(cur.points, pos)
+			}
+		case 'c', 's':
+			n := 6
+			if cmd|0x20 == 's' {
+				n = 4
+			}
+			for i := 0; i+n-1 < len(args); i += n {
+				var c1 Point
+				if n == 6 {
+					c1 = offset(args[i], args[i+1])
+				} else if !math.IsNaN(lastCtrl.X) {
+					c1 = Point{2*pos.X - lastCtrl.X, 2*pos.Y - lastCtrl.Y}
+				} else {
+					c1 = pos
+				}
+				c2 := offset(args[i+n-4], args[i+n-3])
+				end := offset(args[i+n-2], args[i+n-1])
+				cur.points = append(cur.points, cubic(pos, c1, c2, end)...)
+				pos, lastCtrl = end, c2
+			}
+			ctrl = lastCtrl
+		case 'a':
+			for i := 0; i+6 < len(args); i += 7 {
+				end := offset(args[i+5], args[i+6])
+				cur.points = append(cur.points, arc(pos, args[i], args[i+1], args[i+2], args[i+3] != 0, args[i+4] != 0, end)...)
+				pos = end
+			}
+		case 'z':
+			cur.closed = true
+			flush()
+			pos = start
+			cur.points = []Point{pos}
+		}
+	}
+
+	for _, tok := range pathToken.FindAllString(d, -1) {
+		if len(tok) == 1 && (tok[0] >= 'A' && tok[0] <= 'Z' || tok[0] >= 'a' && tok[0] <= 'z') {
+			if cmd != 0 {
+				run()
+			}
+			cmd, args = tok[0], args[:0]
+			continue
+		}
+		f, _ := strconv.ParseFloat(tok, 64)
+		args = append(args, f)
+	}
+	if cmd != 0 {
+		run()
+	}
+	flush()
+	return out
+}
+
+// cubic flattens a Bézier curve, leaving out its start point
+func cubic(p0, p1, p2, p3 Point) []Point {
+	const steps = 10
+	pts := make([]Point, 0, steps)
+	for i := 1; i <= steps; i++ {
+		t := float64(i) / steps
+		u := 1 - t
+		pts = append(pts, Point{
+			u*u*u*p0.X + 3*u*u*t*p1.X + 3*u*t*t*p2.X + t*t*t*p3.X,
+			u*u*u*p0.Y + 3*u*u*t*p1.Y + 3*u*t*t*p2.Y + t*t*t*p3.Y,
+		})
+	}
+	return pts
+}
+
+// arc flattens an elliptical arc given in SVG endpoint form, leaving out its
+// start point (SVG 1.1 appendix F.6.5)
+func arc(from Point, rx, ry, rotation float64, large, sweep bool, to Point) []Point {
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 || from == to {
+		return []Point{to}
+	}
+	phi := rotation * math.Pi / 180
+	cos, sin := math.Cos(phi), math.Sin(phi)
+	dx, dy := (from.X-to.X)/2, (from.Y-to.Y)/2
+	x1 := cos*dx + sin*dy
+	y1 := -sin*dx + cos*dy
+
+	// Scale up radii that are too small to reach the end point
+	if l := x1*x1/(rx*rx) + y1*y1/(ry*ry); l > 1 {
+		rx, ry = rx*math.Sqrt(l), ry*math.Sqrt(l)
+	}
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+	k := math.Sqrt(math.Max(num/den, 0))
+	if large == sweep {
+		k = -k
+	}
+	cx1, cy1 := k*rx*y1/ry, -k*ry*x1/rx
+	cx := cos*cx1 - sin*cy1 + (from.X+to.X)/2
+	cy := sin*cx1 + cos*cy1 + (from.Y+to.Y)/2
+
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+	theta := angle(1, 0, (x1-cx1)/rx, (y1-cy1)/ry)
+	delta := angle((x1-cx1)/rx, (y1-cy1)/ry, (-x1-cx1)/rx, (-y1-cy1)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	steps := int(math.Max(4, math.Ceil(math.Abs(delta)/(math.Pi/12))))
+	pts := make([]Point, 0, steps)
+	for i := 1; i <= steps; i++ {
+		t := theta + delta*float64(i)/float64(steps)
+		ex, ey := rx*math.Cos(t), ry*math.Sin(t)
+		pts = append(pts, Point{cos*ex - sin*ey + cx, sin*ex + cos*ey + cy})
+	}
+	pts[len(pts)-1] = to
+	return pts
+}
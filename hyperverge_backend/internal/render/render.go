@@ -0,0 +1,101 @@
+// Package render draws workflow graphs as SVG and PNG without a browser, so
+// diagrams can go into emails and PDFs. Layout places nodes in layers along
+// the flow and splits them into an SDK lane and a backend lane; the SVG, PNG
+// and PDF writers then draw the same list of shapes.
+package render
+
+import (
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Lanes
+const (
+	LaneSDK     = "sdk"     // runs on the customer's device
+	LaneBackend = "backend" // server-to-server API calls
+)
+
+// Box shapes
+const (
+	ShapeRect    = "rect"
+	ShapeCircle  = "circle"
+	ShapeDiamond = "diamond"
+	ShapePill    = "pill"
+)
+
+type Point struct {
+	X, Y float64
+}
+
+// Box is a laid out node. X and Y are the top-left corner.
+type Box struct {
+	NodeID   string
+	Type     string
+	Lane     string
+	Shape    string
+	X, Y     float64
+	W, H     float64
+	Title    string
+	Subtitle string
+	Color    string // accent: border, icon and title bar
+	Fill     string
+	Icon     string // key into the icon set; empty draws none
+}
+
+// Link is a laid out edge. Back edges close a loop and are routed around the
+// right-hand side of the diagram.
+type Link struct {
+	From, To string
+	Kind     string // flowgraph branch kind
+	Label    string
+	Points   []Point
+	Back     bool
+}
+
+// Lane is a vertical band of the diagram
+type Lane struct {
+	ID    string
+	Title string
+	X, W  float64
+}
+
+// Diagram is a laid out graph
+type Diagram struct {
+	Title    string
+	Subtitle string
+	Width    float64
+	Height   float64
+	Lanes    []Lane
+	Boxes    []Box
+	Links    []Link
+}
+
+var laneTitles = map[string]string{
+	LaneSDK:     "SDK (on device)",
+	LaneBackend: "Backend (server to server)",
+}
+
+// Center returns the middle of a box
+func (b Box) Center() Point {
+	return Point{b.X + b.W/2, b.Y + b.H/2}
+}
+
+// drawable returns the nodes to lay out: reachable ones in flow order, then
+// the rest. Notes and API groups aren't drawn.
+func drawable(g *flowgraph.Graph) []flowgraph.Node {
+	seen := make(map[string]bool)
+	var out []flowgraph.Node
+	add := func(n flowgraph.Node) {
+		if seen[n.ID] || n.Type == flowgraph.NodeNote || n.Type == flowgraph.NodeAPIGroup {
+			return
+		}
+		seen[n.ID] = true
+		out = append(out, n)
+	}
+	for _, n := range g.Ordered() {
+		add(n)
+	}
+	for _, n := range g.Nodes {
+		add(n)
+	}
+	return out
+}
@@ -0,0 +1,228 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// Default colours, matching the canvas
+const (
+	colorModule   = "#3B82F6"
+	colorAPI      = "#6366F1"
+	colorStart    = "#22C55E"
+	colorCard     = "#8B5CF6"
+	colorNeutral  = "#6B7280"
+	colorApproved = "#16A34A"
+	colorDeclined = "#DC2626"
+	colorReview   = "#CA8A04"
+)
+
+// tailwind holds the -500 shades of the colour names some nodes store
+// instead of hex values
+var tailwind = map[string]string{
+	"blue":    "#3B82F6",
+	"indigo":  "#6366F1",
+	"purple":  "#A855F7",
+	"violet":  "#8B5CF6",
+	"pink":    "#EC4899",
+	"red":     "#EF4444",
+	"orange":  "#F97316",
+	"amber":   "#F59E0B",
+	"yellow":  "#EAB308",
+	"green":   "#22C55E",
+	"emerald": "#10B981",
+	"teal":    "#14B8A6",
+	"cyan":    "#06B6D4",
+	"gray":    "#6B7280",
+	"slate":   "#64748B",
+}
+
+// iconRules pick an icon from a module's label when it has no known icon
+// key; same order as ModuleNode.tsx
+var iconRules = []struct {
+	words []string // all must appear
+	icon  string
+}{
+	{[]string{"aml"}, "aml-search"},
+	{[]string{"id card"}, "id-card-validation"},
+	{[]string{"face match"}, "face-match"},
+	{[]string{"liveness"}, "selfie-liveness"},
+	{[]string{"selfie"}, "selfie-liveness"},
+	{[]string{"geo"}, "geo-ip"},
+	{[]string{"pan"}, "nsdl-pan-verification"},
+	{[]string{"nsdl"}, "nsdl-pan-verification"},
+	{[]string{"passport"}, "passport-verification"},
+	{[]string{"driving"}, "driving-license-verification"},
+	{[]string{"license"}, "driving-license-verification"},
+	{[]string{"voter"}, "voter-id-verification"},
+	{[]string{"reverse", "penny drop"}, "reverse-penny-drop"},
+	{[]string{"penny drop"}, "penny-drop"},
+	{[]string{"bank"}, "bank-account-verification"},
+	{[]string{"pennyless"}, "pennyless-verification"},
+	{[]string{"dedup"}, "deduplication"},
+	{[]string{"ckyc", "upload"}, "ckyc-upload"},
+	{[]string{"ckyc", "search"}, "ckyc-search-download"},
+	{[]string{"ckyc", "download"}, "ckyc-search-download"},
+	{[]string{"ckyc"}, "ckyc-validation"},
+	{[]string{"aadhaar"}, "mask-aadhaar"},
+	{[]string{"aadhar"}, "mask-aadhaar"},
+	{[]string{"cheque"}, "cheque-ocr"},
+	{[]string{"passbook"}, "passbook-ocr"},
+	{[]string{"email"}, "email-mobile-verification"},
+	{[]string{"otp"}, "email-mobile-verification"},
+	{[]string{"digilocker", "fetch"}, "digilocker-fetch-documents"},
+	{[]string{"digilocker"}, "digilocker-consent"},
+	{[]string{"crime"}, "crime-detection"},
+	{[]string{"criminal"}, "crime-detection"},
+	{[]string{"efir"}, "efir-verification"},
+	{[]string{"field match"}, "field-match"},
+	{[]string{"nominee"}, "nominee-collection"},
+	{[]string{"aggregator"}, "account-aggregator"},
+	{[]string{"face"}, "face-match"},
+	{[]string{"document"}, "document"},
+	{[]string{"ocr"}, "document"},
+}
+
+// style fills in the shape, size, text, colours and icon of a node's box
+func style(n flowgraph.Node, modules catalog.ModuleIndex) Box {
+	b := Box{NodeID: n.ID, Type: n.Type, Shape: ShapeRect, W: 220, H: 60, Title: n.Label()}
+
+	switch n.Type {
+	case flowgraph.NodeStart:
+		b.Shape, b.W, b.H = ShapeCircle, 48, 48
+		b.Title = "Start"
+		b.Color = nodeColor(n, colorStart)
+	case flowgraph.NodeCondition:
+		b.Shape, b.W, b.H = ShapeDiamond, 170, 76
+		b.Color = nodeColor(n, colorReview)
+		if cond := strings.TrimSpace(n.Str("condition")); cond != "" && cond != b.Title {
+			b.Subtitle = cond
+		}
+	case flowgraph.NodeEndStatus:
+		b.Shape, b.W, b.H = ShapePill, 180, 46
+		b.Title = n.Str("status")
+		if b.Title == "" {
+			b.Title = "End"
+		}
+		b.Subtitle = strings.TrimSpace(n.Str("reason"))
+		switch n.Str("status") {
+		case flowgraph.StatusApproved:
+			b.Color = colorApproved
+		case flowgraph.StatusDeclined:
+			b.Color = colorDeclined
+		case flowgraph.StatusNeedsReview:
+			b.Color = colorReview
+		default:
+			b.Color = nodeColor(n, colorNeutral)
+		}
+	case flowgraph.NodeAPIModule:
+		call := n.APICall()
+		b.Subtitle = strings.TrimSpace(call.Method + " " + call.Path())
+		b.Color = nodeColor(n, colorAPI)
+		b.Icon = "api-module"
+		if key := n.Str("icon"); icons[key].markup != "" {
+			b.Icon = key
+		}
+	case flowgraph.NodeModule, flowgraph.NodeSdkInputs:
+		m, ok := modules[n.Str("moduleType")]
+		b.Color = nodeColor(n, colorModule)
+		b.Subtitle = n.Str("category")
+		if ok {
+			if c := parseColor(m.Color); c != "" {
+				b.Color = c
+			}
+			if m.Category != "" {
+				b.Subtitle = m.Category
+			}
+		}
+		b.Subtitle = strings.ReplaceAll(b.Subtitle, "_", " ")
+		if b.Subtitle == "" {
+			b.Subtitle = "SDK module"
+		}
+		b.Icon = moduleIcon(n, m)
+	case flowgraph.NodeCard:
+		b.Color = nodeColor(n, colorCard)
+		b.Subtitle = n.Str("description")
+	default:
+		b.Color = nodeColor(n, colorNeutral)
+	}
+
+	b.Fill = tint(b.Color, 0.9)
+	if b.Shape == ShapeRect {
+		b.Fill = "#FFFFFF"
+	}
+	return b
+}
+
+// moduleIcon prefers the catalog's icon, then the node's, then a guess from
+// the label
+func moduleIcon(n flowgraph.Node, m catalog.Module) string {
+	for _, key := range []string{m.Icon, n.Str("icon")} {
+		if _, ok := icons[key]; ok {
+			return key
+		}
+	}
+	label := strings.ToLower(n.Label())
+	for _, rule := range iconRules {
+		all := true
+		for _, w := range rule.words {
+			if !strings.Contains(label, w) {
+				all = false
+				break
+			}
+		}
+		if all {
+			return rule.icon
+		}
+	}
+	return "module"
+}
+
+func nodeColor(n flowgraph.Node, fallback string) string {
+	if c := parseColor(n.Str("color")); c != "" {
+		return c
+	}
+	return fallback
+}
+
+// parseColor normalises #rgb, #rrggbb and Tailwind colour names to #RRGGBB;
+// anything else gives ""
+func parseColor(s string) string {
+	s = strings.TrimSpace(strings.ToLower(s))
+	if c, ok := tailwind[s]; ok {
+		return c
+	}
+	if !strings.HasPrefix(s, "#") {
+		return ""
+	}
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return ""
+	}
+	if _, err := strconv.ParseUint(hex, 16, 32); err != nil {
+		return ""
+	}
+	return "#" + strings.ToUpper(hex)
+}
+
+// rgb splits a colour returned by parseColor
+func rgb(c string) (r, g, b uint8) {
+	v, _ := strconv.ParseUint(strings.TrimPrefix(c, "#"), 16, 32)
+	return uint8(v >> 16), uint8(v >> 8), uint8(v)
+}
+
+// tint mixes a colour with white; amount 1 is white
+func tint(c string, amount float64) string {
+	r, g, b := rgb(c)
+	mix := func(v uint8) uint8 {
+		return uint8(float64(v) + (255-float64(v))*amount + 0.5)
+	}
+	return fmt.Sprintf("#%02X%02X%02X", mix(r), mix(g), mix(b))
+}
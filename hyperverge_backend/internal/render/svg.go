@@ -0,0 +1,108 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// icon is line art on a 24x24 grid
+type icon struct {
+	stroke float64
+	markup string
+}
+
+func lookupIcon(key string) icon {
+	if ic, ok := icons[key]; ok {
+		return ic
+	}
+	return defaultIcon
+}
+
+// SVG draws a diagram as a standalone SVG document
+func SVG(d *Diagram) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s" font-family="Helvetica, Arial, sans-serif">`+"\n",
+		num(d.Width), num(d.Height), num(d.Width), num(d.Height))
+	fmt.Fprintf(&b, "<title>%s</title>\n", escape(d.Title))
+
+	for _, o := range paint(d) {
+		switch v := o.(type) {
+		case opRect:
+			fmt.Fprintf(&b, `<rect x="%s" y="%s" width="%s" height="%s"`, num(v.X), num(v.Y), num(v.W), num(v.H))
+			if v.R > 0 {
+				fmt.Fprintf(&b, ` rx="%s"`, num(v.R))
+			}
+			b.WriteString(paintAttrs(v.Fill, v.Stroke, v.Width, false))
+			b.WriteString("/>\n")
+		case opCircle:
+			fmt.Fprintf(&b, `<circle cx="%s" cy="%s" r="%s"%s/>`+"\n", num(v.X), num(v.Y), num(v.R), paintAttrs(v.Fill, v.Stroke, v.Width, false))
+		case opPolygon:
+			fmt.Fprintf(&b, `<polygon points="%s"%s/>`+"\n", points(v.Points), paintAttrs(v.Fill, v.Stroke, v.Width, false))
+		case opLine:
+			fmt.Fprintf(&b, `<polyline points="%s"%s stroke-linejoin="round"/>`+"\n", points(v.Points), paintAttrs("", v.Stroke, v.Width, v.Dashed))
+		case opText:
+			if v.Text == "" {
+				continue
+			}
+			fmt.Fprintf(&b, `<text x="%s" y="%s" font-size="%s" fill="%s"`, num(v.X), num(v.Y), num(v.Size), v.Color)
+			if v.Bold {
+				b.WriteString(` font-weight="bold"`)
+			}
+			if v.Middle {
+				b.WriteString(` text-anchor="middle"`)
+			}
+			fmt.Fprintf(&b, ">%s</text>\n", escape(v.Text))
+		case opIcon:
+			ic := lookupIcon(v.Key)
+			fmt.Fprintf(&b, `<g transform="translate(%s %s) scale(%s)" color="%s" fill="none" stroke="currentColor" stroke-width="%s" stroke-linecap="round" stroke-linejoin="round">%s</g>`+"\n",
+				num(v.X), num(v.Y), num(v.Size/24), v.Color, num(ic.stroke), ic.markup)
+		}
+	}
+
+	b.WriteString("</svg>\n")
+	return b.Bytes()
+}
+
+func paintAttrs(fill, stroke string, width float64, dashed bool) string {
+	if fill == "" {
+		fill = "none"
+	}
+	out := ` fill="` + fill + `"`
+	if stroke != "" && width > 0 {
+		out += ` stroke="` + stroke + `" stroke-width="` + num(width) + `"`
+		if dashed {
+			out += ` stroke-dasharray="6 4"`
+		}
+	}
+	return out
+}
+
+func points(pts []Point) string {
+	parts := make([]string, len(pts))
+	for i, p := range pts {
+		parts[i] = num(p.X) + "," + num(p.Y)
+	}
+	return strings.Join(parts, " ")
+}
+
+// num prints a coordinate with at most two decimals
+func num(f float64) string {
+	return strconv.FormatFloat(float64(int64(f*100+0.5*sign(f)))/100, 'f', -1, 64)
+}
+
+func sign(f float64) float64 {
+	if f < 0 {
+		return -1
+	}
+	return 1
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,35 @@
+package simulate
+
+import (
+	"net/http"
+
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler runs a workflow with mocked responses
+// (POST /workflows/:id/simulate?version_id=&environment_id=)
+func Handler(c *gin.Context) {
+	var req Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	ch, err := catalog.NewRevisionChecker()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	req.Variables = meta.Variables
+	res := Run(g, analysis.CatalogResolver(ch), req)
+	res.Workflow = meta
+	c.JSON(http.StatusOK, res)
+}
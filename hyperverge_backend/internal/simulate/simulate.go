@@ -0,0 +1,346 @@
+// Package simulate runs a workflow graph the way an applicant would go
+// through it, with mocked API and module responses instead of real calls.
+// Each step gets its inputs from the values its request sets, the flow
+// inputs, the environment variables and the outputs of the steps before it;
+// API calls go down their success branch when the mocked status is 2xx and
+// their condition holds on the response. Conditions read the last response
+// first and then what the earlier steps returned.
+package simulate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// MaxSteps stops flows that loop
+const MaxSteps = 200
+
+// Outcomes of a run
+const (
+	OutcomeCompleted = "completed" // reached an end status
+	OutcomeStopped   = "stopped"   // the flow has no branch for what happened
+	OutcomeLoop      = "loop"      // ran MaxSteps steps without ending
+)
+
+// Mock is the response of an API call or SDK module. Without one, an API
+// call returns the success response documented on its node.
+type Mock struct {
+	StatusCode int         `json:"status_code"` // defaults to 200
+	Response   interface{} `json:"response"`
+}
+
+// Request is what a run starts from. Mocks are keyed by node id or title.
+type Request struct {
+	FlowInputs map[string]interface{} `json:"flow_inputs"`
+	Mocks      map[string]Mock        `json:"mocks"`
+	Variables  map[string]interface{} `json:"-"` // of the environment the graph was loaded for
+}
+
+// Step is one node the run went through
+type Step struct {
+	NodeID     string                 `json:"node_id"`
+	Type       string                 `json:"type"`
+	Title      string                 `json:"title"`
+	Input      map[string]interface{} `json:"input,omitempty"`
+	Missing    []string               `json:"missing,omitempty"` // required inputs nothing provided
+	StatusCode int                    `json:"status_code,omitempty"`
+	Response   interface{}            `json:"response,omitempty"`
+	Output     map[string]interface{} `json:"output,omitempty"`
+	Mocked     bool                   `json:"mocked,omitempty"`
+	Condition  string                 `json:"condition,omitempty"`
+	Holds      *bool                  `json:"holds,omitempty"` // whether the condition held
+	Error      string                 `json:"error,omitempty"`
+	Branch     string                 `json:"branch,omitempty"` // kind of the branch taken
+	EdgeID     string                 `json:"edge_id,omitempty"`
+}
+
+// Result is the trace of a run
+type Result struct {
+	Workflow *flowgraph.Meta `json:"workflow,omitempty"`
+	Outcome  string          `json:"outcome"`
+	Status   string          `json:"status,omitempty"` // end status reached
+	Reason   string          `json:"reason,omitempty"` // why the run stopped
+	Path     []string        `json:"path"`             // node ids in the order visited
+	Steps    []Step          `json:"steps"`
+}
+
+type run struct {
+	g       *flowgraph.Graph
+	resolve analysis.Resolver
+	req     Request
+
+	values map[string]interface{} // by field key
+	scope  map[string]interface{} // what conditions read, by name
+	res    *Result
+}
+
+// Run simulates g from its start node
+func Run(g *flowgraph.Graph, resolve analysis.Resolver, req Request) *Result {
+	r := &run{
+		g:       g,
+		resolve: resolve,
+		req:     req,
+		values:  make(map[string]interface{}),
+		scope:   make(map[string]interface{}),
+		res:     &Result{Path: []string{}, Steps: []Step{}},
+	}
+	for name, v := range req.Variables {
+		r.values[flowgraph.FieldKey(name)] = v
+	}
+	for name, v := range req.FlowInputs {
+		r.values[flowgraph.FieldKey(name)] = v
+		r.scope[name] = v
+	}
+
+	starts := g.Starts()
+	if len(starts) == 0 {
+		r.stop(OutcomeStopped, "the flow has no start node")
+		return r.res
+	}
+	id := starts[0].ID
+	for _, s := range starts {
+		if s.Type == flowgraph.NodeStart {
+			id = s.ID
+			break
+		}
+	}
+
+	for id != "" {
+		if len(r.res.Steps) >= MaxSteps {
+			r.stop(OutcomeLoop, fmt.Sprintf("still running after %d steps", MaxSteps))
+			break
+		}
+		id = r.visit(id)
+	}
+	return r.res
+}
+
+// visit runs a node and returns the next one, or "" when the run is over
+func (r *run) visit(id string) string {
+	n := r.g.Node(id)
+	if n == nil {
+		r.stop(OutcomeStopped, "an edge leads to missing node "+id)
+		return ""
+	}
+	r.res.Path = append(r.res.Path, id)
+	step := Step{NodeID: id, Type: n.Type, Title: n.Label()}
+
+	var next *flowgraph.Branch
+	switch n.Type {
+	case flowgraph.NodeEndStatus:
+		r.res.Steps = append(r.res.Steps, step)
+		r.res.Outcome = OutcomeCompleted
+		r.res.Status = n.Str("status")
+		return ""
+	case flowgraph.NodeAPIModule, flowgraph.NodeModule:
+		next = r.call(*n, &step)
+	case flowgraph.NodeCondition:
+		next = r.decide(*n, &step)
+	default:
+		next = first(r.g.Branches(id), nil)
+	}
+
+	if next == nil {
+		r.res.Steps = append(r.res.Steps, step)
+		r.stop(OutcomeStopped, step.Title+" has no branch for this outcome")
+		return ""
+	}
+	step.Branch = next.Kind
+	step.EdgeID = next.EdgeID
+	r.res.Steps = append(r.res.Steps, step)
+	return next.Target
+}
+
+// call runs an API call or SDK module and picks its success or failure branch
+func (r *run) call(n flowgraph.Node, step *Step) *flowgraph.Branch {
+	inputs, outputs := r.resolve(n)
+
+	// The resolver leaves out the inputs the request itself sets; they are
+	// sent all the same
+	step.Input = make(map[string]interface{})
+	if n.Type == flowgraph.NodeAPIModule {
+		call := n.APICall()
+		literals := call.Literals()
+		for _, f := range call.Inputs {
+			if v, ok := literals[flowgraph.FieldKey(f.Name)]; ok {
+				step.Input[f.Name] = v
+			}
+		}
+	}
+	for _, f := range inputs {
+		if v, ok := r.values[flowgraph.FieldKey(f.Name)]; ok {
+			step.Input[f.Name] = v
+		} else if f.Required {
+			step.Missing = append(step.Missing, f.Name)
+		}
+	}
+
+	mock, mocked := r.mock(n)
+	step.Mocked = mocked
+	switch {
+	case mocked:
+		step.StatusCode = mock.StatusCode
+		if step.StatusCode == 0 {
+			step.StatusCode = 200
+		}
+		step.Response = mock.Response
+	case len(step.Missing) > 0:
+		// The API would reject the request
+		step.StatusCode = 400
+		step.Error = "missing required inputs: " + strings.Join(step.Missing, ", ")
+	default:
+		step.StatusCode = 200
+		if n.Type == flowgraph.NodeAPIModule {
+			step.Response = n.APICall().SuccessResponse
+		}
+	}
+	ok := step.StatusCode >= 200 && step.StatusCode < 300
+
+	r.remember(step.Response)
+	if n.Type == flowgraph.NodeAPIModule {
+		if cond := strings.TrimSpace(n.Str("condition")); cond != "" && ok {
+			step.Condition = cond
+			holds, err := r.eval(cond)
+			if err != nil {
+				step.Error = err.Error()
+			}
+			step.Holds = &holds
+			ok = holds
+		}
+	}
+
+	if ok {
+		step.Output = r.produce(outputs, step.Response)
+	}
+	kind := flowgraph.BranchSuccess
+	if !ok {
+		kind = flowgraph.BranchFailure
+	}
+
+	branches := r.g.Branches(n.ID)
+	return first(branches, func(b flowgraph.Branch) bool {
+		if kind == flowgraph.BranchFailure {
+			return b.Kind == flowgraph.BranchFailure
+		}
+		return b.Kind != flowgraph.BranchFailure
+	})
+}
+
+// decide evaluates a condition node
+func (r *run) decide(n flowgraph.Node, step *Step) *flowgraph.Branch {
+	step.Condition = strings.TrimSpace(n.Str("condition"))
+	holds := true
+	if step.Condition != "" {
+		var err error
+		if holds, err = r.eval(step.Condition); err != nil {
+			step.Error = err.Error()
+		}
+	}
+	step.Holds = &holds
+
+	return first(r.g.Branches(n.ID), func(b flowgraph.Branch) bool {
+		return (b.Kind == flowgraph.BranchFalse) != holds
+	})
+}
+
+// eval evaluates a condition against the scope; one that can't be parsed
+// doesn't hold
+func (r *run) eval(cond string) (bool, error) {
+	e, err := flowgraph.ParseExpr(cond)
+	if err != nil {
+		return false, fmt.Errorf("condition %q: %v", cond, err)
+	}
+	return flowgraph.Truthy(flowgraph.Eval(e, r.scope)), nil
+}
+
+// remember adds the fields of a response to the scope, over those of the
+// steps before it, so a condition after a step that returned nothing still
+// sees what the earlier ones did
+func (r *run) remember(response interface{}) {
+	if m, ok := response.(map[string]interface{}); ok {
+		for k, v := range m {
+			r.scope[k] = v
+		}
+	}
+}
+
+// produce makes the outputs of a step available to the steps after it. An
+// SDK module without documented outputs provides every field of its mock.
+func (r *run) produce(outputs []flowgraph.Field, response interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	if len(outputs) == 0 {
+		if m, ok := response.(map[string]interface{}); ok {
+			for k, v := range m {
+				out[k] = v
+			}
+		}
+	}
+	for _, f := range outputs {
+		if v, ok := find(response, flowgraph.FieldKey(f.Name)); ok {
+			out[f.Name] = v
+		}
+	}
+	for k, v := range out {
+		r.values[flowgraph.FieldKey(k)] = v
+		r.scope[k] = v
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func (r *run) mock(n flowgraph.Node) (Mock, bool) {
+	if m, ok := r.req.Mocks[n.ID]; ok {
+		return m, true
+	}
+	m, ok := r.req.Mocks[n.Label()]
+	return m, ok
+}
+
+func (r *run) stop(outcome, reason string) {
+	r.res.Outcome = outcome
+	r.res.Reason = reason
+}
+
+// find looks for a field anywhere in a response, breadth first, so
+// result.details.name is found for an output called name
+func find(response interface{}, key string) (interface{}, bool) {
+	queue := []interface{}{response}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		switch val := v.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(val))
+			for k := range val {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				if flowgraph.FieldKey(k) == key {
+					return val[k], true
+				}
+			}
+			for _, k := range keys {
+				queue = append(queue, val[k])
+			}
+		case []interface{}:
+			queue = append(queue, val...)
+		}
+	}
+	return nil, false
+}
+
+func first(branches []flowgraph.Branch, match func(flowgraph.Branch) bool) *flowgraph.Branch {
+	for i := range branches {
+		if match == nil || match(branches[i]) {
+			return &branches[i]
+		}
+	}
+	return nil
+}
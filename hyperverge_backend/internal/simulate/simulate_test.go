@@ -0,0 +1,163 @@
+package simulate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/flowgraph"
+)
+
+// readID is an API node whose curl example sets countryId
+func readID(country string) string {
+	return fmt.Sprintf(`{"id": "read", "type": "apiModuleNode", "data": {
+		"title": "Read ID",
+		"curlExample": "curl --location 'https://ind.idv.hyperverge.co/v1/readId' --header 'appId: <appId>' --form 'countryId=%s'",
+		"inputs": [{"name": "countryId", "type": "string", "required": true}],
+		"outputs": [{"name": "idNumber", "type": "string"}],
+		"successResponse": {"status": "success", "result": {"idNumber": "X123"}}
+	}}`, country)
+}
+
+const (
+	matchFace = `{"id": "match", "type": "apiModuleNode", "data": {
+		"title": "Match face",
+		"curlExample": "curl 'https://ind.idv.hyperverge.co/v1/matchFace' -d '{\"idNumber\": \"<idNumber>\"}'",
+		"inputs": [{"name": "idNumber", "type": "string", "required": true}],
+		"outputs": [{"name": "match", "type": "boolean"}],
+		"successResponse": {"status": "success", "result": {"match": true}}
+	}}`
+	approved = `{"id": "approved", "type": "endStatusNode", "data": {"status": "auto-approved"}}`
+	declined = `{"id": "declined", "type": "endStatusNode", "data": {"status": "auto-declined"}}`
+)
+
+func condition(expr string) string {
+	return fmt.Sprintf(`{"id": "check", "type": "conditionNode", "data": {"condition": %q}}`, expr)
+}
+
+func graph(t *testing.T, nodes []string, edges ...string) *flowgraph.Graph {
+	t.Helper()
+	var es []string
+	for i, e := range edges {
+		parts := strings.Fields(e) // source target [handle]
+		handle := ""
+		if len(parts) > 2 {
+			handle = parts[2]
+		}
+		es = append(es, fmt.Sprintf(`{"id": "e%d", "source": %q, "target": %q, "sourceHandle": %q}`, i, parts[0], parts[1], handle))
+	}
+	doc := fmt.Sprintf(`{"nodes": [{"id": "start", "type": "startNode"}, %s], "edges": [%s]}`,
+		strings.Join(nodes, ","), strings.Join(es, ","))
+	g, err := flowgraph.Parse(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *flowgraph.Graph
+		req     Request
+		outcome string
+		status  string
+		path    string
+	}{
+		{
+			name:    "literal form value is sent",
+			g:       graph(t, []string{readID("ind"), approved, declined}, "start read", "read approved success", "read declined failure"),
+			outcome: OutcomeCompleted,
+			status:  "auto-approved",
+			path:    "start read approved",
+		},
+		{
+			name:    "placeholder without a value fails the call",
+			g:       graph(t, []string{readID("<countryId>"), approved, declined}, "start read", "read approved success", "read declined failure"),
+			outcome: OutcomeCompleted,
+			status:  "auto-declined",
+			path:    "start read declined",
+		},
+		{
+			name:    "environment variable fills a placeholder",
+			g:       graph(t, []string{readID("<countryId>"), approved, declined}, "start read", "read approved success", "read declined failure"),
+			req:     Request{Variables: map[string]interface{}{"country_id": "ind"}},
+			outcome: OutcomeCompleted,
+			status:  "auto-approved",
+			path:    "start read approved",
+		},
+		{
+			name: "condition reads an earlier step's output",
+			g: graph(t, []string{readID("ind"), matchFace, condition("idNumber == 'X123' && match == true"), approved, declined},
+				"start read", "read match success", "match check success", "check approved true", "check declined false"),
+			outcome: OutcomeCompleted,
+			status:  "auto-approved",
+			path:    "start read match check approved",
+		},
+		{
+			name: "condition reads flow inputs",
+			g: graph(t, []string{readID("ind"), condition("age >= 18"), approved, declined},
+				"start read", "read check success", "check approved true", "check declined false"),
+			req:     Request{FlowInputs: map[string]interface{}{"age": float64(17)}},
+			outcome: OutcomeCompleted,
+			status:  "auto-declined",
+			path:    "start read check declined",
+		},
+		{
+			name: "retry loop that never succeeds",
+			g: graph(t, []string{readID("ind"), matchFace, approved},
+				"start read", "read match success", "match approved success", "match read failure"),
+			req:     Request{Mocks: map[string]Mock{"Match face": {StatusCode: 500}}},
+			outcome: OutcomeLoop,
+		},
+		{
+			name: "retry loop that succeeds",
+			g: graph(t, []string{readID("ind"), matchFace, approved},
+				"start read", "read match success", "match approved success", "match read failure"),
+			outcome: OutcomeCompleted,
+			status:  "auto-approved",
+			path:    "start read match approved",
+		},
+		{
+			name:    "no branch for the outcome",
+			g:       graph(t, []string{readID("<countryId>"), approved}, "start read", "read approved success"),
+			outcome: OutcomeStopped,
+			path:    "start read",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Run(tt.g, analysis.CatalogResolver(nil), tt.req)
+			if res.Outcome != tt.outcome {
+				t.Fatalf("outcome = %s (%s), want %s", res.Outcome, res.Reason, tt.outcome)
+			}
+			if res.Status != tt.status {
+				t.Errorf("status = %q, want %q", res.Status, tt.status)
+			}
+			if tt.path != "" && strings.Join(res.Path, " ") != tt.path {
+				t.Errorf("path = %v, want %s", res.Path, tt.path)
+			}
+			if tt.outcome == OutcomeLoop && len(res.Steps) != MaxSteps {
+				t.Errorf("ran %d steps, want %d", len(res.Steps), MaxSteps)
+			}
+		})
+	}
+}
+
+func TestRunInputs(t *testing.T) {
+	g := graph(t, []string{readID("ind"), matchFace, approved}, "start read", "read match success", "match approved success")
+	res := Run(g, analysis.CatalogResolver(nil), Request{})
+
+	if got := res.Steps[1].Input["countryId"]; got != "ind" {
+		t.Errorf("countryId = %v, want the literal ind", got)
+	}
+	if got := res.Steps[2].Input["idNumber"]; got != "X123" {
+		t.Errorf("idNumber = %v, want X123 from Read ID", got)
+	}
+	for _, s := range res.Steps {
+		if len(s.Missing) > 0 {
+			t.Errorf("%s is missing %v", s.Title, s.Missing)
+		}
+	}
+}
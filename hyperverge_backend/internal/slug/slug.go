@@ -0,0 +1,19 @@
+// Package slug turns names into file name safe slugs for downloads.
+package slug
+
+import (
+	"regexp"
+	"strings"
+)
+
+var unsafe = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// Make lower-cases a name and joins its letters and digits with dashes, e.g.
+// "KYC Flow (v2)" gives "kyc-flow-v2". Names with neither give fallback.
+func Make(name, fallback string) string {
+	out := strings.Trim(strings.ToLower(unsafe.ReplaceAllString(name, "-")), "-")
+	if out == "" {
+		return fallback
+	}
+	return out
+}
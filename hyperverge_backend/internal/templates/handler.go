@@ -0,0 +1,754 @@
+// internal/templates/handler.go
+package templates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/db"
+
+	"github.com/gin-gonic/gin"
+	"github.com/supabase-community/postgrest-go"
+)
+
+const (
+	ScopeGlobal = "global"
+	ScopeClient = "client"
+)
+
+type SaveTemplateReq struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Scope       string              `json:"scope"`      // "global" or "client" (default)
+	VersionID   string              `json:"version_id"` // Optional: save a published version instead of the draft
+	Parameters  []TemplateParameter `json:"parameters"`
+	Changelog   string              `json:"changelog"`
+}
+
+type AddVersionReq struct {
+	WorkflowID string              `json:"workflow_id"`
+	VersionID  string              `json:"version_id"`
+	Parameters []TemplateParameter `json:"parameters"` // Optional: defaults to the previous version's parameters
+	Changelog  string              `json:"changelog"`
+}
+
+type CreateFromTemplateReq struct {
+	TemplateID  string                 `json:"template_id"`
+	Version     int                    `json:"version"` // Optional: defaults to the current version
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Parameters  map[string]interface{} `json:"parameters"`
+}
+
+type TemplateVersionSummary struct {
+	ID               string `json:"id"`
+	Version          int    `json:"version"`
+	Changelog        string `json:"changelog"`
+	SourceWorkflowID string `json:"source_workflow_id"`
+	SourceVersionID  string `json:"source_version_id"`
+	CreatedBy        string `json:"created_by"`
+	CreatedAt        string `json:"created_at"`
+}
+
+type TemplateResponse struct {
+	ID             string                   `json:"id"`
+	Scope          string                   `json:"scope"`
+	ClientID       string                   `json:"client_id,omitempty"`
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	FlowType       string                   `json:"flow_type"`
+	CurrentVersion int                      `json:"current_version"`
+	CreatedBy      string                   `json:"created_by"`
+	CreatedAt      string                   `json:"created_at"`
+	UpdatedAt      string                   `json:"updated_at"`
+	Parameters     []TemplateParameter      `json:"parameters,omitempty"`
+	FlowData       map[string]interface{}   `json:"flow_data,omitempty"`
+	Versions       []TemplateVersionSummary `json:"versions,omitempty"`
+}
+
+type TemplateStatus struct {
+	WorkflowID      string `json:"workflow_id"`
+	WorkflowName    string `json:"workflow_name"`
+	TemplateID      string `json:"template_id"`
+	TemplateName    string `json:"template_name"`
+	TemplateVersion int    `json:"template_version"`
+	LatestVersion   int    `json:"latest_version"`
+	Outdated        bool   `json:"outdated"`
+}
+
+func getInt(m map[string]interface{}, key string) int {
+	if val, ok := m[key].(float64); ok {
+		return int(val)
+	}
+	return 0
+}
+
+// getMap handles jsonb columns stored either as objects or as JSON strings
+func getMap(m map[string]interface{}, key string) map[string]interface{} {
+	if val, ok := m[key]; ok {
+		if str, ok := val.(string); ok {
+			var result map[string]interface{}
+			if err := json.Unmarshal([]byte(str), &result); err == nil {
+				return result
+			}
+		}
+		if mp, ok := val.(map[string]interface{}); ok {
+			return mp
+		}
+	}
+	return nil
+}
+
+func getParameters(m map[string]interface{}) []TemplateParameter {
+	var params []TemplateParameter
+	raw := m["parameters"]
+	if str, ok := raw.(string); ok {
+		json.Unmarshal([]byte(str), &params)
+		return params
+	}
+	b, _ := json.Marshal(raw)
+	json.Unmarshal(b, &params)
+	return params
+}
+
+func toResponse(t map[string]interface{}) TemplateResponse {
+	clientId := db.GetString(t, "client_id")
+	scope := ScopeClient
+	if clientId == "" {
+		scope = ScopeGlobal
+	}
+	return TemplateResponse{
+		ID:             db.GetString(t, "id"),
+		Scope:          scope,
+		ClientID:       clientId,
+		Name:           db.GetString(t, "name"),
+		Description:    db.GetString(t, "description"),
+		FlowType:       db.GetString(t, "flow_type"),
+		CurrentVersion: getInt(t, "current_version"),
+		CreatedBy:      db.GetString(t, "created_by"),
+		CreatedAt:      db.GetString(t, "created_at"),
+		UpdatedAt:      db.GetString(t, "updated_at"),
+	}
+}
+
+// SaveAsTemplate saves a workflow draft (or one of its published versions)
+// as version 1 of a new template.
+func SaveAsTemplate(c *gin.Context) {
+	workflowId := c.Param("id")
+	userId := c.GetString("userId")
+
+	var req SaveTemplateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return
+	}
+
+	if err := ValidateParameters(req.Parameters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	source, err := loadSource(workflowId, req.VersionID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !auth.CanAccessBU(source.BusinessUnitID, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+
+	var clientId interface{}
+	switch req.Scope {
+	case ScopeGlobal:
+		if !auth.IsAdmin(c) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only admins can publish to the global catalog"})
+			return
+		}
+	case "", ScopeClient:
+		clientId = source.ClientID
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "scope must be 'global' or 'client'"})
+		return
+	}
+
+	params := req.Parameters
+	if params == nil {
+		params = []TemplateParameter{}
+	}
+
+	// The template and its version 1 are created together
+	var sourceVersion interface{}
+	if source.VersionID != "" {
+		sourceVersion = source.VersionID
+	}
+	var template map[string]interface{}
+	err = db.CallRPC(db.Client, "create_template", map[string]interface{}{
+		"p_client_id":          clientId,
+		"p_name":               req.Name,
+		"p_description":        req.Description,
+		"p_flow_type":          source.FlowType,
+		"p_flow_data":          source.FlowData,
+		"p_parameters":         params,
+		"p_source_workflow_id": source.WorkflowID,
+		"p_source_version_id":  sourceVersion,
+		"p_changelog":          req.Changelog,
+		"p_created_by":         userId,
+	}, &template)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create template: " + err.Error()})
+		return
+	}
+
+	response := toResponse(template)
+	response.Parameters = params
+	response.FlowData = source.FlowData
+	c.JSON(http.StatusCreated, response)
+}
+
+// List returns the global catalog plus, when client_id is given, that
+// client's own templates.
+func List(c *gin.Context) {
+	userId := c.GetString("userId")
+	clientId := c.Query("client_id")
+
+	data, _, err := db.Client.
+		From("workflow_templates").
+		Select("*", "", false).
+		Is("client_id", "null").
+		Order("name", &postgrest.OrderOpts{Ascending: true}).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var rows []map[string]interface{}
+	json.Unmarshal(data, &rows)
+
+	if clientId != "" {
+		if !auth.CanAccessClient(clientId, userId, false) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+			return
+		}
+
+		clientData, _, err := db.Client.
+			From("workflow_templates").
+			Select("*", "", false).
+			Eq("client_id", clientId).
+			Order("name", &postgrest.OrderOpts{Ascending: true}).
+			Execute()
+
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		var clientRows []map[string]interface{}
+		json.Unmarshal(clientData, &clientRows)
+		rows = append(rows, clientRows...)
+	}
+
+	response := []TemplateResponse{}
+	for _, row := range rows {
+		response = append(response, toResponse(row))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Get returns a template with its current version, or the one asked for
+// with ?version=, and the version history
+func Get(c *gin.Context) {
+	templateId := c.Param("templateId")
+	userId := c.GetString("userId")
+
+	version := 0
+	if v := c.Query("version"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "version must be a positive integer"})
+			return
+		}
+		version = n
+	}
+
+	template, err := loadTemplate(templateId)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "template not found"})
+		return
+	}
+
+	if !canUseTemplate(c, template, userId, false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+
+	data, _, err := db.Client.
+		From("workflow_template_versions").
+		Select("*", "", false).
+		Eq("template_id", templateId).
+		Order("version", &postgrest.OrderOpts{Ascending: false}).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var versions []map[string]interface{}
+	json.Unmarshal(data, &versions)
+
+	response := toResponse(template)
+	if version == 0 {
+		version = response.CurrentVersion
+	}
+	found := false
+	for _, v := range versions {
+		if getInt(v, "version") == version {
+			found = true
+			response.Parameters = getParameters(v)
+			response.FlowData = getMap(v, "flow_data")
+		}
+		response.Versions = append(response.Versions, TemplateVersionSummary{
+			ID:               db.GetString(v, "id"),
+			Version:          getInt(v, "version"),
+			Changelog:        db.GetString(v, "changelog"),
+			SourceWorkflowID: db.GetString(v, "source_workflow_id"),
+			SourceVersionID:  db.GetString(v, "source_version_id"),
+			CreatedBy:        db.GetString(v, "created_by"),
+			CreatedAt:        db.GetString(v, "created_at"),
+		})
+	}
+	if !found && c.Query("version") != "" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "template version not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// AddVersion snapshots a workflow into a new version of an existing template.
+// Workflows created from older versions are reported as outdated afterwards.
+func AddVersion(c *gin.Context) {
+	templateId := c.Param("templateId")
+	userId := c.GetString("userId")
+
+	var req AddVersionReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.WorkflowID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "workflow_id is required"})
+		return
+	}
+
+	template, err := loadTemplate(templateId)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "template not found"})
+		return
+	}
+
+	if !canUseTemplate(c, template, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to edit this template"})
+		return
+	}
+
+	source, err := loadSource(req.WorkflowID, req.VersionID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !auth.CanAccessBU(source.BusinessUnitID, userId, false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to read the source workflow"})
+		return
+	}
+
+	current := getInt(template, "current_version")
+	params := req.Parameters
+	if params == nil {
+		previous, err := loadVersion(templateId, current)
+		if err == nil {
+			params = getParameters(previous)
+		}
+	}
+	if params == nil {
+		params = []TemplateParameter{}
+	}
+
+	if err := ValidateParameters(params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The version row and the template's current_version change together
+	var sourceVersion interface{}
+	if source.VersionID != "" {
+		sourceVersion = source.VersionID
+	}
+	var next int
+	err = db.CallRPC(db.Client, "add_template_version", map[string]interface{}{
+		"p_template_id":        templateId,
+		"p_flow_data":          source.FlowData,
+		"p_parameters":         params,
+		"p_source_workflow_id": source.WorkflowID,
+		"p_source_version_id":  sourceVersion,
+		"p_changelog":          req.Changelog,
+		"p_created_by":         userId,
+		"p_flow_type":          source.FlowType,
+	}, &next)
+
+	var rpcErr *db.RPCError
+	if errors.As(err, &rpcErr) && rpcErr.Code == "P0002" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "template not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create template version: " + err.Error()})
+		return
+	}
+
+	template["current_version"] = float64(next)
+	if source.FlowType != "" {
+		template["flow_type"] = source.FlowType
+	}
+	response := toResponse(template)
+	response.Parameters = params
+	response.FlowData = source.FlowData
+	c.JSON(http.StatusCreated, response)
+}
+
+// Delete removes a template and all of its versions. Workflows created from
+// it keep their graph; their template reference is cleared by the FK.
+func Delete(c *gin.Context) {
+	templateId := c.Param("templateId")
+	userId := c.GetString("userId")
+
+	template, err := loadTemplate(templateId)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "template not found"})
+		return
+	}
+
+	if !canUseTemplate(c, template, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to delete this template"})
+		return
+	}
+
+	_, _, err = db.Client.
+		From("workflow_templates").
+		Delete("", "").
+		Eq("id", templateId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete template"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateFromTemplate creates a new workflow in a BU from a template version
+// with the parameters filled in.
+func CreateFromTemplate(c *gin.Context) {
+	buId := c.Param("buId")
+	userId := c.GetString("userId")
+
+	var req CreateFromTemplateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.TemplateID == "" || req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "template_id and name are required"})
+		return
+	}
+
+	if !auth.CanAccessBU(buId, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to create workflow in this BU"})
+		return
+	}
+
+	template, err := loadTemplate(req.TemplateID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "template not found"})
+		return
+	}
+
+	// Client templates can only be used inside their own client
+	if templateClient := db.GetString(template, "client_id"); templateClient != "" && templateClient != auth.ClientOfBU(buId) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "template belongs to another client"})
+		return
+	}
+
+	versionNumber := req.Version
+	if versionNumber == 0 {
+		versionNumber = getInt(template, "current_version")
+	}
+
+	version, err := loadVersion(req.TemplateID, versionNumber)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "template version not found"})
+		return
+	}
+
+	values, err := ResolveValues(getParameters(version), req.Parameters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	flowData := Instantiate(getMap(version, "flow_data"), values)
+	flowType := db.GetString(template, "flow_type")
+	if ft, _ := flowData["flowType"].(string); ft != "" {
+		flowType = ft
+	}
+	flowData["flowType"] = flowType
+	flowDataJSON, _ := json.Marshal(flowData)
+
+	data, _, err := db.Client.
+		From("test_workflows").
+		Insert(map[string]interface{}{
+			"name":             req.Name,
+			"description":      req.Description,
+			"business_unit_id": buId,
+			"flow_data":        string(flowDataJSON),
+			"flow_type":        flowType,
+			"template_id":      req.TemplateID,
+			"template_version": versionNumber,
+		}, false, "", "", "").
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "no workflow created"})
+		return
+	}
+
+	workflow := results[0]
+	workflow["flow_data"] = flowData
+
+	c.JSON(http.StatusCreated, gin.H{
+		"workflow":         workflow,
+		"template_id":      req.TemplateID,
+		"template_version": versionNumber,
+		"parameters":       values,
+	})
+}
+
+// ListTemplateStatus reports, for every workflow in a BU created from a
+// template, whether a newer template version is available.
+func ListTemplateStatus(c *gin.Context) {
+	buId := c.Param("buId")
+	userId := c.GetString("userId")
+
+	if !auth.CanAccessBU(buId, userId, false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+
+	data, _, err := db.Client.
+		From("test_workflows").
+		Select("id, name, template_id, template_version", "", false).
+		Eq("business_unit_id", buId).
+		Not("template_id", "is", "null").
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var workflows []map[string]interface{}
+	json.Unmarshal(data, &workflows)
+
+	templateIds := []string{}
+	seen := make(map[string]bool)
+	for _, wf := range workflows {
+		if id := db.GetString(wf, "template_id"); !seen[id] {
+			seen[id] = true
+			templateIds = append(templateIds, id)
+		}
+	}
+
+	templates := make(map[string]map[string]interface{})
+	if len(templateIds) > 0 {
+		tplData, _, err := db.Client.
+			From("workflow_templates").
+			Select("id, name, current_version", "", false).
+			In("id", templateIds).
+			Execute()
+
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		var rows []map[string]interface{}
+		json.Unmarshal(tplData, &rows)
+		for _, row := range rows {
+			templates[db.GetString(row, "id")] = row
+		}
+	}
+
+	response := []TemplateStatus{}
+	for _, wf := range workflows {
+		tpl := templates[db.GetString(wf, "template_id")]
+		status := TemplateStatus{
+			WorkflowID:      db.GetString(wf, "id"),
+			WorkflowName:    db.GetString(wf, "name"),
+			TemplateID:      db.GetString(wf, "template_id"),
+			TemplateVersion: getInt(wf, "template_version"),
+		}
+		if tpl != nil {
+			status.TemplateName = db.GetString(tpl, "name")
+			status.LatestVersion = getInt(tpl, "current_version")
+			status.Outdated = status.TemplateVersion < status.LatestVersion
+		}
+		response = append(response, status)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// source is the graph a template version is captured from
+type source struct {
+	WorkflowID     string
+	VersionID      string
+	BusinessUnitID string
+	ClientID       string
+	FlowType       string
+	FlowData       map[string]interface{}
+}
+
+func loadSource(workflowId, versionId string) (*source, error) {
+	wfData, _, err := db.Client.
+		From("test_workflows").
+		Select("id, business_unit_id, flow_data, flow_type", "", false).
+		Eq("id", workflowId).
+		Execute()
+
+	if err != nil {
+		return nil, fmt.Errorf("workflow not found")
+	}
+
+	var workflows []map[string]interface{}
+	if err := json.Unmarshal(wfData, &workflows); err != nil || len(workflows) == 0 {
+		return nil, fmt.Errorf("workflow not found")
+	}
+
+	wf := workflows[0]
+	src := &source{
+		WorkflowID:     workflowId,
+		BusinessUnitID: db.GetString(wf, "business_unit_id"),
+		FlowType:       db.GetString(wf, "flow_type"),
+		FlowData:       getMap(wf, "flow_data"),
+	}
+
+	if versionId != "" {
+		vData, _, err := db.Client.
+			From("workflow_versions").
+			Select("id, flow_data, flow_type", "", false).
+			Eq("id", versionId).
+			Eq("workflow_id", workflowId).
+			Execute()
+
+		if err != nil {
+			return nil, fmt.Errorf("version not found")
+		}
+
+		var versions []map[string]interface{}
+		if err := json.Unmarshal(vData, &versions); err != nil || len(versions) == 0 {
+			return nil, fmt.Errorf("version not found")
+		}
+
+		src.VersionID = versionId
+		src.FlowData = getMap(versions[0], "flow_data")
+		if ft := db.GetString(versions[0], "flow_type"); ft != "" {
+			src.FlowType = ft
+		}
+	}
+
+	if src.FlowData == nil {
+		src.FlowData = map[string]interface{}{
+			"nodes":       []interface{}{},
+			"edges":       []interface{}{},
+			"flowInputs":  "",
+			"flowOutputs": "",
+		}
+	}
+	if src.FlowType == "" {
+		src.FlowType, _ = src.FlowData["flowType"].(string)
+	}
+
+	src.ClientID = auth.ClientOfBU(src.BusinessUnitID)
+	return src, nil
+}
+
+func loadTemplate(templateId string) (map[string]interface{}, error) {
+	data, _, err := db.Client.
+		From("workflow_templates").
+		Select("*", "", false).
+		Eq("id", templateId).
+		Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		return nil, fmt.Errorf("template not found")
+	}
+	return results[0], nil
+}
+
+func loadVersion(templateId string, version int) (map[string]interface{}, error) {
+	data, _, err := db.Client.
+		From("workflow_template_versions").
+		Select("*", "", false).
+		Eq("template_id", templateId).
+		Eq("version", fmt.Sprint(version)).
+		Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		return nil, fmt.Errorf("template version not found")
+	}
+	return results[0], nil
+}
+
+// canUseTemplate: global templates are readable by everyone and writable by
+// admins; client templates follow the client's access rules.
+func canUseTemplate(c *gin.Context, template map[string]interface{}, userId string, write bool) bool {
+	clientId := db.GetString(template, "client_id")
+	if clientId == "" {
+		return !write || auth.IsAdmin(c)
+	}
+	return auth.CanAccessClient(clientId, userId, write)
+}
@@ -0,0 +1,306 @@
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"hypervision_backend/internal/db"
+)
+
+// Parameter types supported by templates
+const (
+	ParamString  = "string"
+	ParamBoolean = "boolean"
+	ParamEnum    = "enum"
+	ParamList    = "list" // e.g. the set of modules to include
+)
+
+// TemplateParameter describes one value that is filled in when a workflow is
+// created from a template.
+//
+// String values are substituted wherever "{{name}}" appears in the flow data.
+// Nodes can be made optional with data.includeIf:
+//   - "name"        keep the node when the parameter is truthy
+//   - "name=value"  keep the node when the parameter equals value, or when a
+//     list parameter contains value
+type TemplateParameter struct {
+	Name        string      `json:"name"`
+	Label       string      `json:"label,omitempty"`
+	Type        string      `json:"type"`
+	Description string      `json:"description,omitempty"`
+	Required    bool        `json:"required"`
+	Default     interface{} `json:"default,omitempty"`
+	Options     []string    `json:"options,omitempty"`
+}
+
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// ValidateParameters checks the parameter definitions of a template
+func ValidateParameters(params []TemplateParameter) error {
+	seen := make(map[string]bool)
+	for _, p := range params {
+		if p.Name == "" {
+			return fmt.Errorf("parameter name is required")
+		}
+		if seen[p.Name] {
+			return fmt.Errorf("duplicate parameter %q", p.Name)
+		}
+		seen[p.Name] = true
+
+		switch p.Type {
+		case ParamString, ParamBoolean, ParamList:
+		case ParamEnum:
+			if len(p.Options) == 0 {
+				return fmt.Errorf("enum parameter %q needs options", p.Name)
+			}
+		default:
+			return fmt.Errorf("parameter %q has unsupported type %q", p.Name, p.Type)
+		}
+	}
+	return nil
+}
+
+// ResolveValues applies defaults and checks the supplied values against the
+// parameter definitions.
+func ResolveValues(params []TemplateParameter, values map[string]interface{}) (map[string]interface{}, error) {
+	resolved := make(map[string]interface{})
+	for _, p := range params {
+		value, ok := values[p.Name]
+		if !ok || value == nil {
+			value = p.Default
+		}
+		if value == nil {
+			if p.Required {
+				return nil, fmt.Errorf("parameter %q is required", p.Name)
+			}
+			continue
+		}
+
+		switch p.Type {
+		case ParamBoolean:
+			if _, ok := value.(bool); !ok {
+				return nil, fmt.Errorf("parameter %q must be a boolean", p.Name)
+			}
+		case ParamEnum:
+			str, _ := value.(string)
+			if !contains(p.Options, str) {
+				return nil, fmt.Errorf("parameter %q must be one of %s", p.Name, strings.Join(p.Options, ", "))
+			}
+		case ParamList:
+			list, ok := toStringList(value)
+			if !ok {
+				return nil, fmt.Errorf("parameter %q must be a list of strings", p.Name)
+			}
+			if len(p.Options) > 0 {
+				for _, item := range list {
+					if !contains(p.Options, item) {
+						return nil, fmt.Errorf("parameter %q does not allow %q", p.Name, item)
+					}
+				}
+			}
+			value = list
+		default:
+			if _, ok := value.(string); !ok {
+				value = fmt.Sprint(value)
+			}
+		}
+		resolved[p.Name] = value
+	}
+	return resolved, nil
+}
+
+// Instantiate produces a concrete flow_data object from template flow data.
+// Optional nodes that are switched off are removed; when a removed node had a
+// single outgoing edge, its incoming edges are re-pointed at that successor
+// so the chain stays connected.
+func Instantiate(flowData map[string]interface{}, values map[string]interface{}) map[string]interface{} {
+	nodes, _ := flowData["nodes"].([]interface{})
+	edges, _ := flowData["edges"].([]interface{})
+
+	removed := make(map[string]bool)
+	var keptNodes []interface{}
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		data, _ := node["data"].(map[string]interface{})
+		if cond, _ := data["includeIf"].(string); cond != "" && !evalInclude(cond, values) {
+			removed[db.GetString(node, "id")] = true
+			continue
+		}
+		if data != nil {
+			delete(data, "includeIf")
+		}
+		keptNodes = append(keptNodes, node)
+	}
+
+	// Drop group children whose parent group was removed
+	for changed := true; changed; {
+		changed = false
+		var next []interface{}
+		for _, n := range keptNodes {
+			node := n.(map[string]interface{})
+			if parent := db.GetString(node, "parentNode"); parent != "" && removed[parent] {
+				removed[db.GetString(node, "id")] = true
+				changed = true
+				continue
+			}
+			next = append(next, node)
+		}
+		keptNodes = next
+	}
+
+	outgoing := make(map[string][]map[string]interface{})
+	for _, e := range edges {
+		if edge, ok := e.(map[string]interface{}); ok {
+			src := db.GetString(edge, "source")
+			outgoing[src] = append(outgoing[src], edge)
+		}
+	}
+
+	// Follow chains of removed single-exit nodes to the first kept node
+	bridge := func(target string) string {
+		for hops := 0; removed[target] && hops < len(nodes); hops++ {
+			outs := outgoing[target]
+			if len(outs) != 1 {
+				return ""
+			}
+			target = db.GetString(outs[0], "target")
+		}
+		if removed[target] {
+			return ""
+		}
+		return target
+	}
+
+	var keptEdges []interface{}
+	seenEdges := make(map[string]bool)
+	for _, e := range edges {
+		edge, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		source := db.GetString(edge, "source")
+		if removed[source] {
+			continue
+		}
+		target := bridge(db.GetString(edge, "target"))
+		if target == "" {
+			continue
+		}
+		key := source + "->" + target + "#" + db.GetString(edge, "sourceHandle")
+		if seenEdges[key] {
+			continue
+		}
+		seenEdges[key] = true
+
+		if target != db.GetString(edge, "target") {
+			edge["target"] = target
+			edge["id"] = source + "-" + target
+			delete(edge, "targetHandle")
+		}
+		keptEdges = append(keptEdges, edge)
+	}
+
+	result := make(map[string]interface{}, len(flowData))
+	for k, v := range flowData {
+		result[k] = v
+	}
+	if keptNodes == nil {
+		keptNodes = []interface{}{}
+	}
+	if keptEdges == nil {
+		keptEdges = []interface{}{}
+	}
+	result["nodes"] = keptNodes
+	result["edges"] = keptEdges
+
+	return substitute(result, values).(map[string]interface{})
+}
+
+// substitute replaces {{name}} placeholders in every string of the value
+func substitute(v interface{}, values map[string]interface{}) interface{} {
+	switch val := v.(type) {
+	case string:
+		return placeholderPattern.ReplaceAllStringFunc(val, func(m string) string {
+			name := placeholderPattern.FindStringSubmatch(m)[1]
+			replacement, ok := values[name]
+			if !ok {
+				return m
+			}
+			if list, ok := replacement.([]string); ok {
+				return strings.Join(list, ", ")
+			}
+			return fmt.Sprint(replacement)
+		})
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = substitute(item, values)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = substitute(item, values)
+		}
+		return val
+	}
+	return v
+}
+
+func evalInclude(cond string, values map[string]interface{}) bool {
+	name, want, hasValue := strings.Cut(cond, "=")
+	name = strings.TrimSpace(name)
+	value, ok := values[name]
+	if !ok {
+		return false
+	}
+
+	if !hasValue {
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string:
+			return v != "" && v != "false"
+		case []string:
+			return len(v) > 0
+		}
+		return value != nil
+	}
+
+	want = strings.TrimSpace(want)
+	switch v := value.(type) {
+	case []string:
+		return contains(v, want)
+	default:
+		return fmt.Sprint(v) == want
+	}
+}
+
+func toStringList(v interface{}) ([]string, bool) {
+	switch val := v.(type) {
+	case []string:
+		return val, true
+	case []interface{}:
+		list := make([]string, 0, len(val))
+		for _, item := range val {
+			str, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			list = append(list, str)
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
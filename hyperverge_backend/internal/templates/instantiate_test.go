@@ -0,0 +1,211 @@
+package templates
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolveValues(t *testing.T) {
+	params := []TemplateParameter{
+		{Name: "country", Type: ParamString, Required: true},
+		{Name: "liveness", Type: ParamBoolean, Default: true},
+		{Name: "tier", Type: ParamEnum, Options: []string{"basic", "full"}, Default: "basic"},
+		{Name: "modules", Type: ParamList, Options: []string{"face", "id", "aml"}},
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		want   map[string]interface{}
+		err    string // part of the error, "" for none
+	}{
+		{
+			name:   "defaults fill what isn't given",
+			values: map[string]interface{}{"country": "ind"},
+			want:   map[string]interface{}{"country": "ind", "liveness": true, "tier": "basic"},
+		},
+		{
+			name:   "given values win over defaults",
+			values: map[string]interface{}{"country": "ind", "liveness": false, "tier": "full", "modules": []interface{}{"face", "aml"}},
+			want:   map[string]interface{}{"country": "ind", "liveness": false, "tier": "full", "modules": []string{"face", "aml"}},
+		},
+		{
+			name:   "null falls back to the default",
+			values: map[string]interface{}{"country": "ind", "tier": nil},
+			want:   map[string]interface{}{"country": "ind", "liveness": true, "tier": "basic"},
+		},
+		{
+			name:   "strings are formatted",
+			values: map[string]interface{}{"country": float64(91)},
+			want:   map[string]interface{}{"country": "91", "liveness": true, "tier": "basic"},
+		},
+		{
+			name:   "missing required parameter",
+			values: map[string]interface{}{"liveness": true},
+			err:    `parameter "country" is required`,
+		},
+		{
+			name:   "required parameter set to null",
+			values: map[string]interface{}{"country": nil},
+			err:    `parameter "country" is required`,
+		},
+		{
+			name:   "boolean of the wrong type",
+			values: map[string]interface{}{"country": "ind", "liveness": "yes"},
+			err:    "must be a boolean",
+		},
+		{
+			name:   "enum value not in the options",
+			values: map[string]interface{}{"country": "ind", "tier": "premium"},
+			err:    "must be one of basic, full",
+		},
+		{
+			name:   "list item not in the options",
+			values: map[string]interface{}{"country": "ind", "modules": []interface{}{"face", "video"}},
+			err:    `does not allow "video"`,
+		},
+		{
+			name:   "list of the wrong type",
+			values: map[string]interface{}{"country": "ind", "modules": []interface{}{"face", float64(1)}},
+			err:    "must be a list of strings",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveValues(params, tt.values)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalInclude(t *testing.T) {
+	values := map[string]interface{}{
+		"liveness": true,
+		"aml":      false,
+		"tier":     "full",
+		"blank":    "",
+		"off":      "false",
+		"modules":  []string{"face", "id"},
+		"none":     []string{},
+	}
+	tests := []struct {
+		cond string
+		want bool
+	}{
+		{"liveness", true},
+		{"aml", false},
+		{"tier", true},
+		{"blank", false},
+		{"off", false},
+		{"modules", true},
+		{"none", false},
+		{"unset", false},
+		{"tier=full", true},
+		{"tier=basic", false},
+		{" tier = full ", true},
+		{"modules=face", true},
+		{"modules=aml", false},
+		{"liveness=true", true},
+		{"unset=x", false},
+	}
+	for _, tt := range tests {
+		if got := evalInclude(tt.cond, values); got != tt.want {
+			t.Errorf("evalInclude(%q) = %v, want %v", tt.cond, got, tt.want)
+		}
+	}
+}
+
+func TestInstantiate(t *testing.T) {
+	// start -> face -> id -> end, where face and id are optional
+	flow := `{
+		"nodes": [
+			{"id": "start", "type": "startNode"},
+			{"id": "face", "type": "moduleNode", "data": {"includeIf": "modules=face", "title": "Face {{country}}"}},
+			{"id": "id", "type": "moduleNode", "data": {"includeIf": "modules=id"}},
+			{"id": "end", "type": "endStatusNode", "data": {"status": "{{status}}"}}
+		],
+		"edges": [
+			{"id": "e1", "source": "start", "target": "face"},
+			{"id": "e2", "source": "face", "target": "id"},
+			{"id": "e3", "source": "id", "target": "end"}
+		]
+	}`
+
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		nodes  string // ids of the kept nodes
+		edges  string // source>target of the kept edges
+		title  string // of the face node
+	}{
+		{
+			name:   "everything included",
+			values: map[string]interface{}{"modules": []string{"face", "id"}, "country": "ind", "status": "auto-approved"},
+			nodes:  "start,face,id,end",
+			edges:  "start>face,face>id,id>end",
+			title:  "Face ind",
+		},
+		{
+			name:   "middle node removed",
+			values: map[string]interface{}{"modules": []string{"face"}},
+			nodes:  "start,face,end",
+			edges:  "start>face,face>end",
+			title:  "Face {{country}}",
+		},
+		{
+			name:   "chain of removed nodes bridged",
+			values: map[string]interface{}{"modules": []string{}},
+			nodes:  "start,end",
+			edges:  "start>end",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var flowData map[string]interface{}
+			if err := json.Unmarshal([]byte(flow), &flowData); err != nil {
+				t.Fatal(err)
+			}
+			got := Instantiate(flowData, tt.values)
+
+			var nodes, edges []string
+			title := ""
+			for _, n := range got["nodes"].([]interface{}) {
+				node := n.(map[string]interface{})
+				nodes = append(nodes, node["id"].(string))
+				data, _ := node["data"].(map[string]interface{})
+				if _, ok := data["includeIf"]; ok {
+					t.Errorf("node %s kept its includeIf", node["id"])
+				}
+				if node["id"] == "face" {
+					title, _ = data["title"].(string)
+				}
+			}
+			for _, e := range got["edges"].([]interface{}) {
+				edge := e.(map[string]interface{})
+				edges = append(edges, edge["source"].(string)+">"+edge["target"].(string))
+			}
+			if strings.Join(nodes, ",") != tt.nodes {
+				t.Errorf("nodes = %v, want %s", nodes, tt.nodes)
+			}
+			if strings.Join(edges, ",") != tt.edges {
+				t.Errorf("edges = %v, want %s", edges, tt.edges)
+			}
+			if title != tt.title {
+				t.Errorf("face title = %q, want %q", title, tt.title)
+			}
+		})
+	}
+}
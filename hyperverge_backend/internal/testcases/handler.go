@@ -0,0 +1,140 @@
+package testcases
+
+import (
+	"errors"
+	"net/http"
+
+	"hypervision_backend/internal/flowgraph"
+
+	"github.com/gin-gonic/gin"
+)
+
+// List returns the test cases of a workflow (GET /workflows/:id/tests)
+func List(c *gin.Context) {
+	_, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	suite, err := Load(meta.WorkflowID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, suite)
+}
+
+// CreateCase adds a test case (POST /workflows/:id/tests)
+func CreateCase(c *gin.Context) {
+	_, meta, ok := flowgraph.ForEdit(c)
+	if !ok {
+		return
+	}
+	var req TestCaseReq
+	if !bind(c, &req) {
+		return
+	}
+	tc, err := Create(meta.WorkflowID, c.GetString("userId"), req)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, tc)
+}
+
+// UpdateCase replaces a test case (PUT /workflows/:id/tests/:caseId)
+func UpdateCase(c *gin.Context) {
+	_, meta, ok := flowgraph.ForEdit(c)
+	if !ok {
+		return
+	}
+	var req TestCaseReq
+	if !bind(c, &req) {
+		return
+	}
+	tc, err := Update(meta.WorkflowID, c.Param("caseId"), req)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, tc)
+}
+
+// DeleteCase removes a test case (DELETE /workflows/:id/tests/:caseId)
+func DeleteCase(c *gin.Context) {
+	_, meta, ok := flowgraph.ForEdit(c)
+	if !ok {
+		return
+	}
+	if err := Delete(meta.WorkflowID, c.Param("caseId")); err != nil {
+		fail(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateSettings sets whether publishing needs every test case to pass
+// (PUT /workflows/:id/tests/settings)
+func UpdateSettings(c *gin.Context) {
+	_, meta, ok := flowgraph.ForEdit(c)
+	if !ok {
+		return
+	}
+	var req SuiteSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := SaveSettings(meta.WorkflowID, req); err != nil {
+		fail(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, req)
+}
+
+// RunSuite runs every test case against the draft, a version or an
+// environment's graph (POST /workflows/:id/tests/run?version_id=&environment_id=)
+func RunSuite(c *gin.Context) {
+	g, meta, ok := flowgraph.FromRequest(c)
+	if !ok {
+		return
+	}
+	suite, err := Load(meta.WorkflowID)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	res, err := Run(g, suite.Cases, meta.Variables)
+	if err != nil {
+		fail(c, err)
+		return
+	}
+	res.Workflow = meta
+	c.JSON(http.StatusOK, res)
+}
+
+// bind decodes and validates a test case; on failure it has already written
+// the error response
+func bind(c *gin.Context, req *TestCaseReq) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+func fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, flowgraph.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
+	case errors.Is(err, ErrConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
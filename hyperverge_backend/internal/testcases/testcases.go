@@ -0,0 +1,354 @@
+// Package testcases keeps named test cases on workflows and runs them with
+// the simulator: each case gives flow inputs and mocked responses, and the
+// end status and path the flow has to reach with them.
+package testcases
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/simulate"
+
+	"github.com/supabase-community/postgrest-go"
+)
+
+var (
+	ErrNotFound = errors.New("test case not found")
+	ErrConflict = errors.New("a test case with this name already exists")
+)
+
+// TestCase is a row of workflow_test_cases
+type TestCase struct {
+	ID             string                   `json:"id"`
+	WorkflowID     string                   `json:"workflow_id"`
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	FlowInputs     map[string]interface{}   `json:"flow_inputs"`
+	Mocks          map[string]simulate.Mock `json:"mocks"`
+	ExpectedStatus string                   `json:"expected_status"`
+	ExpectedPath   []string                 `json:"expected_path"` // node ids or titles
+	CreatedBy      string                   `json:"created_by,omitempty"`
+	CreatedAt      string                   `json:"created_at,omitempty"`
+	UpdatedAt      string                   `json:"updated_at,omitempty"`
+}
+
+// TestCaseReq creates or replaces a test case
+type TestCaseReq struct {
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description"`
+	FlowInputs     map[string]interface{}   `json:"flow_inputs"`
+	Mocks          map[string]simulate.Mock `json:"mocks"`
+	ExpectedStatus string                   `json:"expected_status"` // auto-approved, auto-declined or needs-review
+	ExpectedPath   []string                 `json:"expected_path"`   // node ids or titles, from the start node
+}
+
+// Suite is the test cases of a workflow
+type Suite struct {
+	WorkflowID     string     `json:"workflow_id"`
+	RequirePassing bool       `json:"require_passing"` // publishing needs every case to pass
+	Cases          []TestCase `json:"cases"`
+}
+
+// SuiteSettings changes how publishing uses the suite
+type SuiteSettings struct {
+	RequirePassing bool `json:"require_passing"`
+}
+
+// Diff is one way a run differs from what its test case expects
+type Diff struct {
+	Field    string      `json:"field"` // outcome, status or path
+	Expected interface{} `json:"expected"`
+	Actual   interface{} `json:"actual"`
+	Message  string      `json:"message"`
+}
+
+// CaseResult is the run of one test case
+type CaseResult struct {
+	CaseID string           `json:"case_id"`
+	Name   string           `json:"name"`
+	Passed bool             `json:"passed"`
+	Diffs  []Diff           `json:"diffs"`
+	Run    *simulate.Result `json:"run"`
+}
+
+// SuiteResult is the run of every test case of a workflow
+type SuiteResult struct {
+	Workflow *flowgraph.Meta `json:"workflow,omitempty"`
+	Passed   bool            `json:"passed"`
+	Total    int             `json:"total"`
+	Failed   int             `json:"failed"`
+	Cases    []CaseResult    `json:"cases"`
+}
+
+// Validate checks a test case before it is saved
+func (r TestCaseReq) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch r.ExpectedStatus {
+	case "", flowgraph.StatusApproved, flowgraph.StatusDeclined, flowgraph.StatusNeedsReview:
+	default:
+		return fmt.Errorf("expected_status must be %s, %s or %s", flowgraph.StatusApproved, flowgraph.StatusDeclined, flowgraph.StatusNeedsReview)
+	}
+	if r.ExpectedStatus == "" && len(r.ExpectedPath) == 0 {
+		return fmt.Errorf("expected_status or expected_path is required")
+	}
+	return nil
+}
+
+func (r TestCaseReq) row() map[string]interface{} {
+	row := map[string]interface{}{
+		"name":            strings.TrimSpace(r.Name),
+		"description":     r.Description,
+		"flow_inputs":     r.FlowInputs,
+		"mocks":           r.Mocks,
+		"expected_status": nil,
+		"expected_path":   r.ExpectedPath,
+		"updated_at":      time.Now().UTC().Format(time.RFC3339),
+	}
+	if r.FlowInputs == nil {
+		row["flow_inputs"] = map[string]interface{}{}
+	}
+	if r.Mocks == nil {
+		row["mocks"] = map[string]interface{}{}
+	}
+	if r.ExpectedPath == nil {
+		row["expected_path"] = []string{}
+	}
+	if r.ExpectedStatus != "" {
+		row["expected_status"] = r.ExpectedStatus
+	}
+	return row
+}
+
+// Load returns the suite of a workflow
+func Load(workflowID string) (*Suite, error) {
+	var wfs []map[string]interface{}
+	if err := execute(db.Client.From("test_workflows").Select("require_passing_tests", "", false).Eq("id", workflowID), &wfs); err != nil {
+		return nil, err
+	}
+	if len(wfs) == 0 {
+		return nil, flowgraph.ErrNotFound
+	}
+	required, _ := wfs[0]["require_passing_tests"].(bool)
+
+	cases := []TestCase{}
+	query := db.Client.
+		From("workflow_test_cases").
+		Select("*", "", false).
+		Eq("workflow_id", workflowID).
+		Order("created_at", &postgrest.OrderOpts{Ascending: true})
+	if err := execute(query, &cases); err != nil {
+		return nil, err
+	}
+	return &Suite{WorkflowID: workflowID, RequirePassing: required, Cases: cases}, nil
+}
+
+// Create adds a test case to a workflow
+func Create(workflowID, userID string, req TestCaseReq) (*TestCase, error) {
+	if err := checkName(workflowID, "", req.Name); err != nil {
+		return nil, err
+	}
+	row := req.row()
+	row["workflow_id"] = workflowID
+	row["created_by"] = userID
+
+	var out []TestCase
+	if err := execute(db.Client.From("workflow_test_cases").Insert(row, false, "", "", ""), &out); err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("failed to create test case")
+	}
+	return &out[0], nil
+}
+
+// Update replaces a test case
+func Update(workflowID, caseID string, req TestCaseReq) (*TestCase, error) {
+	if err := checkName(workflowID, caseID, req.Name); err != nil {
+		return nil, err
+	}
+	var out []TestCase
+	query := db.Client.
+		From("workflow_test_cases").
+		Update(req.row(), "", "").
+		Eq("id", caseID).
+		Eq("workflow_id", workflowID)
+	if err := execute(query, &out); err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, ErrNotFound
+	}
+	return &out[0], nil
+}
+
+// Delete removes a test case
+func Delete(workflowID, caseID string) error {
+	var out []TestCase
+	query := db.Client.
+		From("workflow_test_cases").
+		Delete("", "").
+		Eq("id", caseID).
+		Eq("workflow_id", workflowID)
+	if err := execute(query, &out); err != nil {
+		return err
+	}
+	if len(out) == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SaveSettings changes whether publishing needs a passing suite
+func SaveSettings(workflowID string, settings SuiteSettings) error {
+	var out []map[string]interface{}
+	query := db.Client.
+		From("test_workflows").
+		Update(map[string]interface{}{"require_passing_tests": settings.RequirePassing}, "", "").
+		Eq("id", workflowID)
+	if err := execute(query, &out); err != nil {
+		return err
+	}
+	if len(out) == 0 {
+		return flowgraph.ErrNotFound
+	}
+	return nil
+}
+
+func checkName(workflowID, caseID, name string) error {
+	var rows []map[string]interface{}
+	query := db.Client.
+		From("workflow_test_cases").
+		Select("id", "", false).
+		Eq("workflow_id", workflowID).
+		Eq("name", strings.TrimSpace(name))
+	if err := execute(query, &rows); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if id, _ := row["id"].(string); id != caseID {
+			return ErrConflict
+		}
+	}
+	return nil
+}
+
+// Run runs every case of a suite against g with the given environment
+// variables
+func Run(g *flowgraph.Graph, cases []TestCase, variables map[string]interface{}) (*SuiteResult, error) {
+	ch, err := catalog.NewRevisionChecker()
+	if err != nil {
+		return nil, err
+	}
+	resolve := analysis.CatalogResolver(ch)
+
+	res := &SuiteResult{Passed: true, Total: len(cases), Cases: []CaseResult{}}
+	for _, tc := range cases {
+		run := simulate.Run(g, resolve, simulate.Request{FlowInputs: tc.FlowInputs, Mocks: tc.Mocks, Variables: variables})
+		cr := CaseResult{CaseID: tc.ID, Name: tc.Name, Diffs: compare(g, tc, run), Run: run}
+		cr.Passed = len(cr.Diffs) == 0
+		if !cr.Passed {
+			res.Passed = false
+			res.Failed++
+		}
+		res.Cases = append(res.Cases, cr)
+	}
+	return res, nil
+}
+
+// Gate runs the suite of a workflow that requires a passing one before it
+// publishes g. It returns nil when the workflow doesn't require one.
+func Gate(workflowID string, g *flowgraph.Graph, variables map[string]interface{}) (*SuiteResult, error) {
+	suite, err := Load(workflowID)
+	if err != nil || !suite.RequirePassing {
+		return nil, err
+	}
+	return Run(g, suite.Cases, variables)
+}
+
+func compare(g *flowgraph.Graph, tc TestCase, run *simulate.Result) []Diff {
+	diffs := []Diff{}
+	if run.Outcome != simulate.OutcomeCompleted {
+		diffs = append(diffs, Diff{
+			Field:    "outcome",
+			Expected: simulate.OutcomeCompleted,
+			Actual:   run.Outcome,
+			Message:  "The run didn't reach an end status: " + run.Reason,
+		})
+	}
+	if tc.ExpectedStatus != "" && run.Status != tc.ExpectedStatus {
+		diffs = append(diffs, Diff{
+			Field:    "status",
+			Expected: tc.ExpectedStatus,
+			Actual:   run.Status,
+			Message:  fmt.Sprintf("Expected to end %s, ended %s", tc.ExpectedStatus, describeStatus(run.Status)),
+		})
+	}
+	if len(tc.ExpectedPath) > 0 {
+		if d := comparePath(g, tc.ExpectedPath, run.Path); d != nil {
+			diffs = append(diffs, *d)
+		}
+	}
+	return diffs
+}
+
+// comparePath finds where the run left the expected path. Steps of the
+// expected path may be node ids or titles; the start node may be left out.
+func comparePath(g *flowgraph.Graph, expected, path []string) *Diff {
+	var actual, labels []string
+	for _, id := range path {
+		n := g.Node(id)
+		if n == nil || n.Type == flowgraph.NodeStart {
+			continue
+		}
+		actual = append(actual, id)
+		labels = append(labels, n.Label())
+	}
+	if len(expected) > 0 && len(path) > 0 && len(actual) < len(path) {
+		if start := g.Node(path[0]); start != nil && sameNode(expected[0], start.ID, start.Label()) {
+			expected = expected[1:]
+		}
+	}
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		switch {
+		case i >= len(expected):
+			return &Diff{Field: "path", Expected: expected, Actual: labels,
+				Message: fmt.Sprintf("The run went on past the expected path, to %s", labels[i])}
+		case i >= len(actual):
+			return &Diff{Field: "path", Expected: expected, Actual: labels,
+				Message: fmt.Sprintf("The run stopped before step %d, %s", i+1, expected[i])}
+		case !sameNode(expected[i], actual[i], labels[i]):
+			return &Diff{Field: "path", Expected: expected, Actual: labels,
+				Message: fmt.Sprintf("At step %d the run went to %s instead of %s", i+1, labels[i], expected[i])}
+		}
+	}
+	return nil
+}
+
+func sameNode(expected, id, label string) bool {
+	expected = strings.TrimSpace(expected)
+	return expected == id || strings.EqualFold(expected, label)
+}
+
+func describeStatus(status string) string {
+	if status == "" {
+		return "without a status"
+	}
+	return status
+}
+
+func execute(query *postgrest.FilterBuilder, out interface{}) error {
+	data, _, err := query.Execute()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
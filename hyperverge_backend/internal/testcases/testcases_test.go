@@ -0,0 +1,140 @@
+package testcases
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/simulate"
+)
+
+// retryGraph reads an ID, retrying on failure, then routes on a condition:
+//
+//	start -> Read ID -success-> Check -true-> Approved
+//	            ^  \-failure-> Retry -/   \-false-> Declined
+//	            \--------------/
+func retryGraph(t *testing.T) *flowgraph.Graph {
+	t.Helper()
+	nodes := []string{
+		`{"id": "start", "type": "startNode"}`,
+		`{"id": "read", "type": "apiModuleNode", "data": {
+			"title": "Read ID",
+			"curlExample": "curl 'https://ind.idv.hyperverge.co/v1/readId' --form 'countryId=ind'",
+			"outputs": [{"name": "age", "type": "number"}],
+			"successResponse": {"status": "success", "result": {"age": 30}}
+		}}`,
+		`{"id": "retry", "type": "conditionNode", "data": {"title": "Retry", "condition": "retries < 3"}}`,
+		`{"id": "check", "type": "conditionNode", "data": {"title": "Check", "condition": "age >= 18"}}`,
+		`{"id": "approved", "type": "endStatusNode", "data": {"title": "Approved", "status": "auto-approved"}}`,
+		`{"id": "declined", "type": "endStatusNode", "data": {"title": "Declined", "status": "auto-declined"}}`,
+	}
+	var edges []string
+	for i, e := range []string{
+		"start read", "read check success", "read retry failure",
+		"retry read true", "retry declined false",
+		"check approved true", "check declined false",
+	} {
+		parts := strings.Fields(e)
+		handle := ""
+		if len(parts) > 2 {
+			handle = parts[2]
+		}
+		edges = append(edges, fmt.Sprintf(`{"id": "e%d", "source": %q, "target": %q, "sourceHandle": %q}`, i, parts[0], parts[1], handle))
+	}
+	g, err := flowgraph.Parse(fmt.Sprintf(`{"nodes": [%s], "edges": [%s]}`, strings.Join(nodes, ","), strings.Join(edges, ",")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestCompare(t *testing.T) {
+	g := retryGraph(t)
+	young := map[string]simulate.Mock{"read": {Response: map[string]interface{}{"age": float64(16)}}}
+	failing := map[string]simulate.Mock{"Read ID": {StatusCode: 500}}
+
+	tests := []struct {
+		name  string
+		tc    TestCase
+		diffs []string // Field of each diff
+	}{
+		{
+			name: "expected status",
+			tc:   TestCase{ExpectedStatus: flowgraph.StatusApproved},
+		},
+		{
+			name:  "wrong status",
+			tc:    TestCase{ExpectedStatus: flowgraph.StatusApproved, Mocks: young},
+			diffs: []string{"status"},
+		},
+		{
+			name: "path by ids",
+			tc:   TestCase{ExpectedPath: []string{"read", "check", "approved"}},
+		},
+		{
+			name: "path by titles with the start node",
+			tc:   TestCase{ExpectedPath: []string{"start", "read id", "Check", "Approved"}},
+		},
+		{
+			name:  "run leaves the path",
+			tc:    TestCase{ExpectedPath: []string{"read", "check", "approved"}, Mocks: young},
+			diffs: []string{"path"},
+		},
+		{
+			name:  "run goes past the path",
+			tc:    TestCase{ExpectedPath: []string{"read", "check"}},
+			diffs: []string{"path"},
+		},
+		{
+			name: "retry loop ends on the flow input",
+			tc: TestCase{
+				FlowInputs:     map[string]interface{}{"retries": float64(3)},
+				Mocks:          failing,
+				ExpectedStatus: flowgraph.StatusDeclined,
+				ExpectedPath:   []string{"read", "retry", "declined"},
+			},
+		},
+		{
+			name: "retry loop that never ends",
+			tc: TestCase{
+				FlowInputs:     map[string]interface{}{"retries": float64(0)},
+				Mocks:          failing,
+				ExpectedStatus: flowgraph.StatusDeclined,
+			},
+			diffs: []string{"outcome", "status"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := simulate.Run(g, analysis.CatalogResolver(nil), simulate.Request{FlowInputs: tt.tc.FlowInputs, Mocks: tt.tc.Mocks})
+			var fields []string
+			for _, d := range compare(g, tt.tc, run) {
+				fields = append(fields, d.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.diffs, ",") {
+				t.Errorf("diffs = %v, want %v", fields, tt.diffs)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  TestCaseReq
+		ok   bool
+	}{
+		{"status", TestCaseReq{Name: "adult", ExpectedStatus: flowgraph.StatusApproved}, true},
+		{"path", TestCaseReq{Name: "adult", ExpectedPath: []string{"read"}}, true},
+		{"no name", TestCaseReq{Name: "  ", ExpectedStatus: flowgraph.StatusApproved}, false},
+		{"unknown status", TestCaseReq{Name: "adult", ExpectedStatus: "approved"}, false},
+		{"nothing expected", TestCaseReq{Name: "adult"}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.req.Validate(); (err == nil) != tt.ok {
+			t.Errorf("%s: Validate() = %v, want ok %v", tt.name, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,421 @@
+// internal/variables/handler.go
+package variables
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Variable sources, in resolution order (later sources override earlier ones)
+const (
+	SourceClient       = "client"
+	SourceBusinessUnit = "business_unit"
+	SourceEnvironment  = "environment"
+)
+
+// UpdateVariablesReq is a bulk edit: keys in Set are added or replaced,
+// keys in Unset are removed. Everything else is left untouched.
+type UpdateVariablesReq struct {
+	Set   map[string]interface{} `json:"set"`
+	Unset []string               `json:"unset"`
+}
+
+type VariablesResponse struct {
+	OwnerType string                 `json:"owner_type"`
+	OwnerID   string                 `json:"owner_id"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// ResolvedVariable is the effective value of a key plus where it came from
+type ResolvedVariable struct {
+	Value      interface{}       `json:"value"`
+	Source     string            `json:"source"`
+	SourceID   string            `json:"source_id"`
+	Overridden []OverriddenValue `json:"overridden,omitempty"`
+}
+
+// OverriddenValue is a parent value hidden by a more specific level
+type OverriddenValue struct {
+	Value    interface{} `json:"value"`
+	Source   string      `json:"source"`
+	SourceID string      `json:"source_id"`
+}
+
+type ResolvedResponse struct {
+	EnvironmentID  string                      `json:"environment_id"`
+	BusinessUnitID string                      `json:"business_unit_id"`
+	ClientID       string                      `json:"client_id"`
+	Variables      map[string]ResolvedVariable `json:"variables"`
+	Keys           []string                    `json:"keys"`
+}
+
+// Layer is one level of the hierarchy taking part in resolution
+type Layer struct {
+	Source    string
+	SourceID  string
+	Variables map[string]interface{}
+}
+
+func getMap(m map[string]interface{}, key string) map[string]interface{} {
+	if val, ok := m[key]; ok {
+		// Handle JSON string stored in DB
+		if str, ok := val.(string); ok {
+			var result map[string]interface{}
+			if err := json.Unmarshal([]byte(str), &result); err == nil && result != nil {
+				return result
+			}
+		}
+		// Handle map directly
+		if mp, ok := val.(map[string]interface{}); ok {
+			return mp
+		}
+	}
+	return make(map[string]interface{})
+}
+
+// Resolve merges the layers in order. Later layers win; hidden parent
+// values are kept in Overridden so the UI can explain the result.
+func Resolve(layers ...Layer) map[string]ResolvedVariable {
+	resolved := make(map[string]ResolvedVariable)
+	for _, layer := range layers {
+		for key, value := range layer.Variables {
+			next := ResolvedVariable{
+				Value:    value,
+				Source:   layer.Source,
+				SourceID: layer.SourceID,
+			}
+			if prev, ok := resolved[key]; ok {
+				next.Overridden = append(prev.Overridden, OverriddenValue{
+					Value:    prev.Value,
+					Source:   prev.Source,
+					SourceID: prev.SourceID,
+				})
+			}
+			resolved[key] = next
+		}
+	}
+	return resolved
+}
+
+// Flatten drops the provenance and returns plain key/value pairs
+func Flatten(resolved map[string]ResolvedVariable) map[string]interface{} {
+	flat := make(map[string]interface{}, len(resolved))
+	for key, v := range resolved {
+		flat[key] = v.Value
+	}
+	return flat
+}
+
+// secretName matches the keys strip_secret_variables drops when a business
+// unit is cloned without secrets
+var secretName = regexp.MustCompile(`(?i)(secret|password|token|api_?key|app_?key|credential)`)
+
+// IsSecret reports whether a variable name looks like it holds a credential
+func IsSecret(key string) bool {
+	return secretName.MatchString(key)
+}
+
+// ParentLayers loads the client and BU layers for a business unit
+func ParentLayers(buId string) ([]Layer, string, error) {
+	buData, _, err := db.Client.
+		From("test_business_units").
+		Select("id, client_id, variables", "", false).
+		Eq("id", buId).
+		Execute()
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buResults []map[string]interface{}
+	if err := json.Unmarshal(buData, &buResults); err != nil || len(buResults) == 0 {
+		return nil, "", fmt.Errorf("business unit not found")
+	}
+
+	bu := buResults[0]
+	clientId := db.GetString(bu, "client_id")
+
+	clientData, _, err := db.Client.
+		From("test_clients").
+		Select("id, variables", "", false).
+		Eq("id", clientId).
+		Execute()
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	var clientResults []map[string]interface{}
+	clientVars := make(map[string]interface{})
+	if json.Unmarshal(clientData, &clientResults) == nil && len(clientResults) > 0 {
+		clientVars = getMap(clientResults[0], "variables")
+	}
+
+	return []Layer{
+		{Source: SourceClient, SourceID: clientId, Variables: clientVars},
+		{Source: SourceBusinessUnit, SourceID: buId, Variables: getMap(bu, "variables")},
+	}, clientId, nil
+}
+
+// ResolveForEnvironment resolves the full variable set of an environment row
+// (as returned by PostgREST) against its business unit and client.
+func ResolveForEnvironment(env map[string]interface{}) (map[string]ResolvedVariable, error) {
+	layers, _, err := ParentLayers(db.GetString(env, "business_unit_id"))
+	if err != nil {
+		return nil, err
+	}
+
+	layers = append(layers, Layer{
+		Source:    SourceEnvironment,
+		SourceID:  db.GetString(env, "id"),
+		Variables: getMap(env, "variables"),
+	})
+
+	return Resolve(layers...), nil
+}
+
+// GetClientVariables returns the variables defined at client level
+func GetClientVariables(c *gin.Context) {
+	clientId := c.Param("id")
+	userId := c.GetString("userId")
+
+	data, _, err := db.Client.
+		From("test_clients").
+		Select("id, variables", "", false).
+		Eq("id", clientId).
+		Eq("owner_id", userId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "database error"})
+		return
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "client not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, VariablesResponse{
+		OwnerType: SourceClient,
+		OwnerID:   clientId,
+		Variables: getMap(results[0], "variables"),
+	})
+}
+
+// UpdateClientVariables bulk edits the client level variables. Every BU and
+// environment under the client picks up the change on its next resolution.
+func UpdateClientVariables(c *gin.Context) {
+	clientId := c.Param("id")
+	userId := c.GetString("userId")
+
+	var req UpdateVariablesReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	data, _, err := db.Client.
+		From("test_clients").
+		Select("id, variables", "", false).
+		Eq("id", clientId).
+		Eq("owner_id", userId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "database error"})
+		return
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to edit this client"})
+		return
+	}
+
+	vars := applyEdit(getMap(results[0], "variables"), req)
+	if err := saveVariables("test_clients", clientId, vars); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, VariablesResponse{
+		OwnerType: SourceClient,
+		OwnerID:   clientId,
+		Variables: vars,
+	})
+}
+
+// GetBUVariables returns the variables defined at business unit level
+func GetBUVariables(c *gin.Context) {
+	buId := c.Param("buId")
+	userId := c.GetString("userId")
+
+	if !auth.CanAccessBU(buId, userId, false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+
+	data, _, err := db.Client.
+		From("test_business_units").
+		Select("id, variables", "", false).
+		Eq("id", buId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "database error"})
+		return
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "business unit not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, VariablesResponse{
+		OwnerType: SourceBusinessUnit,
+		OwnerID:   buId,
+		Variables: getMap(results[0], "variables"),
+	})
+}
+
+// UpdateBUVariables bulk edits the business unit level variables
+func UpdateBUVariables(c *gin.Context) {
+	buId := c.Param("buId")
+	userId := c.GetString("userId")
+
+	var req UpdateVariablesReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !auth.CanAccessBU(buId, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to edit this business unit"})
+		return
+	}
+
+	data, _, err := db.Client.
+		From("test_business_units").
+		Select("id, variables", "", false).
+		Eq("id", buId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "database error"})
+		return
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "business unit not found"})
+		return
+	}
+
+	vars := applyEdit(getMap(results[0], "variables"), req)
+	if err := saveVariables("test_business_units", buId, vars); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, VariablesResponse{
+		OwnerType: SourceBusinessUnit,
+		OwnerID:   buId,
+		Variables: vars,
+	})
+}
+
+// GetResolved returns the effective variables of an environment together
+// with the level each value came from.
+func GetResolved(c *gin.Context) {
+	envId := c.Param("id")
+	userId := c.GetString("userId")
+
+	data, _, err := db.Client.
+		From("test_environments").
+		Select("id, business_unit_id, variables", "", false).
+		Eq("id", envId).
+		Execute()
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "environment not found"})
+		return
+	}
+
+	var envResults []map[string]interface{}
+	if err := json.Unmarshal(data, &envResults); err != nil || len(envResults) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "environment not found"})
+		return
+	}
+
+	env := envResults[0]
+	buId := db.GetString(env, "business_unit_id")
+
+	if !auth.CanAccessBU(buId, userId, false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized"})
+		return
+	}
+
+	layers, clientId, err := ParentLayers(buId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	layers = append(layers, Layer{
+		Source:    SourceEnvironment,
+		SourceID:  envId,
+		Variables: getMap(env, "variables"),
+	})
+
+	resolved := Resolve(layers...)
+	keys := make([]string, 0, len(resolved))
+	for key := range resolved {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	c.JSON(http.StatusOK, ResolvedResponse{
+		EnvironmentID:  envId,
+		BusinessUnitID: buId,
+		ClientID:       clientId,
+		Variables:      resolved,
+		Keys:           keys,
+	})
+}
+
+func applyEdit(vars map[string]interface{}, req UpdateVariablesReq) map[string]interface{} {
+	for key, value := range req.Set {
+		vars[key] = value
+	}
+	for _, key := range req.Unset {
+		delete(vars, key)
+	}
+	return vars
+}
+
+func saveVariables(table, id string, vars map[string]interface{}) error {
+	variablesJSON, _ := json.Marshal(vars)
+
+	_, _, err := db.Client.
+		From(table).
+		Update(map[string]interface{}{
+			"variables":  string(variablesJSON),
+			"updated_at": time.Now().UTC().Format(time.RFC3339),
+		}, "", "").
+		Eq("id", id).
+		Execute()
+
+	return err
+}
@@ -0,0 +1,80 @@
+package variables
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	client := Layer{Source: SourceClient, SourceID: "c1", Variables: map[string]interface{}{"baseUrl": "https://a.io", "region": "ind", "timeout": float64(30)}}
+	bu := Layer{Source: SourceBusinessUnit, SourceID: "b1", Variables: map[string]interface{}{"baseUrl": "https://b.io", "retries": float64(2)}}
+	env := Layer{Source: SourceEnvironment, SourceID: "e1", Variables: map[string]interface{}{"baseUrl": "https://e.io", "region": nil}}
+
+	tests := []struct {
+		name       string
+		layers     []Layer
+		key        string
+		want       interface{}
+		source     string
+		overridden []string // SourceID of the hidden values, oldest first
+	}{
+		{"only the client sets it", []Layer{client, bu, env}, "timeout", float64(30), SourceClient, nil},
+		{"only the BU sets it", []Layer{client, bu, env}, "retries", float64(2), SourceBusinessUnit, nil},
+		{"the environment wins", []Layer{client, bu, env}, "baseUrl", "https://e.io", SourceEnvironment, []string{"c1", "b1"}},
+		{"the BU wins without an environment", []Layer{client, bu}, "baseUrl", "https://b.io", SourceBusinessUnit, []string{"c1"}},
+		{"null still overrides", []Layer{client, bu, env}, "region", nil, SourceEnvironment, []string{"c1"}},
+		{"order decides, not the source", []Layer{env, client}, "baseUrl", "https://a.io", SourceClient, []string{"e1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Resolve(tt.layers...)[tt.key]
+			if !ok {
+				t.Fatalf("%s is not resolved", tt.key)
+			}
+			if !reflect.DeepEqual(got.Value, tt.want) || got.Source != tt.source {
+				t.Errorf("%s = %v from %s, want %v from %s", tt.key, got.Value, got.Source, tt.want, tt.source)
+			}
+			var hidden []string
+			for _, o := range got.Overridden {
+				hidden = append(hidden, o.SourceID)
+			}
+			if !reflect.DeepEqual(hidden, tt.overridden) {
+				t.Errorf("overridden = %v, want %v", hidden, tt.overridden)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	got := Flatten(Resolve(
+		Layer{Source: SourceClient, Variables: map[string]interface{}{"a": "1", "b": "1"}},
+		Layer{Source: SourceBusinessUnit, Variables: map[string]interface{}{"b": "2"}},
+	))
+	if want := map[string]interface{}{"a": "1", "b": "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %v, want %v", got, want)
+	}
+}
+
+func TestIsSecret(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"clientSecret", true},
+		{"DB_PASSWORD", true},
+		{"accessToken", true},
+		{"api_key", true},
+		{"apiKey", true},
+		{"APPKEY", true},
+		{"app_key", true},
+		{"credentials", true},
+		{"baseUrl", false},
+		{"appId", false},
+		{"api-key", false}, // strip_secret_variables only allows an underscore
+	}
+	for _, tt := range tests {
+		if got := IsSecret(tt.key); got != tt.want {
+			t.Errorf("IsSecret(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
@@ -4,9 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/auth"
 	"hypervision_backend/internal/db"
+	"hypervision_backend/internal/flowgraph"
+	"hypervision_backend/internal/testcases"
+	"hypervision_backend/internal/variables"
 
 	"github.com/gin-gonic/gin"
 	"github.com/supabase-community/postgrest-go"
@@ -15,9 +21,12 @@ import (
 type PublishReq struct {
 	VersionNumber  string `json:"version_number"`
 	VersionDetails string `json:"version_details"`
+	Force          bool   `json:"force"` // Publish even with data-mapping errors
 }
 
-// Publish creates a new published version from the current workflow snapshot
+// Publish creates a new published version from the current workflow snapshot.
+// A snapshot whose steps miss required inputs isn't published unless forced;
+// one that fails the test cases of a workflow requiring passing ones never is.
 func Publish(c *gin.Context) {
 	workflowId := c.Param("id")
 	userId := c.GetString("userId")
@@ -41,12 +50,10 @@ func Publish(c *gin.Context) {
 	// Get current snapshot
 	wfData, _, err := db.Client.
 		From("test_workflows").
-		Select("flow_data, flow_type", "", false).
+		Select("flow_data, flow_type, business_unit_id", "", false).
 		Eq("id", workflowId).
 		Execute()
 
-	fmt.Printf("[versions] fetch workflow: err=%v data=%s\n", err, string(wfData))
-
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch workflow: " + err.Error()})
 		return
@@ -54,37 +61,77 @@ func Publish(c *gin.Context) {
 
 	var workflows []map[string]interface{}
 	if err := json.Unmarshal(wfData, &workflows); err != nil || len(workflows) == 0 {
-		fmt.Printf("[versions] workflow parse error or empty: err=%v len=%d\n", err, len(workflows))
 		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
 		return
 	}
 
 	wf := workflows[0]
-	flowDataStr, _ := wf["flow_data"].(string)
+	flowData := wf["flow_data"]
+	if s, ok := flowData.(string); flowData == nil || ok && strings.TrimSpace(s) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "workflow has no draft to publish"})
+		return
+	}
 	flowType, _ := wf["flow_type"].(string)
 
+	g, err := flowgraph.Parse(flowData)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	// The version may be deployed to any environment, so only the
+	// client and BU variables they all share count as available
+	meta := &flowgraph.Meta{WorkflowID: workflowId, BusinessUnitID: db.GetString(wf, "business_unit_id"), FlowType: flowType, Origin: flowgraph.OriginDraft}
+	if layers, _, err := variables.ParentLayers(meta.BusinessUnitID); err == nil {
+		meta.Variables = variables.Flatten(variables.Resolve(layers...))
+	}
+
+	if !req.Force {
+		result, err := analysis.Check(g, meta)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to analyse workflow: " + err.Error()})
+			return
+		}
+		if !result.Passed() {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":    fmt.Sprintf("workflow has %d data-mapping errors; fix them or publish with force", result.Errors),
+				"analysis": result,
+			})
+			return
+		}
+	}
+
+	// A workflow requiring passing tests can't be published around them
+	suite, err := testcases.Gate(workflowId, g, meta.Variables)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to run test cases: " + err.Error()})
+		return
+	}
+	if suite != nil && !suite.Passed {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": fmt.Sprintf("%d of %d test cases fail; fix them before publishing", suite.Failed, suite.Total),
+			"tests": suite,
+		})
+		return
+	}
+
 	now := time.Now().UTC().Format(time.RFC3339)
 
 	versionInsert := map[string]interface{}{
 		"workflow_id":     workflowId,
 		"version_number":  req.VersionNumber,
 		"version_details": req.VersionDetails,
-		"flow_data":       flowDataStr,
+		"flow_data":       flowData,
 		"flow_type":       flowType,
 		"published_by":    userId,
 		"published_at":    now,
 		"created_at":      now,
 	}
 
-	fmt.Printf("[versions] inserting version: workflow_id=%s version_number=%s\n", workflowId, req.VersionNumber)
-
 	vData, _, err := db.Client.
 		From("workflow_versions").
 		Insert(versionInsert, false, "", "", "").
 		Execute()
 
-	fmt.Printf("[versions] insert result: err=%v data=%s\n", err, string(vData))
-
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create version: " + err.Error()})
 		return
@@ -92,16 +139,14 @@ func Publish(c *gin.Context) {
 
 	var versionResults []map[string]interface{}
 	if err := json.Unmarshal(vData, &versionResults); err != nil || len(versionResults) == 0 {
-		fmt.Printf("[versions] parse error or empty: err=%v len=%d\n", err, len(versionResults))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to parse version result"})
 		return
 	}
 
-	versionId := getString(versionResults[0], "id")
-	fmt.Printf("[versions] created version id=%s, updating active_published_version_id\n", versionId)
+	versionId := db.GetString(versionResults[0], "id")
 
 	// Set as active published version
-	updateData, _, err := db.Client.
+	_, _, err = db.Client.
 		From("test_workflows").
 		Update(map[string]interface{}{
 			"active_published_version_id": versionId,
@@ -109,8 +154,6 @@ func Publish(c *gin.Context) {
 		Eq("id", workflowId).
 		Execute()
 
-	fmt.Printf("[versions] update active version: err=%v data=%s\n", err, string(updateData))
-
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to set active version: " + err.Error()})
 		return
@@ -269,79 +312,18 @@ func SetActiveVersion(c *gin.Context) {
 }
 
 func canWriteWorkflow(workflowId, userId string) bool {
-	fmt.Printf("[versions] canWriteWorkflow: workflowId=%s userId=%s\n", workflowId, userId)
-
 	wfData, _, err := db.Client.
 		From("test_workflows").
 		Select("business_unit_id", "", false).
 		Eq("id", workflowId).
 		Execute()
-
-	fmt.Printf("[versions] test_workflows query: err=%v data=%s\n", err, string(wfData))
-
 	if err != nil {
 		return false
 	}
 
 	var wfs []map[string]interface{}
 	if err := json.Unmarshal(wfData, &wfs); err != nil || len(wfs) == 0 {
-		fmt.Printf("[versions] no workflow found\n")
 		return false
 	}
-
-	buId, _ := wfs[0]["business_unit_id"].(string)
-	fmt.Printf("[versions] buId=%s\n", buId)
-
-	buResult, _, buErr := db.Client.
-		From("test_business_units").
-		Select("client_id", "", false).
-		Eq("id", buId).
-		Execute()
-
-	fmt.Printf("[versions] test_business_units query: err=%v data=%s\n", buErr, string(buResult))
-
-	var bus []map[string]interface{}
-	if json.Unmarshal(buResult, &bus) == nil && len(bus) > 0 {
-		clientId, _ := bus[0]["client_id"].(string)
-		fmt.Printf("[versions] clientId=%s\n", clientId)
-
-		clientResult, _, clientErr := db.Client.
-			From("test_clients").
-			Select("id", "", false).
-			Eq("id", clientId).
-			Eq("owner_id", userId).
-			Execute()
-
-		fmt.Printf("[versions] test_clients query: err=%v data=%s\n", clientErr, string(clientResult))
-
-		var clients []map[string]interface{}
-		if json.Unmarshal(clientResult, &clients) == nil && len(clients) > 0 {
-			fmt.Printf("[versions] access granted via client ownership\n")
-			return true
-		}
-	}
-
-	permResult, _, permErr := db.Client.
-		From("test_bu_permissions").
-		Select("id", "", false).
-		Eq("business_unit_id", buId).
-		Eq("user_id", userId).
-		Eq("role", "editor").
-		Execute()
-
-	fmt.Printf("[versions] test_bu_permissions query: err=%v data=%s\n", permErr, string(permResult))
-
-	var perms []map[string]interface{}
-	granted := json.Unmarshal(permResult, &perms) == nil && len(perms) > 0
-	fmt.Printf("[versions] access granted via BU permission: %v\n", granted)
-	return granted
-}
-
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
+	return auth.CanAccessBU(db.GetString(wfs[0], "business_unit_id"), userId, true)
 }
@@ -30,15 +30,6 @@ type WorkflowEnvironment struct {
 	WorkflowName    string `json:"workflow_name,omitempty"`
 }
 
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 func getMap(m map[string]interface{}, key string) map[string]interface{} {
 	if val, ok := m[key]; ok {
 		if str, ok := val.(string); ok {
@@ -78,7 +69,7 @@ func Link(c *gin.Context) {
 	}
 
 	wf := wfResults[0]
-	buId := getString(wf, "business_unit_id")
+	buId := db.GetString(wf, "business_unit_id")
 
 	// 2. Verify access to environment
 	envData, _, err := db.Client.
@@ -99,7 +90,7 @@ func Link(c *gin.Context) {
 	}
 
 	env := envResults[0]
-	envBuId := getString(env, "business_unit_id")
+	envBuId := db.GetString(env, "business_unit_id")
 
 	// 3. Check if workflow and environment belong to same BU
 	if buId != envBuId {
@@ -126,7 +117,7 @@ func Link(c *gin.Context) {
 	}
 
 	buResult := buResults[0]
-	clientId := getString(buResult, "client_id")
+	clientId := db.GetString(buResult, "client_id")
 
 	// Check user access
 	clientData, _, clientErr := db.Client.
@@ -218,7 +209,7 @@ func Unlink(c *gin.Context) {
 	}
 
 	wf := wfResults[0]
-	buId := getString(wf, "business_unit_id")
+	buId := db.GetString(wf, "business_unit_id")
 
 	// Verify user permissions on BU
 	buData, _, err := db.Client.
@@ -231,7 +222,7 @@ func Unlink(c *gin.Context) {
 		var buResults []map[string]interface{}
 		if err := json.Unmarshal(buData, &buResults); err == nil && len(buResults) > 0 {
 			buResult := buResults[0]
-			clientId := getString(buResult, "client_id")
+			clientId := db.GetString(buResult, "client_id")
 
 			clientData, _, clientErr := db.Client.
 				From("test_clients").
@@ -309,16 +300,16 @@ func ListByWorkflow(c *gin.Context) {
 		}
 
 		response = append(response, WorkflowEnvironment{
-			ID:               getString(res, "id"),
-			WorkflowID:       getString(res, "workflow_id"),
-			EnvironmentID:    getString(res, "environment_id"),
+			ID:               db.GetString(res, "id"),
+			WorkflowID:       db.GetString(res, "workflow_id"),
+			EnvironmentID:    db.GetString(res, "environment_id"),
 			FlowDataOverride: getMap(res, "flow_data_override"),
 			IsActive:         res["is_active"] == true, // simplified boolean check
-			DeployedAt:       getString(res, "deployed_at"),
-			CreatedAt:        getString(res, "created_at"),
-			UpdatedAt:        getString(res, "updated_at"),
-			EnvironmentName:  getString(env, "name"),
-			EnvironmentType:  getString(env, "type"),
+			DeployedAt:       db.GetString(res, "deployed_at"),
+			CreatedAt:        db.GetString(res, "created_at"),
+			UpdatedAt:        db.GetString(res, "updated_at"),
+			EnvironmentName:  db.GetString(env, "name"),
+			EnvironmentType:  db.GetString(env, "type"),
 		})
 	}
 
@@ -350,15 +341,15 @@ func ListByEnvironment(c *gin.Context) {
 		}
 
 		response = append(response, WorkflowEnvironment{
-			ID:               getString(res, "id"),
-			WorkflowID:       getString(res, "workflow_id"),
-			EnvironmentID:    getString(res, "environment_id"),
+			ID:               db.GetString(res, "id"),
+			WorkflowID:       db.GetString(res, "workflow_id"),
+			EnvironmentID:    db.GetString(res, "environment_id"),
 			FlowDataOverride: getMap(res, "flow_data_override"),
 			IsActive:         res["is_active"] == true,
-			DeployedAt:       getString(res, "deployed_at"),
-			CreatedAt:        getString(res, "created_at"),
-			UpdatedAt:        getString(res, "updated_at"),
-			WorkflowName:     getString(wf, "name"),
+			DeployedAt:       db.GetString(res, "deployed_at"),
+			CreatedAt:        db.GetString(res, "created_at"),
+			UpdatedAt:        db.GetString(res, "updated_at"),
+			WorkflowName:     db.GetString(wf, "name"),
 		})
 	}
 
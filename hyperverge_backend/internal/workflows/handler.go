@@ -43,15 +43,6 @@ type WorkflowResponse struct {
 	UpdatedAt      string   `json:"updated_at"`
 }
 
-func getString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if str, ok := val.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 func Create(c *gin.Context) {
 	buId := c.Param("buId")
 	var req CreateWorkflowReq
@@ -85,7 +76,7 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	clientId := getString(buResults[0], "client_id")
+	clientId := db.GetString(buResults[0], "client_id")
 
 	// Check if user owns the client
 	clientData, _, clientErr := db.Client.
@@ -172,10 +163,10 @@ func Create(c *gin.Context) {
 
 	workflow := dbResult[0]
 	response := WorkflowResponse{
-		ID:             getString(workflow, "id"),
-		Name:           getString(workflow, "name"),
-		Description:    getString(workflow, "description"),
-		BusinessUnitID: getString(workflow, "business_unit_id"),
+		ID:             db.GetString(workflow, "id"),
+		Name:           db.GetString(workflow, "name"),
+		Description:    db.GetString(workflow, "description"),
+		BusinessUnitID: db.GetString(workflow, "business_unit_id"),
 		OwnerID:        userId,
 		FlowType:       req.FlowType,
 		FlowData: FlowData{
@@ -185,8 +176,8 @@ func Create(c *gin.Context) {
 			FlowOutputs: "",
 			FlowType:    req.FlowType,
 		},
-		CreatedAt: getString(workflow, "created_at"),
-		UpdatedAt: getString(workflow, "updated_at"),
+		CreatedAt: db.GetString(workflow, "created_at"),
+		UpdatedAt: db.GetString(workflow, "updated_at"),
 	}
 
 	c.JSON(http.StatusCreated, response)
@@ -219,7 +210,7 @@ func List(c *gin.Context) {
 		return
 	}
 
-	clientId := getString(buResults[0], "client_id")
+	clientId := db.GetString(buResults[0], "client_id")
 
 	// Check if user owns the client
 	clientData, _, clientErr := db.Client.
@@ -314,11 +305,11 @@ func Get(c *gin.Context) {
 	result := results[0]
 
 	// Read dedicated flow_type column; fall back to value inside flow_data JSON
-	flowType := getString(result, "flow_type")
+	flowType := db.GetString(result, "flow_type")
 
 	// Parse flow_data from JSON string to FlowData struct
 	var flowData FlowData
-	flowDataStr := getString(result, "flow_data")
+	flowDataStr := db.GetString(result, "flow_data")
 	if flowDataStr != "" {
 		if err := json.Unmarshal([]byte(flowDataStr), &flowData); err != nil {
 			flowData = FlowData{
@@ -348,15 +339,15 @@ func Get(c *gin.Context) {
 
 	// Build properly formatted response
 	response := WorkflowResponse{
-		ID:             getString(result, "id"),
-		Name:           getString(result, "name"),
-		Description:    getString(result, "description"),
-		BusinessUnitID: getString(result, "business_unit_id"),
-		OwnerID:        getString(result, "owner_id"),
+		ID:             db.GetString(result, "id"),
+		Name:           db.GetString(result, "name"),
+		Description:    db.GetString(result, "description"),
+		BusinessUnitID: db.GetString(result, "business_unit_id"),
+		OwnerID:        db.GetString(result, "owner_id"),
 		FlowType:       flowType,
 		FlowData:       flowData,
-		CreatedAt:      getString(result, "created_at"),
-		UpdatedAt:      getString(result, "updated_at"),
+		CreatedAt:      db.GetString(result, "created_at"),
+		UpdatedAt:      db.GetString(result, "updated_at"),
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -410,8 +401,8 @@ func Update(c *gin.Context) {
 
 	workflow := workflowResults[0]
 
-	ownerId := getString(workflow, "owner_id")
-	buId := getString(workflow, "business_unit_id")
+	ownerId := db.GetString(workflow, "owner_id")
+	buId := db.GetString(workflow, "business_unit_id")
 
 	// Check if user owns the workflow
 	if ownerId != userId {
@@ -473,7 +464,7 @@ func Delete(c *gin.Context) {
 	}
 
 	workflow := workflowResults[0]
-	buId := getString(workflow, "business_unit_id")
+	buId := db.GetString(workflow, "business_unit_id")
 
 	// Check if user has access via client ownership or BU permissions
 	buData, _, err := db.Client.
@@ -494,7 +485,7 @@ func Delete(c *gin.Context) {
 	}
 
 	businessUnit := buResults[0]
-	clientId := getString(businessUnit, "client_id")
+	clientId := db.GetString(businessUnit, "client_id")
 
 	// Check if user owns the client
 	clientData, _, clientErr := db.Client.
@@ -0,0 +1,182 @@
+// internal/workflows/transfer.go
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"hypervision_backend/internal/auth"
+	"hypervision_backend/internal/db"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CloneWorkflowReq struct {
+	TargetBusinessUnitID string `json:"target_business_unit_id"`
+	Name                 string `json:"name"`                 // Optional: defaults to "<name> (copy)"
+	IncludeVersions      bool   `json:"include_versions"`     // Copy published versions as well
+	IncludeEnvironments  bool   `json:"include_environments"` // Recreate environment links in the target BU
+}
+
+type MoveWorkflowReq struct {
+	TargetBusinessUnitID string `json:"target_business_unit_id"`
+}
+
+// EnvironmentMapping is a link that was carried over to the target BU
+type EnvironmentMapping struct {
+	EnvironmentName     string `json:"environment_name"`
+	SourceEnvironmentID string `json:"source_environment_id"`
+	TargetEnvironmentID string `json:"target_environment_id"`
+}
+
+// UnmappedLink is a link that could not be carried over
+type UnmappedLink struct {
+	EnvironmentName     string `json:"environment_name"`
+	SourceEnvironmentID string `json:"source_environment_id"`
+	HadOverride         bool   `json:"had_override"`
+	Reason              string `json:"reason"`
+}
+
+type TransferReport struct {
+	WorkflowID           string               `json:"workflow_id"`
+	SourceWorkflowID     string               `json:"source_workflow_id"`
+	SourceBusinessUnitID string               `json:"source_business_unit_id"`
+	TargetBusinessUnitID string               `json:"target_business_unit_id"`
+	VersionIDMap         map[string]string    `json:"version_id_map,omitempty"`
+	EnvironmentLinks     []EnvironmentMapping `json:"environment_links"`
+	Unmapped             []UnmappedLink       `json:"unmapped"`
+}
+
+// Clone copies a workflow into another (or the same) business unit, possibly
+// under a different client.
+func Clone(c *gin.Context) {
+	workflowId := c.Param("id")
+	userId := c.GetString("userId")
+
+	var req CloneWorkflowReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.TargetBusinessUnitID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "target_business_unit_id is required"})
+		return
+	}
+
+	source, err := loadWorkflowRow(workflowId)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
+		return
+	}
+
+	sourceBuId := db.GetString(source, "business_unit_id")
+	if !auth.CanAccessBU(sourceBuId, userId, false) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to read the source workflow"})
+		return
+	}
+	if !auth.CanAccessBU(req.TargetBusinessUnitID, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to create workflow in the target BU"})
+		return
+	}
+
+	// The copy, its versions and its links are written in one transaction
+	var report TransferReport
+	err = db.CallRPC(db.Client, "clone_workflow", map[string]interface{}{
+		"p_workflow_id":          workflowId,
+		"p_target_bu_id":         req.TargetBusinessUnitID,
+		"p_name":                 req.Name,
+		"p_include_versions":     req.IncludeVersions,
+		"p_include_environments": req.IncludeEnvironments,
+	}, &report)
+	if err != nil {
+		transferFailed(c, "failed to clone workflow", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
+
+// Move re-parents a workflow to another business unit. Its versions move with
+// it; environment links are remapped by environment name and links without a
+// matching environment in the target BU are removed and reported.
+func Move(c *gin.Context) {
+	workflowId := c.Param("id")
+	userId := c.GetString("userId")
+
+	var req MoveWorkflowReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.TargetBusinessUnitID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "target_business_unit_id is required"})
+		return
+	}
+
+	source, err := loadWorkflowRow(workflowId)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "workflow not found"})
+		return
+	}
+
+	sourceBuId := db.GetString(source, "business_unit_id")
+	if sourceBuId == req.TargetBusinessUnitID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "workflow already belongs to this business unit"})
+		return
+	}
+
+	if !auth.CanAccessBU(sourceBuId, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to move this workflow"})
+		return
+	}
+	if !auth.CanAccessBU(req.TargetBusinessUnitID, userId, true) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to move workflow into the target BU"})
+		return
+	}
+
+	// The workflow and its links move together or not at all
+	var report TransferReport
+	err = db.CallRPC(db.Client, "move_workflow", map[string]interface{}{
+		"p_workflow_id":  workflowId,
+		"p_target_bu_id": req.TargetBusinessUnitID,
+	}, &report)
+	if err != nil {
+		transferFailed(c, "failed to move workflow", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// transferFailed reports a failed clone_workflow or move_workflow call;
+// nothing was written
+func transferFailed(c *gin.Context, message string, err error) {
+	var rpcErr *db.RPCError
+	if errors.As(err, &rpcErr) && rpcErr.Code == "P0002" {
+		c.JSON(http.StatusNotFound, gin.H{"error": rpcErr.Message})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": message + ": " + err.Error()})
+}
+
+func loadWorkflowRow(workflowId string) (map[string]interface{}, error) {
+	data, _, err := db.Client.
+		From("test_workflows").
+		Select("*", "", false).
+		Eq("id", workflowId).
+		Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(data, &results); err != nil || len(results) == 0 {
+		return nil, fmt.Errorf("workflow not found")
+	}
+	return results[0], nil
+}
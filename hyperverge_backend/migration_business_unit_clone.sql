@@ -0,0 +1,184 @@
+-- Migration script for deep cloning a business unit
+--
+-- clone_business_unit copies a BU with its environments, workflows, published
+-- versions and workflow-environment links (including overrides) in a single
+-- transaction. It is called through PostgREST (POST /rest/v1/rpc/clone_business_unit)
+-- and returns an ID mapping report:
+--
+--   {
+--     "business_unit_id": "<new bu>",
+--     "business_units":   {"<old>": "<new>"},
+--     "environments":     {"<old>": "<new>"},
+--     "workflows":        {"<old>": "<new>"},
+--     "versions":         {"<old>": "<new>"},
+--     "links":            {"<old>": "<new>"},
+--     "secrets_copied":   false
+--   }
+--
+-- When p_include_secrets is false, environment api keys and header values are
+-- cleared and variables whose name looks like a secret are dropped.
+
+-- Some handlers store jsonb columns as JSON-encoded strings; unwrap those
+CREATE OR REPLACE FUNCTION public.as_jsonb_object(p_value jsonb)
+RETURNS jsonb
+LANGUAGE sql
+IMMUTABLE
+AS $$
+  SELECT CASE jsonb_typeof(p_value)
+    WHEN 'object' THEN p_value
+    WHEN 'string' THEN COALESCE((p_value #>> '{}')::jsonb, '{}'::jsonb)
+    ELSE '{}'::jsonb
+  END;
+$$;
+
+-- Drops variables whose name suggests a credential
+CREATE OR REPLACE FUNCTION public.strip_secret_variables(p_vars jsonb)
+RETURNS jsonb
+LANGUAGE sql
+IMMUTABLE
+AS $$
+  SELECT COALESCE(jsonb_object_agg(key, value), '{}'::jsonb)
+  FROM jsonb_each(public.as_jsonb_object(p_vars))
+  WHERE key !~* '(secret|password|token|api_?key|app_?key|credential)';
+$$;
+
+CREATE OR REPLACE FUNCTION public.clone_business_unit(
+  p_source_bu_id uuid,
+  p_target_client_id uuid,
+  p_name text,
+  p_owner_id uuid,
+  p_include_secrets boolean DEFAULT false
+) RETURNS jsonb
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_source public.test_business_units%ROWTYPE;
+  v_new_bu uuid;
+  v_env_map jsonb := '{}'::jsonb;
+  v_wf_map jsonb := '{}'::jsonb;
+  v_version_map jsonb := '{}'::jsonb;
+  v_link_map jsonb := '{}'::jsonb;
+  v_row record;
+  v_new_id uuid;
+BEGIN
+  SELECT * INTO v_source FROM public.test_business_units WHERE id = p_source_bu_id;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'business unit % not found', p_source_bu_id USING ERRCODE = 'P0002';
+  END IF;
+
+  PERFORM 1 FROM public.test_clients WHERE id = p_target_client_id;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'client % not found', p_target_client_id USING ERRCODE = 'P0002';
+  END IF;
+
+  -- 1. Business unit
+  INSERT INTO public.test_business_units (name, description, client_id, variables)
+  VALUES (
+    COALESCE(NULLIF(p_name, ''), v_source.name || ' (copy)'),
+    v_source.description,
+    p_target_client_id,
+    CASE WHEN p_include_secrets THEN v_source.variables
+         ELSE public.strip_secret_variables(v_source.variables) END
+  )
+  RETURNING id INTO v_new_bu;
+
+  -- 2. Environments
+  FOR v_row IN SELECT * FROM public.test_environments WHERE business_unit_id = p_source_bu_id LOOP
+    INSERT INTO public.test_environments (
+      name, description, type, integration_type, base_url, api_key, auth_method,
+      headers, variables, documentation_links, business_unit_id, owner_id
+    ) VALUES (
+      v_row.name,
+      v_row.description,
+      v_row.type,
+      v_row.integration_type,
+      v_row.base_url,
+      CASE WHEN p_include_secrets THEN v_row.api_key ELSE NULL END,
+      v_row.auth_method,
+      CASE WHEN p_include_secrets THEN v_row.headers
+           ELSE (SELECT COALESCE(jsonb_object_agg(key, ''), '{}'::jsonb)
+                 FROM jsonb_each(public.as_jsonb_object(v_row.headers))) END,
+      CASE WHEN p_include_secrets THEN v_row.variables
+           ELSE public.strip_secret_variables(v_row.variables) END,
+      v_row.documentation_links,
+      v_new_bu,
+      p_owner_id
+    )
+    RETURNING id INTO v_new_id;
+
+    v_env_map := v_env_map || jsonb_build_object(v_row.id::text, v_new_id::text);
+  END LOOP;
+
+  -- 3. Workflows
+  FOR v_row IN SELECT * FROM public.test_workflows WHERE business_unit_id = p_source_bu_id LOOP
+    INSERT INTO public.test_workflows (
+      name, description, business_unit_id, flow_data, flow_type, template_id, template_version
+    ) VALUES (
+      v_row.name, v_row.description, v_new_bu, v_row.flow_data, v_row.flow_type,
+      v_row.template_id, v_row.template_version
+    )
+    RETURNING id INTO v_new_id;
+
+    v_wf_map := v_wf_map || jsonb_build_object(v_row.id::text, v_new_id::text);
+  END LOOP;
+
+  -- 4. Published versions
+  FOR v_row IN
+    SELECT v.* FROM public.workflow_versions v
+    JOIN public.test_workflows w ON w.id = v.workflow_id
+    WHERE w.business_unit_id = p_source_bu_id
+  LOOP
+    INSERT INTO public.workflow_versions (
+      workflow_id, version_number, version_details, flow_data, flow_type,
+      published_by, published_at, created_at
+    ) VALUES (
+      (v_wf_map ->> v_row.workflow_id::text)::uuid,
+      v_row.version_number, v_row.version_details, v_row.flow_data, v_row.flow_type,
+      v_row.published_by, v_row.published_at, v_row.created_at
+    )
+    RETURNING id INTO v_new_id;
+
+    v_version_map := v_version_map || jsonb_build_object(v_row.id::text, v_new_id::text);
+  END LOOP;
+
+  -- Point the copies at their copied active version
+  UPDATE public.test_workflows nw
+  SET active_published_version_id = (v_version_map ->> ow.active_published_version_id::text)::uuid
+  FROM public.test_workflows ow
+  WHERE ow.business_unit_id = p_source_bu_id
+    AND ow.active_published_version_id IS NOT NULL
+    AND nw.id = (v_wf_map ->> ow.id::text)::uuid;
+
+  -- 5. Workflow-environment links and overrides
+  FOR v_row IN
+    SELECT l.* FROM public.test_workflow_environments l
+    JOIN public.test_workflows w ON w.id = l.workflow_id
+    WHERE w.business_unit_id = p_source_bu_id
+  LOOP
+    CONTINUE WHEN NOT (v_env_map ? v_row.environment_id::text);
+
+    INSERT INTO public.test_workflow_environments (
+      workflow_id, environment_id, flow_data_override, is_active, deployed_at
+    ) VALUES (
+      (v_wf_map ->> v_row.workflow_id::text)::uuid,
+      (v_env_map ->> v_row.environment_id::text)::uuid,
+      v_row.flow_data_override, v_row.is_active, v_row.deployed_at
+    )
+    RETURNING id INTO v_new_id;
+
+    v_link_map := v_link_map || jsonb_build_object(v_row.id::text, v_new_id::text);
+  END LOOP;
+
+  RETURN jsonb_build_object(
+    'business_unit_id', v_new_bu,
+    'business_units', jsonb_build_object(p_source_bu_id::text, v_new_bu::text),
+    'environments', v_env_map,
+    'workflows', v_wf_map,
+    'versions', v_version_map,
+    'links', v_link_map,
+    'secrets_copied', p_include_secrets
+  );
+END;
+$$;
+
+GRANT EXECUTE ON FUNCTION public.clone_business_unit(uuid, uuid, text, uuid, boolean) TO authenticated;
@@ -0,0 +1,74 @@
+-- Migration script for editing the API catalog
+--
+-- save_api_documentation creates or updates a row of api_documentation_new and
+-- replaces its inputs and outputs in a single transaction, so a failed save
+-- never leaves an API with half of its fields. It is called through PostgREST
+-- (POST /rest/v1/rpc/save_api_documentation) with the API as JSON:
+--
+--   {
+--     "name": "...", "description": "...", "url": "...", "category": "india_api",
+--     "curl_example": "...", "success_response": {}, "failure_responses": [],
+--     "error_details": [],
+--     "inputs":  [{"name": "...", "type": "...", "description": "...", "required": true}],
+--     "outputs": [...]
+--   }
+--
+-- p_id is null to create an API. It returns {"id": "<api id>"}.
+
+CREATE OR REPLACE FUNCTION public.save_api_documentation(
+  p_id uuid,
+  p_api jsonb
+) RETURNS jsonb
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_id uuid;
+BEGIN
+  IF p_id IS NULL THEN
+    INSERT INTO public.api_documentation_new (
+      name, description, url, category, curl_example,
+      success_response, failure_responses, error_details
+    ) VALUES (
+      p_api->>'name',
+      p_api->>'description',
+      p_api->>'url',
+      p_api->>'category',
+      p_api->>'curl_example',
+      COALESCE(p_api->'success_response', '{}'::jsonb),
+      COALESCE(p_api->'failure_responses', '[]'::jsonb),
+      COALESCE(p_api->'error_details', '[]'::jsonb)
+    )
+    RETURNING id INTO v_id;
+  ELSE
+    UPDATE public.api_documentation_new SET
+      name = p_api->>'name',
+      description = p_api->>'description',
+      url = p_api->>'url',
+      category = p_api->>'category',
+      curl_example = p_api->>'curl_example',
+      success_response = COALESCE(p_api->'success_response', '{}'::jsonb),
+      failure_responses = COALESCE(p_api->'failure_responses', '[]'::jsonb),
+      error_details = COALESCE(p_api->'error_details', '[]'::jsonb),
+      updated_at = now()
+    WHERE id = p_id
+    RETURNING id INTO v_id;
+
+    IF NOT FOUND THEN
+      RAISE EXCEPTION 'api % not found', p_id USING ERRCODE = 'P0002';
+    END IF;
+
+    DELETE FROM public.api_inputs_new WHERE api_id = v_id;
+    DELETE FROM public.api_outputs_new WHERE api_id = v_id;
+  END IF;
+
+  INSERT INTO public.api_inputs_new (api_id, name, type, description, required)
+  SELECT v_id, f->>'name', f->>'type', f->>'description', COALESCE((f->>'required')::boolean, false)
+  FROM jsonb_array_elements(COALESCE(p_api->'inputs', '[]'::jsonb)) AS f;
+
+  INSERT INTO public.api_outputs_new (api_id, name, type, description, required)
+  SELECT v_id, f->>'name', f->>'type', f->>'description', COALESCE((f->>'required')::boolean, false)
+  FROM jsonb_array_elements(COALESCE(p_api->'outputs', '[]'::jsonb)) AS f;
+
+  RETURN jsonb_build_object('id', v_id);
+END;
+$$;
@@ -0,0 +1,122 @@
+-- Migration script for API catalog revisions
+--
+-- Every save of an API in api_documentation_new bumps its revision and keeps
+-- a snapshot of it (with its inputs and outputs) in api_documentation_revisions,
+-- along with what changed since the previous revision. Workflow nodes record
+-- the revision they were copied from, so outdated nodes can be found.
+--
+-- Run after migration_catalog.sql; it replaces save_api_documentation.
+
+ALTER TABLE public.api_documentation_new
+  ADD COLUMN IF NOT EXISTS revision integer NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS public.api_documentation_revisions (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  api_id uuid NOT NULL REFERENCES public.api_documentation_new(id) ON DELETE CASCADE,
+  revision integer NOT NULL,
+  source text NOT NULL DEFAULT 'edit' CHECK (source IN ('edit', 'import')),
+  snapshot jsonb NOT NULL,
+  changes jsonb NOT NULL DEFAULT '{}'::jsonb,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  UNIQUE (api_id, revision)
+);
+
+ALTER TABLE public.api_documentation_revisions ENABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS "Allow read access to authenticated users" ON public.api_documentation_revisions;
+CREATE POLICY "Allow read access to authenticated users" ON public.api_documentation_revisions
+  FOR SELECT USING (auth.role() = 'authenticated');
+
+-- The current state of every API becomes its first revision
+INSERT INTO public.api_documentation_revisions (api_id, revision, source, snapshot)
+SELECT a.id, a.revision, 'import', jsonb_build_object(
+  'name', a.name,
+  'description', a.description,
+  'url', a.url,
+  'category', a.category,
+  'curl_example', a.curl_example,
+  'success_response', COALESCE(a.success_response, '{}'::jsonb),
+  'failure_responses', COALESCE(a.failure_responses, '[]'::jsonb),
+  'error_details', COALESCE(a.error_details, '[]'::jsonb),
+  'inputs', COALESCE((
+    SELECT jsonb_agg(jsonb_build_object('name', f.name, 'type', f.type, 'description', f.description, 'required', f.required) ORDER BY f.created_at, f.name)
+    FROM public.api_inputs_new f WHERE f.api_id = a.id
+  ), '[]'::jsonb),
+  'outputs', COALESCE((
+    SELECT jsonb_agg(jsonb_build_object('name', f.name, 'type', f.type, 'description', f.description, 'required', f.required) ORDER BY f.created_at, f.name)
+    FROM public.api_outputs_new f WHERE f.api_id = a.id
+  ), '[]'::jsonb)
+)
+FROM public.api_documentation_new a
+ON CONFLICT (api_id, revision) DO NOTHING;
+
+-- save_api_documentation now also takes what changed, computed by the
+-- backend, and where the change came from. It returns
+-- {"id": "<api id>", "revision": <new revision>}.
+DROP FUNCTION IF EXISTS public.save_api_documentation(uuid, jsonb);
+
+CREATE OR REPLACE FUNCTION public.save_api_documentation(
+  p_id uuid,
+  p_api jsonb,
+  p_changes jsonb DEFAULT '{}'::jsonb,
+  p_source text DEFAULT 'edit'
+) RETURNS jsonb
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_id uuid;
+  v_revision integer;
+BEGIN
+  IF p_id IS NULL THEN
+    INSERT INTO public.api_documentation_new (
+      name, description, url, category, curl_example,
+      success_response, failure_responses, error_details, revision
+    ) VALUES (
+      p_api->>'name',
+      p_api->>'description',
+      p_api->>'url',
+      p_api->>'category',
+      p_api->>'curl_example',
+      COALESCE(p_api->'success_response', '{}'::jsonb),
+      COALESCE(p_api->'failure_responses', '[]'::jsonb),
+      COALESCE(p_api->'error_details', '[]'::jsonb),
+      1
+    )
+    RETURNING id, revision INTO v_id, v_revision;
+  ELSE
+    UPDATE public.api_documentation_new SET
+      name = p_api->>'name',
+      description = p_api->>'description',
+      url = p_api->>'url',
+      category = p_api->>'category',
+      curl_example = p_api->>'curl_example',
+      success_response = COALESCE(p_api->'success_response', '{}'::jsonb),
+      failure_responses = COALESCE(p_api->'failure_responses', '[]'::jsonb),
+      error_details = COALESCE(p_api->'error_details', '[]'::jsonb),
+      revision = revision + 1,
+      updated_at = now()
+    WHERE id = p_id
+    RETURNING id, revision INTO v_id, v_revision;
+
+    IF NOT FOUND THEN
+      RAISE EXCEPTION 'api % not found', p_id USING ERRCODE = 'P0002';
+    END IF;
+
+    DELETE FROM public.api_inputs_new WHERE api_id = v_id;
+    DELETE FROM public.api_outputs_new WHERE api_id = v_id;
+  END IF;
+
+  INSERT INTO public.api_inputs_new (api_id, name, type, description, required)
+  SELECT v_id, f->>'name', f->>'type', f->>'description', COALESCE((f->>'required')::boolean, false)
+  FROM jsonb_array_elements(COALESCE(p_api->'inputs', '[]'::jsonb)) AS f;
+
+  INSERT INTO public.api_outputs_new (api_id, name, type, description, required)
+  SELECT v_id, f->>'name', f->>'type', f->>'description', COALESCE((f->>'required')::boolean, false)
+  FROM jsonb_array_elements(COALESCE(p_api->'outputs', '[]'::jsonb)) AS f;
+
+  INSERT INTO public.api_documentation_revisions (api_id, revision, source, snapshot, changes)
+  VALUES (v_id, v_revision, COALESCE(p_source, 'edit'), p_api, COALESCE(p_changes, '{}'::jsonb));
+
+  RETURN jsonb_build_object('id', v_id, 'revision', v_revision);
+END;
+$$;
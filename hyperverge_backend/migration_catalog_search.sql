@@ -0,0 +1,174 @@
+-- Migration script for searching the API catalog in Postgres
+--
+-- The backend searches the catalog with an in-process index by default. With
+-- CATALOG_SEARCH=postgres it calls search_catalog instead, which ranks APIs
+-- and modules with full-text search over weighted tsvectors, stored in
+-- search_document columns with GIN indexes:
+--
+--   A  name
+--   B  input and output field names
+--   C  description
+--   D  error details and failure responses
+--
+-- Names that are within a typo or two of the query match through pg_trgm.
+-- It returns the same results as GET /documentation/search:
+--
+--   [{"kind": "api", "id": "...", "name": "...", "category": "...", "url": "...",
+--     "description": "...", "score": 0.42,
+--     "highlights": {"name": ["<mark>...</mark>"], "description": [...]}}]
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 1. Stored search documents
+--
+-- Field names live in api_inputs_new and api_outputs_new, which a generated
+-- column can't read, so triggers copy them into field_names first.
+
+ALTER TABLE public.api_documentation_new
+  ADD COLUMN IF NOT EXISTS field_names text NOT NULL DEFAULT '';
+
+CREATE OR REPLACE FUNCTION public.refresh_api_field_names(p_api_id uuid)
+RETURNS void
+LANGUAGE sql
+AS $$
+  UPDATE public.api_documentation_new a
+  SET field_names = COALESCE((
+    SELECT string_agg(f.name, ' ') FROM (
+      SELECT name FROM public.api_inputs_new WHERE api_id = p_api_id
+      UNION ALL
+      SELECT name FROM public.api_outputs_new WHERE api_id = p_api_id
+    ) f
+  ), '')
+  WHERE a.id = p_api_id;
+$$;
+
+CREATE OR REPLACE FUNCTION public.api_fields_changed()
+RETURNS trigger
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    PERFORM public.refresh_api_field_names(OLD.api_id);
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    PERFORM public.refresh_api_field_names(NEW.api_id);
+  END IF;
+  RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS api_inputs_search ON public.api_inputs_new;
+CREATE TRIGGER api_inputs_search
+  AFTER INSERT OR UPDATE OR DELETE ON public.api_inputs_new
+  FOR EACH ROW EXECUTE FUNCTION public.api_fields_changed();
+
+DROP TRIGGER IF EXISTS api_outputs_search ON public.api_outputs_new;
+CREATE TRIGGER api_outputs_search
+  AFTER INSERT OR UPDATE OR DELETE ON public.api_outputs_new
+  FOR EACH ROW EXECUTE FUNCTION public.api_fields_changed();
+
+-- Backfill the APIs saved before the triggers existed
+SELECT public.refresh_api_field_names(id) FROM public.api_documentation_new;
+
+ALTER TABLE public.api_documentation_new
+  ADD COLUMN IF NOT EXISTS search_document tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('simple', field_names), 'B') ||
+    setweight(to_tsvector('simple', COALESCE(description, '')), 'C') ||
+    setweight(jsonb_to_tsvector('simple',
+      COALESCE(error_details, '[]'::jsonb) || COALESCE(failure_responses, '[]'::jsonb),
+      '["string"]'), 'D')
+  ) STORED;
+
+ALTER TABLE public.module_documentation_new
+  ADD COLUMN IF NOT EXISTS search_document tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(name, '') || ' ' || id), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(description, '') || ' ' || COALESCE(category, '')), 'C')
+  ) STORED;
+
+CREATE INDEX IF NOT EXISTS api_documentation_new_search_idx
+  ON public.api_documentation_new USING gin (search_document);
+CREATE INDEX IF NOT EXISTS module_documentation_new_search_idx
+  ON public.module_documentation_new USING gin (search_document);
+CREATE INDEX IF NOT EXISTS api_documentation_new_name_trgm_idx
+  ON public.api_documentation_new USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS module_documentation_new_name_trgm_idx
+  ON public.module_documentation_new USING gin (name gin_trgm_ops);
+
+DROP FUNCTION IF EXISTS public.catalog_api_document(uuid);
+
+-- 2. Search
+--
+-- Highlights are returned as HTML, so the text is escaped before
+-- ts_headline adds the <mark> tags.
+
+CREATE OR REPLACE FUNCTION public.escape_html(p_text text)
+RETURNS text
+LANGUAGE sql IMMUTABLE
+AS $$
+  SELECT replace(replace(replace(p_text, '&', '&amp;'), '<', '&lt;'), '>', '&gt;');
+$$;
+
+CREATE OR REPLACE FUNCTION public.search_catalog(
+  p_query text,
+  p_kind text DEFAULT NULL,
+  p_category text DEFAULT NULL,
+  p_limit integer DEFAULT 20
+) RETURNS jsonb
+LANGUAGE sql STABLE
+-- p_query <% name is word_similarity(p_query, name) above this, and can use
+-- the trigram indexes
+SET pg_trgm.word_similarity_threshold = 0.5
+AS $$
+  WITH q AS (
+    SELECT websearch_to_tsquery('simple', p_query) AS ts
+  ),
+  docs AS (
+    SELECT 'api' AS kind, a.id::text AS id, a.name, a.category, a.url, a.description,
+           a.search_document AS document
+    FROM public.api_documentation_new a
+    WHERE p_kind IS NULL OR p_kind = 'api'
+    UNION ALL
+    SELECT 'module', m.id, m.name, m.category, NULL, m.description,
+           m.search_document
+    FROM public.module_documentation_new m
+    WHERE p_kind IS NULL OR p_kind = 'module'
+  ),
+  ranked AS (
+    SELECT d.*, q.ts,
+           ts_rank(d.document, q.ts) + 0.1 * word_similarity(p_query, d.name) AS score
+    FROM docs d, q
+    WHERE (p_category IS NULL OR d.category = p_category)
+      AND (d.document @@ q.ts OR p_query <% d.name)
+    ORDER BY score DESC, d.name
+    LIMIT p_limit
+  )
+  SELECT COALESCE(jsonb_agg(jsonb_build_object(
+    'kind', r.kind,
+    'id', r.id,
+    'name', r.name,
+    'category', r.category,
+    'url', r.url,
+    'description', r.description,
+    'score', round(r.score::numeric, 3),
+    'highlights', jsonb_strip_nulls(jsonb_build_object(
+      'name', CASE WHEN to_tsvector('simple', r.name) @@ r.ts
+        THEN jsonb_build_array(ts_headline('simple', public.escape_html(r.name), r.ts, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')) END,
+      'description', CASE WHEN to_tsvector('simple', COALESCE(r.description, '')) @@ r.ts
+        THEN jsonb_build_array(ts_headline('simple', public.escape_html(r.description), r.ts, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=3')) END,
+      'inputs', CASE WHEN to_tsvector('simple', COALESCE(f.inputs, '')) @@ r.ts
+        THEN jsonb_build_array(ts_headline('simple', public.escape_html(f.inputs), r.ts, 'StartSel=<mark>, StopSel=</mark>')) END,
+      'outputs', CASE WHEN to_tsvector('simple', COALESCE(f.outputs, '')) @@ r.ts
+        THEN jsonb_build_array(ts_headline('simple', public.escape_html(f.outputs), r.ts, 'StartSel=<mark>, StopSel=</mark>')) END
+    ))
+  ) ORDER BY r.score DESC, r.name), '[]'::jsonb)
+  FROM ranked r
+  -- Field names are only read for the page being returned
+  LEFT JOIN LATERAL (
+    SELECT
+      (SELECT string_agg(name, ', ') FROM public.api_inputs_new WHERE r.kind = 'api' AND api_id::text = r.id) AS inputs,
+      (SELECT string_agg(name, ', ') FROM public.api_outputs_new WHERE r.kind = 'api' AND api_id::text = r.id) AS outputs
+  ) f ON true;
+$$;
+
+GRANT EXECUTE ON FUNCTION public.search_catalog(text, text, text, integer) TO authenticated;
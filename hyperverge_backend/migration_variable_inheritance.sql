@@ -0,0 +1,14 @@
+-- Migration script for client / business unit level variables
+--
+-- Environments already store their own variables. Clients and business units
+-- get the same column so shared settings (appId, callback domains, ...) can be
+-- defined once and inherited. Resolution order is:
+--   client  ->  business unit  ->  environment   (last one wins)
+
+-- 1. Client level variables
+ALTER TABLE public.test_clients
+  ADD COLUMN IF NOT EXISTS variables jsonb NOT NULL DEFAULT '{}'::jsonb;
+
+-- 2. Business unit level variables
+ALTER TABLE public.test_business_units
+  ADD COLUMN IF NOT EXISTS variables jsonb NOT NULL DEFAULT '{}'::jsonb;
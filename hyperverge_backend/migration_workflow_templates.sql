@@ -0,0 +1,132 @@
+-- Migration script for the workflow templates library
+
+-- 1. Templates. client_id NULL means the template is in the global catalog,
+--    otherwise it is only visible to that client.
+CREATE TABLE IF NOT EXISTS public.workflow_templates (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  client_id uuid,
+  name character varying NOT NULL,
+  description text,
+  flow_type character varying,
+  current_version integer NOT NULL DEFAULT 1,
+  created_by uuid,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  updated_at timestamp with time zone NOT NULL DEFAULT now(),
+  CONSTRAINT workflow_templates_pkey PRIMARY KEY (id),
+  CONSTRAINT workflow_templates_client_id_fkey FOREIGN KEY (client_id) REFERENCES public.test_clients(id) ON DELETE CASCADE
+);
+
+-- 2. Immutable template versions. Each save of a template adds a row and
+--    bumps workflow_templates.current_version.
+CREATE TABLE IF NOT EXISTS public.workflow_template_versions (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  template_id uuid NOT NULL,
+  version integer NOT NULL,
+  flow_data jsonb NOT NULL,
+  parameters jsonb NOT NULL DEFAULT '[]'::jsonb,
+  source_workflow_id uuid,
+  source_version_id uuid,
+  changelog text,
+  created_by uuid,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  CONSTRAINT workflow_template_versions_pkey PRIMARY KEY (id),
+  CONSTRAINT workflow_template_versions_unique UNIQUE (template_id, version),
+  CONSTRAINT workflow_template_versions_template_id_fkey FOREIGN KEY (template_id) REFERENCES public.workflow_templates(id) ON DELETE CASCADE
+);
+
+-- 3. Remember which template (and which version of it) a workflow came from
+ALTER TABLE public.test_workflows
+  ADD COLUMN IF NOT EXISTS template_id uuid REFERENCES public.workflow_templates(id) ON DELETE SET NULL,
+  ADD COLUMN IF NOT EXISTS template_version integer;
+
+-- 4. Indexes
+CREATE INDEX IF NOT EXISTS idx_workflow_templates_client ON public.workflow_templates(client_id);
+CREATE INDEX IF NOT EXISTS idx_workflow_template_versions_template ON public.workflow_template_versions(template_id);
+CREATE INDEX IF NOT EXISTS idx_workflows_template ON public.test_workflows(template_id);
+
+-- 5. Adding a version inserts it and bumps current_version in one
+--    transaction. The template row is locked so concurrent saves get
+--    consecutive numbers instead of a unique violation. Returns the new
+--    version number.
+CREATE OR REPLACE FUNCTION public.add_template_version(
+  p_template_id uuid,
+  p_flow_data jsonb,
+  p_parameters jsonb,
+  p_source_workflow_id uuid,
+  p_source_version_id uuid,
+  p_changelog text,
+  p_created_by uuid,
+  p_flow_type text
+) RETURNS integer
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_next integer;
+BEGIN
+  SELECT current_version + 1 INTO v_next
+  FROM public.workflow_templates
+  WHERE id = p_template_id
+  FOR UPDATE;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'template % not found', p_template_id USING ERRCODE = 'P0002';
+  END IF;
+
+  INSERT INTO public.workflow_template_versions (
+    template_id, version, flow_data, parameters,
+    source_workflow_id, source_version_id, changelog, created_by
+  ) VALUES (
+    p_template_id, v_next, p_flow_data, COALESCE(p_parameters, '[]'::jsonb),
+    p_source_workflow_id, p_source_version_id, p_changelog, p_created_by
+  );
+
+  UPDATE public.workflow_templates
+  SET current_version = v_next,
+      flow_type = COALESCE(NULLIF(p_flow_type, ''), flow_type),
+      updated_at = now()
+  WHERE id = p_template_id;
+
+  RETURN v_next;
+END;
+$$;
+
+-- 6. Saving a workflow as a template creates the template and its version 1
+--    in one transaction, so a failure never leaves a template without
+--    versions. Returns the template row.
+CREATE OR REPLACE FUNCTION public.create_template(
+  p_client_id uuid,
+  p_name text,
+  p_description text,
+  p_flow_type text,
+  p_flow_data jsonb,
+  p_parameters jsonb,
+  p_source_workflow_id uuid,
+  p_source_version_id uuid,
+  p_changelog text,
+  p_created_by uuid
+) RETURNS jsonb
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_template public.workflow_templates%ROWTYPE;
+BEGIN
+  INSERT INTO public.workflow_templates (
+    client_id, name, description, flow_type, current_version, created_by
+  ) VALUES (
+    p_client_id, p_name, p_description, NULLIF(p_flow_type, ''), 1, p_created_by
+  )
+  RETURNING * INTO v_template;
+
+  INSERT INTO public.workflow_template_versions (
+    template_id, version, flow_data, parameters,
+    source_workflow_id, source_version_id, changelog, created_by
+  ) VALUES (
+    v_template.id, 1, p_flow_data, COALESCE(p_parameters, '[]'::jsonb),
+    p_source_workflow_id, p_source_version_id, p_changelog, p_created_by
+  );
+
+  RETURN to_jsonb(v_template);
+END;
+$$;
+
+GRANT EXECUTE ON FUNCTION public.add_template_version(uuid, jsonb, jsonb, uuid, uuid, text, uuid, text) TO authenticated;
+GRANT EXECUTE ON FUNCTION public.create_template(uuid, text, text, text, jsonb, jsonb, uuid, uuid, text, uuid) TO authenticated;
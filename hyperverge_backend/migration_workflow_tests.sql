@@ -0,0 +1,28 @@
+-- Migration script for workflow test cases
+
+-- 1. Named test cases of a workflow: flow inputs, mocked API and module
+--    responses, and the end status and path they must reach
+CREATE TABLE IF NOT EXISTS public.workflow_test_cases (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  workflow_id uuid NOT NULL,
+  name character varying NOT NULL,
+  description text,
+  flow_inputs jsonb NOT NULL DEFAULT '{}'::jsonb,
+  mocks jsonb NOT NULL DEFAULT '{}'::jsonb,
+  expected_status character varying,
+  expected_path jsonb NOT NULL DEFAULT '[]'::jsonb,
+  created_by uuid,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  updated_at timestamp with time zone NOT NULL DEFAULT now(),
+  CONSTRAINT workflow_test_cases_pkey PRIMARY KEY (id),
+  CONSTRAINT workflow_test_cases_unique UNIQUE (workflow_id, name),
+  CONSTRAINT workflow_test_cases_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.test_workflows(id) ON DELETE CASCADE,
+  CONSTRAINT workflow_test_cases_status_check CHECK (expected_status IS NULL OR expected_status IN ('auto-approved', 'auto-declined', 'needs-review'))
+);
+
+-- 2. Workflows can refuse to publish unless every test case passes
+ALTER TABLE public.test_workflows
+  ADD COLUMN IF NOT EXISTS require_passing_tests boolean NOT NULL DEFAULT false;
+
+-- 3. Indexes
+CREATE INDEX IF NOT EXISTS idx_workflow_test_cases_workflow ON public.workflow_test_cases(workflow_id);
@@ -0,0 +1,230 @@
+-- Migration script for cloning and moving a workflow across business units
+--
+-- clone_workflow and move_workflow do all of their writes in one transaction,
+-- so a failure leaves neither an orphan copy, partial versions nor half-moved
+-- environment links. They are called through PostgREST
+-- (POST /rest/v1/rpc/clone_workflow, /rest/v1/rpc/move_workflow) and return
+-- the transfer report:
+--
+--   {
+--     "workflow_id":             "<new or moved workflow>",
+--     "source_workflow_id":      "<source>",
+--     "source_business_unit_id": "<bu>",
+--     "target_business_unit_id": "<bu>",
+--     "version_id_map":          {"<old>": "<new>"},   -- clones with versions
+--     "environment_links":       [{"environment_name", "source_environment_id", "target_environment_id"}],
+--     "unmapped":                [{"environment_name", "source_environment_id", "had_override", "reason"}]
+--   }
+--
+-- Environment links are carried over to the environment with the same name
+-- in the target business unit; links without exactly one such environment
+-- are reported as unmapped.
+
+CREATE OR REPLACE FUNCTION public.map_workflow_links(p_workflow_id uuid, p_target_bu_id uuid)
+RETURNS TABLE (
+  link_id uuid,
+  environment_name text,
+  source_environment_id uuid,
+  target_environment_id uuid,
+  had_override boolean,
+  reason text
+)
+LANGUAGE sql
+STABLE
+AS $$
+  SELECT
+    l.id,
+    COALESCE(e.name, '')::text,
+    l.environment_id,
+    CASE WHEN m.n = 1 THEN m.target_id END,
+    l.flow_data_override IS NOT NULL,
+    CASE
+      WHEN m.n = 0 THEN 'no environment with this name in the target business unit'
+      WHEN m.n > 1 THEN 'several environments with this name in the target business unit'
+    END
+  FROM public.test_workflow_environments l
+  LEFT JOIN public.test_environments e ON e.id = l.environment_id
+  CROSS JOIN LATERAL (
+    SELECT count(*) AS n, min(t.id::text)::uuid AS target_id
+    FROM public.test_environments t
+    WHERE t.business_unit_id = p_target_bu_id AND t.name = e.name
+  ) m
+  WHERE l.workflow_id = p_workflow_id;
+$$;
+
+CREATE OR REPLACE FUNCTION public.clone_workflow(
+  p_workflow_id uuid,
+  p_target_bu_id uuid,
+  p_name text,
+  p_include_versions boolean DEFAULT false,
+  p_include_environments boolean DEFAULT false
+) RETURNS jsonb
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_source public.test_workflows%ROWTYPE;
+  v_new uuid;
+  v_new_id uuid;
+  v_version_map jsonb;
+  v_links jsonb := '[]'::jsonb;
+  v_unmapped jsonb := '[]'::jsonb;
+  v_row record;
+BEGIN
+  SELECT * INTO v_source FROM public.test_workflows WHERE id = p_workflow_id;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'workflow % not found', p_workflow_id USING ERRCODE = 'P0002';
+  END IF;
+
+  PERFORM 1 FROM public.test_business_units WHERE id = p_target_bu_id;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'business unit % not found', p_target_bu_id USING ERRCODE = 'P0002';
+  END IF;
+
+  -- 1. Workflow
+  INSERT INTO public.test_workflows (
+    name, description, business_unit_id, flow_data, flow_type, template_id, template_version
+  ) VALUES (
+    COALESCE(NULLIF(p_name, ''), v_source.name || ' (copy)'),
+    v_source.description, p_target_bu_id, v_source.flow_data, v_source.flow_type,
+    v_source.template_id, v_source.template_version
+  )
+  RETURNING id INTO v_new;
+
+  -- 2. Published versions, and the copy of the active one
+  IF p_include_versions THEN
+    v_version_map := '{}'::jsonb;
+    FOR v_row IN
+      SELECT * FROM public.workflow_versions
+      WHERE workflow_id = p_workflow_id
+      ORDER BY published_at
+    LOOP
+      INSERT INTO public.workflow_versions (
+        workflow_id, version_number, version_details, flow_data, flow_type,
+        published_by, published_at, created_at
+      ) VALUES (
+        v_new, v_row.version_number, v_row.version_details, v_row.flow_data, v_row.flow_type,
+        v_row.published_by, v_row.published_at, v_row.created_at
+      )
+      RETURNING id INTO v_new_id;
+
+      v_version_map := v_version_map || jsonb_build_object(v_row.id::text, v_new_id::text);
+    END LOOP;
+
+    IF v_source.active_published_version_id IS NOT NULL
+       AND v_version_map ? v_source.active_published_version_id::text THEN
+      UPDATE public.test_workflows
+      SET active_published_version_id = (v_version_map ->> v_source.active_published_version_id::text)::uuid
+      WHERE id = v_new;
+    END IF;
+  END IF;
+
+  -- 3. Environment links, remapped by environment name
+  IF p_include_environments THEN
+    FOR v_row IN
+      SELECT m.*, l.flow_data_override, l.is_active
+      FROM public.map_workflow_links(p_workflow_id, p_target_bu_id) m
+      JOIN public.test_workflow_environments l ON l.id = m.link_id
+    LOOP
+      IF v_row.target_environment_id IS NULL THEN
+        v_unmapped := v_unmapped || jsonb_build_object(
+          'environment_name', v_row.environment_name,
+          'source_environment_id', v_row.source_environment_id,
+          'had_override', v_row.had_override,
+          'reason', v_row.reason
+        );
+        CONTINUE;
+      END IF;
+
+      INSERT INTO public.test_workflow_environments (
+        workflow_id, environment_id, flow_data_override, is_active, deployed_at
+      ) VALUES (
+        v_new, v_row.target_environment_id, v_row.flow_data_override,
+        COALESCE(v_row.is_active, false), now()
+      );
+
+      v_links := v_links || jsonb_build_object(
+        'environment_name', v_row.environment_name,
+        'source_environment_id', v_row.source_environment_id,
+        'target_environment_id', v_row.target_environment_id
+      );
+    END LOOP;
+  END IF;
+
+  RETURN jsonb_build_object(
+    'workflow_id', v_new,
+    'source_workflow_id', p_workflow_id,
+    'source_business_unit_id', v_source.business_unit_id,
+    'target_business_unit_id', p_target_bu_id,
+    'version_id_map', v_version_map,
+    'environment_links', v_links,
+    'unmapped', v_unmapped
+  );
+END;
+$$;
+
+-- Moves a workflow; its versions move with it. Links without a matching
+-- environment in the target business unit are removed, since they would
+-- point at another business unit's environment.
+CREATE OR REPLACE FUNCTION public.move_workflow(
+  p_workflow_id uuid,
+  p_target_bu_id uuid
+) RETURNS jsonb
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_source public.test_workflows%ROWTYPE;
+  v_links jsonb := '[]'::jsonb;
+  v_unmapped jsonb := '[]'::jsonb;
+  v_row record;
+BEGIN
+  SELECT * INTO v_source FROM public.test_workflows WHERE id = p_workflow_id FOR UPDATE;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'workflow % not found', p_workflow_id USING ERRCODE = 'P0002';
+  END IF;
+
+  PERFORM 1 FROM public.test_business_units WHERE id = p_target_bu_id;
+  IF NOT FOUND THEN
+    RAISE EXCEPTION 'business unit % not found', p_target_bu_id USING ERRCODE = 'P0002';
+  END IF;
+
+  FOR v_row IN SELECT * FROM public.map_workflow_links(p_workflow_id, p_target_bu_id) LOOP
+    IF v_row.target_environment_id IS NULL THEN
+      DELETE FROM public.test_workflow_environments WHERE id = v_row.link_id;
+      v_unmapped := v_unmapped || jsonb_build_object(
+        'environment_name', v_row.environment_name,
+        'source_environment_id', v_row.source_environment_id,
+        'had_override', v_row.had_override,
+        'reason', v_row.reason
+      );
+      CONTINUE;
+    END IF;
+
+    UPDATE public.test_workflow_environments
+    SET environment_id = v_row.target_environment_id, updated_at = now()
+    WHERE id = v_row.link_id;
+
+    v_links := v_links || jsonb_build_object(
+      'environment_name', v_row.environment_name,
+      'source_environment_id', v_row.source_environment_id,
+      'target_environment_id', v_row.target_environment_id
+    );
+  END LOOP;
+
+  UPDATE public.test_workflows
+  SET business_unit_id = p_target_bu_id, updated_at = now()
+  WHERE id = p_workflow_id;
+
+  RETURN jsonb_build_object(
+    'workflow_id', p_workflow_id,
+    'source_workflow_id', p_workflow_id,
+    'source_business_unit_id', v_source.business_unit_id,
+    'target_business_unit_id', p_target_bu_id,
+    'environment_links', v_links,
+    'unmapped', v_unmapped
+  );
+END;
+$$;
+
+GRANT EXECUTE ON FUNCTION public.map_workflow_links(uuid, uuid) TO authenticated;
+GRANT EXECUTE ON FUNCTION public.clone_workflow(uuid, uuid, text, boolean, boolean) TO authenticated;
+GRANT EXECUTE ON FUNCTION public.move_workflow(uuid, uuid) TO authenticated;
@@ -0,0 +1,298 @@
+// routes/openapi.go
+package routes
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"hypervision_backend/internal/accesslinks"
+	"hypervision_backend/internal/analysis"
+	"hypervision_backend/internal/boards"
+	"hypervision_backend/internal/buaccesslinks"
+	"hypervision_backend/internal/bundle"
+	"hypervision_backend/internal/businessunits"
+	"hypervision_backend/internal/catalog"
+	"hypervision_backend/internal/clients"
+	"hypervision_backend/internal/codegen"
+	"hypervision_backend/internal/collaborators"
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/csp"
+	"hypervision_backend/internal/drift"
+	"hypervision_backend/internal/environments"
+	"hypervision_backend/internal/exports"
+	"hypervision_backend/internal/network"
+	"hypervision_backend/internal/openapi"
+	"hypervision_backend/internal/simulate"
+	"hypervision_backend/internal/snapshot"
+	"hypervision_backend/internal/templates"
+	"hypervision_backend/internal/testcases"
+	"hypervision_backend/internal/variables"
+	"hypervision_backend/internal/versions"
+	"hypervision_backend/internal/workflow_environments"
+	"hypervision_backend/internal/workflows"
+)
+
+// rows is used for handlers that return database rows as-is
+type rows = []map[string]interface{}
+
+type message struct {
+	Message string `json:"message"`
+}
+
+// graphQuery selects which graph of a workflow an export is built from
+var graphQuery = []openapi.Param{
+	{Name: "version_id", Description: "Published version to export instead of the draft"},
+	{Name: "environment_id", Description: "Export the environment's effective graph and use its variables"},
+}
+
+// publicGraphQuery is graphQuery plus the BU link password
+var publicGraphQuery = append([]openapi.Param{
+	{Name: "token", Description: "BU link password", Required: true},
+}, graphQuery...)
+
+var handbookQuery = []openapi.Param{
+	{Name: "workflow_id", Description: "Workflows to include, repeated or comma separated (default all)"},
+}
+
+var countryParam = openapi.Param{Name: "country", Description: "ISO 3166 alpha-3 countries whose regional hosts to allow, repeated or comma separated"}
+
+// catalogQuery filters, sorts and pages the API catalog. The next page's
+// cursor is returned in the X-Next-Cursor header.
+var catalogQuery = []openapi.Param{
+	{Name: "category", Enum: []string{catalog.CategoryIndia, catalog.CategoryGlobal}},
+	{Name: "region", Description: "Regional host prefix the API is served from, such as ind or usa"},
+	{Name: "input", Description: "Has an input whose name contains this"},
+	{Name: "output", Description: "Has an output whose name contains this"},
+	{Name: "sort", Description: "name (default), category, created_at or updated_at; prefix with - for descending"},
+	{Name: "limit", Description: "Page size, at most 200 (default all)"},
+	{Name: "cursor", Description: "X-Next-Cursor of the previous page"},
+}
+
+// formatParam picks JSON or a CSV download for the network reports
+var formatParam = openapi.Param{Name: "format", Description: "Report format (default json)", Enum: []string{network.FormatJSON, network.FormatCSV}}
+
+var scaleParam = openapi.Param{Name: "scale", Description: "Pixels per diagram unit, 0.5 to 4 (default 2)"}
+
+// Operations documents every route registered by Register. Adding a route
+// without adding it here makes TestSpecCoversRoutes fail.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		// Clients
+		{Method: "POST", Path: "/api/clients", Tag: "Clients", Summary: "Create a client", Request: clients.CreateClientReq{}, Response: clients.ClientResponse{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/clients", Tag: "Clients", Summary: "List clients owned by the current user", Response: rows{}},
+		{Method: "GET", Path: "/api/clients/:id", Tag: "Clients", Summary: "Get a client"},
+		{Method: "DELETE", Path: "/api/clients/:id", Tag: "Clients", Summary: "Delete a client", Status: http.StatusNoContent},
+		{Method: "GET", Path: "/api/clients/:id/export", Tag: "Clients", Summary: "Export a client bundle", ContentType: "application/octet-stream",
+			Query: []openapi.Param{{Name: "format", Enum: []string{bundle.FormatJSON, bundle.FormatTar}}}},
+		{Method: "POST", Path: "/api/clients/import", Tag: "Clients", Summary: "Import a client bundle (JSON or tar.gz body)", Request: bundle.Bundle{}, Response: bundle.ImportReport{}, Status: http.StatusCreated,
+			Query: []openapi.Param{
+				{Name: "dry_run", Description: "Only report the plan and conflicts", Enum: []string{"true", "false"}},
+				{Name: "namespace", Description: "Import an independent copy"},
+				{Name: "force", Description: "Ignore non-blocking conflicts", Enum: []string{"true", "false"}},
+			}},
+
+		// Business units
+		{Method: "POST", Path: "/api/clients/:id/business-units", Tag: "Business Units", Summary: "Create a business unit", Request: businessunits.CreateBUReq{}, Response: businessunits.BUResponse{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/clients/:id/business-units", Tag: "Business Units", Summary: "List business units of a client", Response: rows{}},
+		{Method: "GET", Path: "/api/business-units/:buId", Tag: "Business Units", Summary: "Get a business unit"},
+		{Method: "DELETE", Path: "/api/business-units/:buId", Tag: "Business Units", Summary: "Delete a business unit", Status: http.StatusNoContent},
+		{Method: "POST", Path: "/api/business-units/:buId/clone", Tag: "Business Units", Summary: "Deep clone a business unit", Request: businessunits.CloneBUReq{}, Response: businessunits.CloneReport{}, Status: http.StatusCreated},
+		{Method: "POST", Path: "/api/business-units/:buId/share", Tag: "Business Units", Summary: "Share a business unit", Request: businessunits.ShareBUReq{}, Response: message{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/business-units/:buId/collaborators", Tag: "Business Units", Summary: "List collaborators", Response: rows{}},
+		{Method: "DELETE", Path: "/api/business-units/:buId/share/:userId", Tag: "Business Units", Summary: "Remove a collaborator", Status: http.StatusNoContent},
+
+		// Environments
+		{Method: "POST", Path: "/api/business-units/:buId/environments", Tag: "Environments", Summary: "Create an environment", Request: environments.EnvironmentReq{}, Response: environments.EnvironmentResponse{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/business-units/:buId/environments", Tag: "Environments", Summary: "List environments", Response: []environments.EnvironmentResponse{}},
+		{Method: "GET", Path: "/api/environments/:id", Tag: "Environments", Summary: "Get an environment", Response: environments.EnvironmentResponse{}},
+		{Method: "PUT", Path: "/api/environments/:id", Tag: "Environments", Summary: "Update an environment", Request: environments.EnvironmentReq{}, Response: message{}},
+		{Method: "DELETE", Path: "/api/environments/:id", Tag: "Environments", Summary: "Delete an environment", Status: http.StatusNoContent},
+
+		// Variables
+		{Method: "GET", Path: "/api/clients/:id/variables", Tag: "Variables", Summary: "Get client variables", Response: variables.VariablesResponse{}},
+		{Method: "PUT", Path: "/api/clients/:id/variables", Tag: "Variables", Summary: "Update client variables", Request: variables.UpdateVariablesReq{}, Response: variables.VariablesResponse{}},
+		{Method: "GET", Path: "/api/business-units/:buId/variables", Tag: "Variables", Summary: "Get business unit variables", Response: variables.VariablesResponse{}},
+		{Method: "PUT", Path: "/api/business-units/:buId/variables", Tag: "Variables", Summary: "Update business unit variables", Request: variables.UpdateVariablesReq{}, Response: variables.VariablesResponse{}},
+		{Method: "GET", Path: "/api/environments/:id/variables/resolved", Tag: "Variables", Summary: "Resolved variables of an environment", Response: variables.ResolvedResponse{}},
+
+		// Workflows
+		{Method: "POST", Path: "/api/business-units/:buId/workflows", Tag: "Workflows", Summary: "Create a workflow", Request: workflows.CreateWorkflowReq{}, Response: workflows.WorkflowResponse{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/business-units/:buId/workflows", Tag: "Workflows", Summary: "List workflows", Response: rows{}},
+		{Method: "GET", Path: "/api/workflows/:id", Tag: "Workflows", Summary: "Get a workflow", Response: workflows.WorkflowResponse{}},
+		{Method: "PUT", Path: "/api/workflows/:id", Tag: "Workflows", Summary: "Update a workflow", Request: workflows.UpdateWorkflowReq{}, Response: message{}},
+		{Method: "DELETE", Path: "/api/workflows/:id", Tag: "Workflows", Summary: "Delete a workflow", Status: http.StatusNoContent},
+		{Method: "POST", Path: "/api/workflows/:id/clone", Tag: "Workflows", Summary: "Clone a workflow into a business unit", Request: workflows.CloneWorkflowReq{}, Response: workflows.TransferReport{}, Status: http.StatusCreated},
+		{Method: "POST", Path: "/api/workflows/:id/move", Tag: "Workflows", Summary: "Move a workflow to another business unit", Request: workflows.MoveWorkflowReq{}, Response: workflows.TransferReport{}},
+
+		// Templates
+		{Method: "GET", Path: "/api/templates", Tag: "Templates", Summary: "List templates", Response: []templates.TemplateResponse{},
+			Query: []openapi.Param{{Name: "client_id", Description: "Include this client's templates"}}},
+		{Method: "GET", Path: "/api/templates/:templateId", Tag: "Templates", Summary: "Get a template", Response: templates.TemplateResponse{},
+			Query: []openapi.Param{{Name: "version", Description: "Return this version's graph and parameters instead of the current one's"}}},
+		{Method: "POST", Path: "/api/templates/:templateId/versions", Tag: "Templates", Summary: "Add a template version", Request: templates.AddVersionReq{}, Response: templates.TemplateResponse{}, Status: http.StatusCreated},
+		{Method: "DELETE", Path: "/api/templates/:templateId", Tag: "Templates", Summary: "Delete a template", Status: http.StatusNoContent},
+		{Method: "POST", Path: "/api/workflows/:id/templates", Tag: "Templates", Summary: "Save a workflow as a template", Request: templates.SaveTemplateReq{}, Response: templates.TemplateResponse{}, Status: http.StatusCreated},
+		{Method: "POST", Path: "/api/business-units/:buId/workflows/from-template", Tag: "Templates", Summary: "Create a workflow from a template", Request: templates.CreateFromTemplateReq{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/business-units/:buId/workflows/template-status", Tag: "Templates", Summary: "Template status of the BU's workflows", Response: []templates.TemplateStatus{}},
+
+		// Snapshots
+		{Method: "GET", Path: "/api/workflows/:id/snapshot", Tag: "Snapshots", Summary: "Get the workflow snapshot"},
+		{Method: "PUT", Path: "/api/workflows/:id/snapshot", Tag: "Snapshots", Summary: "Save the workflow snapshot", Request: snapshot.SaveSnapshotReq{}},
+
+		// Published versions
+		{Method: "POST", Path: "/api/workflows/:id/publish", Tag: "Versions", Summary: "Publish the draft as a new active version; refused when it has data-mapping errors, unless forced, or, if the workflow requires it, failing test cases", Request: versions.PublishReq{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/workflows/:id/versions", Tag: "Versions", Summary: "List published versions and the active one"},
+		{Method: "GET", Path: "/api/workflows/:id/versions/:versionId", Tag: "Versions", Summary: "Get a published version with its flow data"},
+		{Method: "PUT", Path: "/api/workflows/:id/versions/:versionId/activate", Tag: "Versions", Summary: "Make a published version the active one", Response: message{}},
+		{Method: "GET", Path: "/api/workflows/:id/analysis", Tag: "Versions", Summary: "Trace data along every path: missing required inputs, type mismatches and unused outputs", Response: analysis.Analysis{}, Query: graphQuery},
+		{Method: "GET", Path: "/api/workflows/:id/coverage", Tag: "Versions", Summary: "List every start-to-end path with its conditions, reachable end statuses, missing branches, loops without an exit and test-case coverage", Response: coverage.Report{}, Query: graphQuery},
+		{Method: "POST", Path: "/api/workflows/:id/simulate", Tag: "Versions", Summary: "Run a workflow with flow inputs and mocked responses; returns the path taken, each step's input and output, and the end status", Request: simulate.Request{}, Response: simulate.Result{}, Query: graphQuery},
+		{Method: "GET", Path: "/api/workflows/:id/tests", Tag: "Versions", Summary: "List the test cases of a workflow", Response: testcases.Suite{}},
+		{Method: "POST", Path: "/api/workflows/:id/tests", Tag: "Versions", Summary: "Add a test case: flow inputs, mocked responses, and the expected end status and path", Request: testcases.TestCaseReq{}, Response: testcases.TestCase{}, Status: http.StatusCreated},
+		{Method: "POST", Path: "/api/workflows/:id/tests/run", Tag: "Versions", Summary: "Run every test case against the draft, a version or an environment, with pass/fail and diffs", Response: testcases.SuiteResult{}, Query: graphQuery},
+		{Method: "PUT", Path: "/api/workflows/:id/tests/settings", Tag: "Versions", Summary: "Set whether publishing needs every test case to pass", Request: testcases.SuiteSettings{}, Response: testcases.SuiteSettings{}},
+		{Method: "PUT", Path: "/api/workflows/:id/tests/:caseId", Tag: "Versions", Summary: "Replace a test case", Request: testcases.TestCaseReq{}, Response: testcases.TestCase{}},
+		{Method: "DELETE", Path: "/api/workflows/:id/tests/:caseId", Tag: "Versions", Summary: "Delete a test case", Status: http.StatusNoContent},
+
+		// Workflow-environment links
+		{Method: "POST", Path: "/api/workflows/:id/environments/:envId", Tag: "Workflow Environments", Summary: "Link a workflow to an environment", Request: workflow_environments.LinkRequest{}, Status: http.StatusCreated},
+		{Method: "DELETE", Path: "/api/workflows/:id/environments/:envId", Tag: "Workflow Environments", Summary: "Unlink a workflow from an environment", Status: http.StatusNoContent},
+		{Method: "GET", Path: "/api/workflows/:id/environments", Tag: "Workflow Environments", Summary: "Environments of a workflow", Response: []workflow_environments.WorkflowEnvironment{}},
+		{Method: "GET", Path: "/api/environments/:id/workflows", Tag: "Workflow Environments", Summary: "Workflows of an environment", Response: []workflow_environments.WorkflowEnvironment{}},
+		{Method: "PUT", Path: "/api/workflows/:id/environments/:envId/flow-data", Tag: "Workflow Environments", Summary: "Update the environment-specific diagram", Request: workflow_environments.LinkRequest{}, Response: message{}},
+
+		// Exports
+		{Method: "GET", Path: "/api/workflows/:id/export", Tag: "Exports", Summary: "Export the workflow graph as Mermaid, Graphviz DOT or BPMN 2.0, noting its decision coverage", ContentType: "text/plain",
+			Query: append([]openapi.Param{{Name: "format", Description: "Diagram format (default mermaid)", Enum: []string{exports.FormatMermaid, exports.FormatDOT, exports.FormatBPMN}}}, graphQuery...)},
+		{Method: "GET", Path: "/api/workflows/:id/export/openapi", Tag: "Exports", Summary: "Export an api workflow as an OpenAPI 3.1 document, with its decision coverage as x-coverage", Response: map[string]interface{}{}, Query: graphQuery},
+		{Method: "GET", Path: "/api/workflows/:id/export/postman", Tag: "Exports", Summary: "Export a workflow's API calls as a Postman v2.1 collection, its description summing up decision coverage", Response: exports.PostmanCollection{},
+			Query: append([]openapi.Param{{Name: "include_secrets", Description: "Export credentials and environment headers; editors only", Enum: []string{"true", "false"}}}, graphQuery...)},
+		{Method: "GET", Path: "/api/workflows/:id/export/code", Tag: "Exports", Summary: "Generate sample integration code as a zip", ContentType: "application/zip",
+			Query: append([]openapi.Param{{Name: "language", Description: "Comma separated language ids; all languages when omitted"}}, graphQuery...)},
+		{Method: "GET", Path: "/api/codegen/languages", Tag: "Exports", Summary: "List code generation languages", Response: []codegen.Language{}},
+		{Method: "GET", Path: "/api/workflows/:id/render.svg", Tag: "Exports", Summary: "Render the workflow diagram as SVG", ContentType: "image/svg+xml", Query: graphQuery},
+		{Method: "GET", Path: "/api/workflows/:id/render.png", Tag: "Exports", Summary: "Render the workflow diagram as PNG", ContentType: "image/png",
+			Query: append([]openapi.Param{scaleParam}, graphQuery...)},
+		{Method: "GET", Path: "/api/workflows/:id/csp", Tag: "Exports", Summary: "Compute the Content-Security-Policy and egress IP allowlist of a workflow", Response: csp.Result{},
+			Query: append([]openapi.Param{countryParam}, graphQuery...)},
+		{Method: "POST", Path: "/api/workflows/:id/csp/diff", Tag: "Exports", Summary: "Compare a customer's current CSP with what the workflow needs", Request: csp.DiffReq{}, Response: csp.Result{},
+			Query: append([]openapi.Param{countryParam}, graphQuery...)},
+		{Method: "GET", Path: "/api/workflows/:id/network", Tag: "Exports", Summary: "List the hosts, IPs and ports a workflow needs through the customer's firewall", Response: network.Report{},
+			Query: append([]openapi.Param{formatParam, countryParam}, graphQuery...)},
+		{Method: "GET", Path: "/api/workflows/:id/network/diff", Tag: "Exports", Summary: "Compare the network requirements of two published versions", Response: network.Diff{},
+			Query: []openapi.Param{{Name: "from", Description: "Older version id", Required: true}, {Name: "to", Description: "Newer version id", Required: true}, formatParam, countryParam}},
+
+		// Legacy boards
+		{Method: "POST", Path: "/api/boards", Tag: "Boards", Summary: "Create a board", Request: boards.CreateBoardReq{}, Response: boards.BoardResponse{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/boards", Tag: "Boards", Summary: "List boards", Response: rows{}},
+		{Method: "GET", Path: "/api/boards/:id", Tag: "Boards", Summary: "Get a board"},
+		{Method: "DELETE", Path: "/api/boards/:id", Tag: "Boards", Summary: "Delete a board", Status: http.StatusNoContent},
+		{Method: "POST", Path: "/api/boards/:id/share", Tag: "Boards", Summary: "Share a board", Request: collaborators.ShareReq{}},
+		{Method: "GET", Path: "/api/boards/:id/collaborators", Tag: "Boards", Summary: "List board collaborators", Response: rows{}},
+		{Method: "GET", Path: "/api/boards/:id/snapshot", Tag: "Boards", Summary: "Get the board snapshot"},
+		{Method: "PUT", Path: "/api/boards/:id/snapshot", Tag: "Boards", Summary: "Save the board snapshot", Request: snapshot.SaveSnapshotReq{}},
+		{Method: "POST", Path: "/api/boards/:id/links", Tag: "Boards", Summary: "Create a board access link", Request: accesslinks.CreateLinkReq{}, Response: accesslinks.CreateLinkResponse{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/boards/:id/links", Tag: "Boards", Summary: "List board access links", Response: []accesslinks.AccessLink{}},
+		{Method: "DELETE", Path: "/api/boards/:id/links/:linkId", Tag: "Boards", Summary: "Revoke a board access link", Status: http.StatusNoContent},
+
+		// BU access links
+		{Method: "POST", Path: "/api/business-units/:buId/links", Tag: "Access Links", Summary: "Create a BU access link", Request: buaccesslinks.CreateLinkReq{}, Response: buaccesslinks.CreateLinkResponse{}, Status: http.StatusCreated},
+		{Method: "GET", Path: "/api/business-units/:buId/links", Tag: "Access Links", Summary: "List BU access links", Response: []buaccesslinks.BUAccessLink{}},
+		{Method: "DELETE", Path: "/api/business-units/:buId/links/:linkId", Tag: "Access Links", Summary: "Revoke a BU access link", Status: http.StatusNoContent},
+		{Method: "GET", Path: "/api/business-units/:buId/handbook.pdf", Tag: "Exports", Summary: "Generate the integration handbook of a business unit as PDF", ContentType: "application/pdf", Query: handbookQuery},
+		{Method: "GET", Path: "/api/business-units/:buId/network", Tag: "Exports", Summary: "List the network requirements of every workflow of a business unit", Response: network.Report{},
+			Query: []openapi.Param{formatParam, countryParam}},
+
+		// API catalog
+		{Method: "GET", Path: "/api/documentation", Tag: "Documentation", Summary: "List API documentation (legacy)", Response: []catalog.LegacyAPI{},
+			Query: []openapi.Param{{Name: "category"}}},
+		{Method: "GET", Path: "/api/documentation/search", Tag: "Documentation", Summary: "Rank APIs and modules by a free-text query, tolerating typos", Response: catalog.SearchResults{},
+			Query: []openapi.Param{{Name: "q", Required: true}, {Name: "kind", Enum: []string{catalog.KindAPI, catalog.KindModule}}, {Name: "category"}, {Name: "limit", Description: "At most 200; defaults to 20"}}},
+		{Method: "GET", Path: "/api/documentation/new", Tag: "Documentation", Summary: "List API documentation with inputs and outputs", Response: []catalog.API{},
+			Query: append([]openapi.Param{{Name: "q", Description: "Name contains"}}, catalogQuery...)},
+		{Method: "GET", Path: "/api/documentation/new/search", Tag: "Documentation", Summary: "Search API documentation by name, input or output", Response: []catalog.API{},
+			Query: append([]openapi.Param{{Name: "q", Description: "Name contains; required unless input or output is given"}}, catalogQuery...)},
+		{Method: "GET", Path: "/api/documentation/new/:apiId", Tag: "Documentation", Summary: "Get an API with its inputs and outputs", Response: catalog.API{}},
+		{Method: "GET", Path: "/api/documentation/new/:apiId/revisions", Tag: "Documentation", Summary: "List the revisions of an API, newest first", Response: []catalog.Revision{}},
+		{Method: "GET", Path: "/api/documentation/new/:apiId/revisions/:revision", Tag: "Documentation", Summary: "Get a revision of an API", Response: catalog.Revision{}},
+		{Method: "GET", Path: "/api/workflows/:id/catalog-revisions", Tag: "Documentation", Summary: "List a workflow's API nodes and whether they were copied from the latest catalog revision", Response: catalog.WorkflowRevisions{},
+			Query: graphQuery},
+		{Method: "GET", Path: "/api/business-units/:buId/catalog-revisions", Tag: "Documentation", Summary: "Flag the workflows of a business unit with API nodes copied from an older catalog revision", Response: []catalog.WorkflowRevisions{}},
+		{Method: "GET", Path: "/api/workflows/:id/drift", Tag: "Documentation", Summary: "List the API nodes of a workflow's draft and versions that drifted from the catalog", Response: drift.WorkflowReport{}},
+		{Method: "POST", Path: "/api/workflows/:id/nodes/:nodeId/refresh", Tag: "Documentation", Summary: "Copy the catalog's current endpoint, fields and examples into an API node of the draft", Response: drift.Refreshed{}},
+		{Method: "GET", Path: "/api/catalog/drift", Tag: "Documentation", Summary: "Latest scan of every workflow for API nodes that drifted from the catalog (admins)", Response: drift.Report{},
+			Query: []openapi.Param{{Name: "refresh", Enum: []string{"true"}, Description: "Scan now instead of returning the latest scan"}}},
+		{Method: "POST", Path: "/api/documentation/new", Tag: "Documentation", Summary: "Add an API to the catalog (admins)", Request: catalog.APIReq{}, Response: catalog.API{}, Status: http.StatusCreated},
+		{Method: "PUT", Path: "/api/documentation/new/:apiId", Tag: "Documentation", Summary: "Replace an API with its inputs and outputs (admins)", Request: catalog.APIReq{}, Response: catalog.API{}},
+		{Method: "DELETE", Path: "/api/documentation/new/:apiId", Tag: "Documentation", Summary: "Delete an API (admins)", Status: http.StatusNoContent},
+		{Method: "GET", Path: "/api/modules", Tag: "Documentation", Summary: "List SDK module documentation", Response: []catalog.Module{},
+			Query: []openapi.Param{{Name: "category"}}},
+		{Method: "GET", Path: "/api/modules/search", Tag: "Documentation", Summary: "Search SDK module documentation", Response: []catalog.Module{},
+			Query: []openapi.Param{{Name: "q", Required: true}, {Name: "category"}}},
+		{Method: "GET", Path: "/api/modules/:moduleId", Tag: "Documentation", Summary: "Get an SDK module", Response: catalog.Module{}},
+		{Method: "POST", Path: "/api/modules", Tag: "Documentation", Summary: "Add an SDK module (admins)", Request: catalog.ModuleReq{}, Response: catalog.Module{}, Status: http.StatusCreated},
+		{Method: "PUT", Path: "/api/modules/:moduleId", Tag: "Documentation", Summary: "Replace an SDK module (admins)", Request: catalog.ModuleReq{}, Response: catalog.Module{}},
+		{Method: "DELETE", Path: "/api/modules/:moduleId", Tag: "Documentation", Summary: "Delete an SDK module (admins)", Status: http.StatusNoContent},
+
+		// Public
+		{Method: "POST", Path: "/api/public/links/:linkId/verify", Tag: "Public", Summary: "Verify a board link password", Public: true, Request: accesslinks.VerifyPasswordReq{}, Response: accesslinks.VerifyResponse{}},
+		{Method: "GET", Path: "/api/public/links/:linkId/board", Tag: "Public", Summary: "Get a shared board", Public: true},
+		{Method: "POST", Path: "/api/public/bu-links/:linkId/verify", Tag: "Public", Summary: "Verify a BU link password", Public: true, Request: buaccesslinks.VerifyReq{}, Response: buaccesslinks.VerifyResponse{}},
+		{Method: "GET", Path: "/api/public/bu-links/:linkId/data", Tag: "Public", Summary: "Get a shared business unit", Public: true},
+		{Method: "GET", Path: "/api/public/bu-links/:linkId/workflows/:id/export/postman", Tag: "Public", Summary: "Export a shared workflow as a Postman collection, without secrets", Public: true, Response: exports.PostmanCollection{}, Query: publicGraphQuery},
+		{Method: "GET", Path: "/api/public/bu-links/:linkId/workflows/:id/render.svg", Tag: "Public", Summary: "Render a shared workflow diagram as SVG", Public: true, ContentType: "image/svg+xml", Query: publicGraphQuery},
+		{Method: "GET", Path: "/api/public/bu-links/:linkId/workflows/:id/render.png", Tag: "Public", Summary: "Render a shared workflow diagram as PNG", Public: true, ContentType: "image/png",
+			Query: append([]openapi.Param{scaleParam}, publicGraphQuery...)},
+		{Method: "GET", Path: "/api/public/bu-links/:linkId/handbook.pdf", Tag: "Public", Summary: "Generate the integration handbook of a shared business unit, without secrets", Public: true, ContentType: "application/pdf",
+			Query: append([]openapi.Param{{Name: "token", Description: "BU link password", Required: true}}, handbookQuery...)},
+		{Method: "GET", Path: "/api/public/test-docs", Tag: "Public", Summary: "Catalog connectivity check", Public: true},
+
+		// This document
+		{Method: "GET", Path: "/openapi.json", Tag: "Meta", Summary: "OpenAPI document", Public: true},
+		{Method: "GET", Path: "/docs", Tag: "Meta", Summary: "API reference UI", Public: true, ContentType: "text/html"},
+	}
+}
+
+var (
+	specOnce sync.Once
+	spec     map[string]interface{}
+)
+
+// Spec returns the OpenAPI document for the API
+func Spec() map[string]interface{} {
+	specOnce.Do(func() {
+		spec = openapi.Build(openapi.Info{
+			Title:       "HyperFlow API",
+			Version:     "1.0.0",
+			Description: "Clients, business units, environments and workflows for HyperVerge integrations.",
+		}, Operations())
+	})
+	return spec
+}
+
+const docsPage = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8" />
+  <title>HyperFlow API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>`
+
+func registerDocs(r *gin.Engine) {
+	r.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, Spec())
+	})
+	r.GET("/docs", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(docsPage))
+	})
+}
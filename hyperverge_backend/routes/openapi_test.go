@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"hypervision_backend/internal/openapi"
+)
+
+// Every route registered on the router must be documented, and the document
+// must not describe routes that don't exist.
+func TestSpecCoversRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	Register(r)
+
+	paths, ok := Spec()["paths"].(map[string]map[string]interface{})
+	if !ok {
+		t.Fatal("spec has no paths")
+	}
+
+	registered := make(map[string]bool)
+	for _, route := range r.Routes() {
+		path := openapi.PathFromGin(route.Path)
+		method := strings.ToLower(route.Method)
+		registered[method+" "+path] = true
+
+		if _, ok := paths[path][method]; !ok {
+			t.Errorf("route %s %s is missing from the OpenAPI spec", route.Method, route.Path)
+		}
+	}
+
+	for path, ops := range paths {
+		for method := range ops {
+			if !registered[method+" "+path] {
+				t.Errorf("spec documents %s %s but no such route is registered", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
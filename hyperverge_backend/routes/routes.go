@@ -4,28 +4,44 @@ package routes
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"os"
 
 	"github.com/gin-gonic/gin"
 
 	"hypervision_backend/internal/accesslinks"
+	"hypervision_backend/internal/analysis"
 	"hypervision_backend/internal/auth"
 	"hypervision_backend/internal/boards"
 	"hypervision_backend/internal/buaccesslinks"
+	"hypervision_backend/internal/bundle"
 	"hypervision_backend/internal/businessunits"
+	"hypervision_backend/internal/catalog"
 	"hypervision_backend/internal/clients"
+	"hypervision_backend/internal/codegen"
 	"hypervision_backend/internal/collaborators"
+	"hypervision_backend/internal/coverage"
+	"hypervision_backend/internal/csp"
 	"hypervision_backend/internal/db"
-	// "hypervision_backend/internal/documentation"
+	"hypervision_backend/internal/drift"
 	"hypervision_backend/internal/environments"
+	"hypervision_backend/internal/exports"
+	"hypervision_backend/internal/handbook"
+	"hypervision_backend/internal/network"
+	"hypervision_backend/internal/render"
+	"hypervision_backend/internal/simulate"
 	"hypervision_backend/internal/snapshot"
+	"hypervision_backend/internal/templates"
+	"hypervision_backend/internal/testcases"
+	"hypervision_backend/internal/variables"
+	"hypervision_backend/internal/versions"
 	"hypervision_backend/internal/workflow_environments"
 	"hypervision_backend/internal/workflows"
 )
 
 func Register(r *gin.Engine) {
+	// OpenAPI document and docs UI (see openapi.go)
+	registerDocs(r)
+
 	// Authenticated routes
 	api := r.Group("/api")
 	api.Use(auth.RequireAuth())
@@ -37,12 +53,15 @@ func Register(r *gin.Engine) {
 	api.GET("/clients", clients.List)
 	api.GET("/clients/:id", clients.Get)
 	api.DELETE("/clients/:id", clients.Delete)
+	api.GET("/clients/:id/export", bundle.ExportHandler)
+	api.POST("/clients/import", bundle.ImportHandler)
 
 	// Business Units (nested under clients)
 	api.POST("/clients/:id/business-units", businessunits.Create)
 	api.GET("/clients/:id/business-units", businessunits.List)
 	api.GET("/business-units/:buId", businessunits.Get)
 	api.DELETE("/business-units/:buId", businessunits.Delete)
+	api.POST("/business-units/:buId/clone", businessunits.Clone)
 
 	// BU Sharing
 	api.POST("/business-units/:buId/share", businessunits.Share)
@@ -57,17 +76,51 @@ func Register(r *gin.Engine) {
 	api.PUT("/environments/:id", environments.Update)
 	api.DELETE("/environments/:id", environments.Delete)
 
+	// Inherited variables (client -> BU -> environment)
+	api.GET("/clients/:id/variables", variables.GetClientVariables)
+	api.PUT("/clients/:id/variables", variables.UpdateClientVariables)
+	api.GET("/business-units/:buId/variables", variables.GetBUVariables)
+	api.PUT("/business-units/:buId/variables", variables.UpdateBUVariables)
+	api.GET("/environments/:id/variables/resolved", variables.GetResolved)
+
 	// Workflows (nested under business units)
 	api.POST("/business-units/:buId/workflows", workflows.Create)
 	api.GET("/business-units/:buId/workflows", workflows.List)
 	api.GET("/workflows/:id", workflows.Get)
 	api.PUT("/workflows/:id", workflows.Update)
 	api.DELETE("/workflows/:id", workflows.Delete)
+	api.POST("/workflows/:id/clone", workflows.Clone)
+	api.POST("/workflows/:id/move", workflows.Move)
+
+	// Workflow templates (global catalog + per-client catalog)
+	api.GET("/templates", templates.List)
+	api.GET("/templates/:templateId", templates.Get)
+	api.POST("/templates/:templateId/versions", templates.AddVersion)
+	api.DELETE("/templates/:templateId", templates.Delete)
+	api.POST("/workflows/:id/templates", templates.SaveAsTemplate)
+	api.POST("/business-units/:buId/workflows/from-template", templates.CreateFromTemplate)
+	api.GET("/business-units/:buId/workflows/template-status", templates.ListTemplateStatus)
 
 	// Workflow snapshot routes
 	api.GET("/workflows/:id/snapshot", snapshot.GetWorkflow)
 	api.PUT("/workflows/:id/snapshot", snapshot.SaveWorkflow)
 
+	// Published versions; publishing is gated on the data-mapping analysis and,
+	// when the workflow asks for it, on its test cases
+	api.POST("/workflows/:id/publish", versions.Publish)
+	api.GET("/workflows/:id/versions", versions.ListVersions)
+	api.GET("/workflows/:id/versions/:versionId", versions.GetVersion)
+	api.PUT("/workflows/:id/versions/:versionId/activate", versions.SetActiveVersion)
+	api.GET("/workflows/:id/analysis", analysis.Handler)
+	api.GET("/workflows/:id/coverage", coverage.Handler)
+	api.POST("/workflows/:id/simulate", simulate.Handler)
+	api.GET("/workflows/:id/tests", testcases.List)
+	api.POST("/workflows/:id/tests", testcases.CreateCase)
+	api.POST("/workflows/:id/tests/run", testcases.RunSuite)
+	api.PUT("/workflows/:id/tests/settings", testcases.UpdateSettings)
+	api.PUT("/workflows/:id/tests/:caseId", testcases.UpdateCase)
+	api.DELETE("/workflows/:id/tests/:caseId", testcases.DeleteCase)
+
 	// Workflow-Environment Relationships
 	api.POST("/workflows/:id/environments/:envId", workflow_environments.Link)
 	api.DELETE("/workflows/:id/environments/:envId", workflow_environments.Unlink)
@@ -75,6 +128,22 @@ func Register(r *gin.Engine) {
 	api.GET("/environments/:id/workflows", workflow_environments.ListByEnvironment)
 	api.PUT("/workflows/:id/environments/:envId/flow-data", workflow_environments.UpdateDiagram)
 
+	// Exports
+	api.GET("/workflows/:id/export", exports.Diagram)
+	api.GET("/workflows/:id/export/openapi", exports.OpenAPI)
+	api.GET("/workflows/:id/export/postman", exports.Postman)
+	api.GET("/workflows/:id/export/code", codegen.Download)
+	api.GET("/codegen/languages", codegen.ListLanguages)
+	api.GET("/workflows/:id/render.svg", render.SVGHandler)
+	api.GET("/workflows/:id/render.png", render.PNGHandler)
+	api.GET("/workflows/:id/csp", csp.Handler)
+	api.POST("/workflows/:id/csp/diff", csp.DiffHandler)
+	api.GET("/workflows/:id/network", network.Handler)
+	api.GET("/workflows/:id/network/diff", network.DiffHandler)
+	api.GET("/workflows/:id/catalog-revisions", catalog.CheckWorkflow)
+	api.GET("/workflows/:id/drift", drift.WorkflowHandler)
+	api.POST("/workflows/:id/nodes/:nodeId/refresh", drift.RefreshHandler)
+
 	// ============ LEGACY BOARD ROUTES (keep for now) ============
 
 	api.POST("/boards", boards.Create)
@@ -98,174 +167,30 @@ func Register(r *gin.Engine) {
 	api.POST("/business-units/:buId/links", buaccesslinks.Create)
 	api.GET("/business-units/:buId/links", buaccesslinks.List)
 	api.DELETE("/business-units/:buId/links/:linkId", buaccesslinks.Revoke)
-
-	// API Documentation routes (authenticated) - querying real database
-	// API Documentation routes (authenticated) - querying real database
-	api.GET("/documentation", func(c *gin.Context) {
-		category := c.Query("category")
-
-		url := os.Getenv("SUPABASE_URL") + "/rest/v1/api_documentation?select=id,name,description,url,category,curl_example,success_response,failure_responses,error_details,created_at,updated_at"
-		if category != "" {
-			url += "&category=eq." + category
-		}
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create request: " + err.Error()})
-			return
-		}
-
-		req.Header.Set("apikey", os.Getenv("SUPABASE_ANON_KEY"))
-		req.Header.Set("Authorization", c.GetHeader("Authorization"))
-
-		client := &http.Client{}
-		resp, err := client.Do(req)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch APIs: " + err.Error()})
-			return
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			c.JSON(resp.StatusCode, gin.H{"error": "Failed to fetch APIs from DB"})
-			return
-		}
-
-		var data []map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to decode APIs"})
-			return
-		}
-
-		c.JSON(http.StatusOK, data)
-	})
-
-	api.GET("/documentation/new", func(c *gin.Context) {
-		category := c.Query("category")
-
-		url := os.Getenv("SUPABASE_URL") + "/rest/v1/api_documentation_new?select=*,api_inputs_new(*),api_outputs_new(*)"
-		if category != "" {
-			url += "&category=eq." + category
-		}
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create request: " + err.Error()})
-			return
-		}
-
-		req.Header.Set("apikey", os.Getenv("SUPABASE_ANON_KEY"))
-		req.Header.Set("Authorization", c.GetHeader("Authorization"))
-
-		client := &http.Client{}
-		resp, err := client.Do(req)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch APIs: " + err.Error()})
-			return
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			bodyBytes, _ := io.ReadAll(resp.Body)
-			c.JSON(resp.StatusCode, gin.H{"error": "Failed to fetch APIs from DB: " + string(bodyBytes)})
-			return
-		}
-
-		var data []map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to decode APIs"})
-			return
-		}
-
-		c.JSON(http.StatusOK, data)
-	})
-
-	api.GET("/documentation/new/search", func(c *gin.Context) {
-		searchQuery := c.Query("q")
-		category := c.Query("category")
-
-		if searchQuery == "" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Search query is required"})
-			return
-		}
-
-		url := os.Getenv("SUPABASE_URL") + "/rest/v1/api_documentation_new?select=*,api_inputs_new(*),api_outputs_new(*)"
-		url += "&name=ilike.*" + searchQuery + "*"
-		if category != "" {
-			url += "&category=eq." + category
-		}
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create request: " + err.Error()})
-			return
-		}
-
-		req.Header.Set("apikey", os.Getenv("SUPABASE_ANON_KEY"))
-		req.Header.Set("Authorization", c.GetHeader("Authorization"))
-
-		client := &http.Client{}
-		resp, err := client.Do(req)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search APIs: " + err.Error()})
-			return
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			c.JSON(resp.StatusCode, gin.H{"error": "Failed to search APIs from DB"})
-			return
-		}
-
-		var data []map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to decode APIs"})
-			return
-		}
-
-		c.JSON(http.StatusOK, data)
-	})
+	api.GET("/business-units/:buId/handbook.pdf", handbook.Handler)
+	api.GET("/business-units/:buId/network", network.BusinessUnitHandler)
+	api.GET("/business-units/:buId/catalog-revisions", catalog.CheckBusinessUnit)
+
+	// API and SDK module catalog; changes are admin-only
+	api.GET("/documentation", catalog.ListLegacy)
+	api.GET("/documentation/search", catalog.SearchCatalog)
+	api.GET("/documentation/new", catalog.ListAPIs)
+	api.GET("/documentation/new/search", catalog.SearchAPIs)
+	api.GET("/documentation/new/:apiId", catalog.GetAPI)
+	api.GET("/documentation/new/:apiId/revisions", catalog.ListRevisions)
+	api.GET("/documentation/new/:apiId/revisions/:revision", catalog.GetRevision)
+	api.POST("/documentation/new", auth.RequireAdmin(), catalog.CreateAPI)
+	api.PUT("/documentation/new/:apiId", auth.RequireAdmin(), catalog.UpdateAPI)
+	api.DELETE("/documentation/new/:apiId", auth.RequireAdmin(), catalog.DeleteAPI)
+	api.GET("/catalog/drift", auth.RequireAdmin(), drift.ReportHandler)
 
 	// Module Documentation routes for SDK flow
-	api.GET("/modules", func(c *gin.Context) {
-		category := c.Query("category")
-
-		url := os.Getenv("SUPABASE_URL") + "/rest/v1/module_documentation_new?select=*"
-		if category != "" {
-			url += "&category=eq." + category
-		}
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create request: " + err.Error()})
-			return
-		}
-
-		req.Header.Set("apikey", os.Getenv("SUPABASE_ANON_KEY"))
-		req.Header.Set("Authorization", c.GetHeader("Authorization"))
-
-		client := &http.Client{}
-		resp, err := client.Do(req)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch modules: " + err.Error()})
-			return
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			bodyBytes, _ := io.ReadAll(resp.Body)
-			c.JSON(resp.StatusCode, gin.H{"error": "Failed to fetch modules from DB: " + string(bodyBytes)})
-			return
-		}
-
-		var data []map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to decode modules"})
-			return
-		}
-
-		c.JSON(http.StatusOK, data)
-	})
+	api.GET("/modules", catalog.ListModules)
+	api.GET("/modules/search", catalog.SearchModules)
+	api.GET("/modules/:moduleId", catalog.GetModule)
+	api.POST("/modules", auth.RequireAdmin(), catalog.CreateModule)
+	api.PUT("/modules/:moduleId", auth.RequireAdmin(), catalog.UpdateModule)
+	api.DELETE("/modules/:moduleId", auth.RequireAdmin(), catalog.DeleteModule)
 
 	// Public routes (no auth required)
 	public := r.Group("/api/public")
@@ -275,6 +200,10 @@ func Register(r *gin.Engine) {
 	// Public BU access routes
 	public.POST("/bu-links/:linkId/verify", buaccesslinks.Verify)
 	public.GET("/bu-links/:linkId/data", buaccesslinks.GetPublicBUData)
+	public.GET("/bu-links/:linkId/workflows/:id/export/postman", exports.PublicPostman)
+	public.GET("/bu-links/:linkId/workflows/:id/render.svg", render.PublicSVG)
+	public.GET("/bu-links/:linkId/workflows/:id/render.png", render.PublicPNG)
+	public.GET("/bu-links/:linkId/handbook.pdf", handbook.PublicHandler)
 
 	// Temporary test endpoint for documentation (no auth)
 	public.GET("/test-docs", func(c *gin.Context) {